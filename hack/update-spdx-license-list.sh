@@ -0,0 +1,76 @@
+#!/usr/bin/env bash
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+source hack/common.sh
+
+# Release of the SPDX license-list-data repository the identifiers are taken from
+export SPDX_LICENSE_LIST_VERSION="v3.23"
+
+# SPDX_LICENSE_LIST_DIR can point to a local checkout of license-list-data at
+# the pinned release to generate the list without downloading it.
+LIST_DIR="${SPDX_LICENSE_LIST_DIR:-}"
+OUTPUT="pkg/license/list_data.go"
+BASE_URL="https://raw.githubusercontent.com/spdx/license-list-data"
+
+command -v jq >/dev/null || exit_with_msg "jq is required to generate the license list"
+
+if [[ -z "${LIST_DIR}" ]]; then
+    LIST_DIR="$(mktemp -d)"
+    trap 'rm -rf "${LIST_DIR}"' EXIT
+    mkdir -p "${LIST_DIR}/json"
+    curl -fsSL -o "${LIST_DIR}/json/licenses.json" "${BASE_URL}/${SPDX_LICENSE_LIST_VERSION}/json/licenses.json" ||
+        exit_with_msg "Unable to download the SPDX license list"
+    curl -fsSL -o "${LIST_DIR}/json/exceptions.json" "${BASE_URL}/${SPDX_LICENSE_LIST_VERSION}/json/exceptions.json" ||
+        exit_with_msg "Unable to download the SPDX license exceptions list"
+fi
+
+# ids prints the identifiers selected by the jq filter as quoted strings,
+# wrapped in lines of up to 88 columns.
+function ids() {
+    jq -r "${1}" "${2}" | LC_ALL=C sort -f | awk '
+        {
+            id = "\"" $0 "\","
+            if (line != "" && length(line) + length(id) + 1 > 88) {
+                print "\t" line
+                line = ""
+            }
+            line = (line == "" ? id : line " " id)
+        }
+        END { if (line != "") print "\t" line }'
+}
+
+{
+    cat <<HEADER
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by hack/update-spdx-license-list.sh. DO NOT EDIT.
+
+package license
+
+// This file bundles the license and exception identifiers of the SPDX
+// License List (https://spdx.org/licenses/). The list includes the
+// deprecated identifiers so they can be recognized and normalized.
+
+// LicenseListVersion is the version of the SPDX License List the license
+// and exception identifiers were taken from.
+const LicenseListVersion = "${SPDX_LICENSE_LIST_VERSION#v}"
+
+// licenseIDs are the SPDX license identifiers
+var licenseIDs = []string{
+HEADER
+    ids '.licenses[].licenseId' "${LIST_DIR}/json/licenses.json"
+    cat <<MIDDLE
+}
+
+// exceptionIDs are the SPDX license exception identifiers
+var exceptionIDs = []string{
+MIDDLE
+    ids '.exceptions[].licenseExceptionId' "${LIST_DIR}/json/exceptions.json"
+    echo "}"
+} >"${OUTPUT}"
+
+gofmt -w "${OUTPUT}"
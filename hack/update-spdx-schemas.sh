@@ -0,0 +1,21 @@
+#!/usr/bin/env bash
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+source hack/common.sh
+
+# Tags of the spdx-spec repository the schemas are vendored from
+export SPDX22_TAG="v2.2.2"
+export SPDX23_TAG="v2.3"
+
+SCHEMAS_DIR="pkg/formats/schema/schemas"
+BASE_URL="https://raw.githubusercontent.com/spdx/spdx-spec"
+
+curl -fsSL -o "${SCHEMAS_DIR}/spdx-2.2.schema.json" "${BASE_URL}/${SPDX22_TAG}/schemas/spdx-schema.json" ||
+    exit_with_msg "Unable to download the SPDX 2.2 schema"
+curl -fsSL -o "${SCHEMAS_DIR}/spdx-2.3.schema.json" "${BASE_URL}/${SPDX23_TAG}/schemas/spdx-schema.json" ||
+    exit_with_msg "Unable to download the SPDX 2.3 schema"
+
+sha256sum "${SCHEMAS_DIR}"/spdx-2.?.schema.json
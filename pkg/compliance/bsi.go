@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compliance
+
+import "github.com/protobom/protobom/pkg/sbom"
+
+// BSI is the name of the BSI TR-03183-2 profile
+const BSI = "BSI TR-03183-2"
+
+// BSIProfile checks the required data fields of the BSI Technical Guideline
+// TR-03183-2 version 2.0 (https://www.bsi.bund.de/dok/TR-03183). The
+// executable, archive and structured properties of components are read from
+// the node properties named as in the BSI CycloneDX property taxonomy.
+var BSIProfile = NewProfile(BSI,
+	&Requirement{
+		ID: "bsi.creator", Element: "Creator of the SBOM", Field: "Metadata.Authors[].Email",
+		Scope: ScopeDocument, Check: hasAuthorContact,
+	},
+	&Requirement{
+		ID: "bsi.timestamp", Element: "Timestamp", Field: "Metadata.Date",
+		Scope: ScopeDocument, Check: hasTimestamp,
+	},
+	&Requirement{
+		ID: "bsi.component.creator", Element: "Component creator", Field: "Node.Originators[].Email",
+		Scope: ScopeNode, Check: hasCreatorContact,
+	},
+	&Requirement{
+		ID: "bsi.component.name", Element: "Component name", Field: "Node.Name",
+		Scope: ScopeNode, Check: hasName,
+	},
+	&Requirement{
+		ID: "bsi.component.version", Element: "Component version", Field: "Node.Version",
+		Scope: ScopeNode, Check: hasVersion,
+	},
+	&Requirement{
+		ID: "bsi.component.filename", Element: "Filename of the component", Field: "Node.FileName",
+		Scope: ScopeNode, Check: hasFileName,
+	},
+	&Requirement{
+		ID: "bsi.component.dependencies", Element: "Dependencies on other components", Field: "NodeList.Edges",
+		Scope: ScopeNode, Check: isRelated,
+	},
+	&Requirement{
+		ID: "bsi.component.license.declared", Element: "Associated licences (declared)", Field: "Node.Licenses",
+		Scope: ScopeNode, Check: hasLicenses,
+	},
+	&Requirement{
+		ID: "bsi.component.license.concluded", Element: "Associated licences (concluded)", Field: "Node.LicenseConcluded",
+		Scope: ScopeNode, Check: hasLicenseConcluded,
+	},
+	&Requirement{
+		ID: "bsi.component.hash", Element: "Hash value of the executable component", Field: "Node.Hashes[SHA512]",
+		Scope: ScopeNode, Check: hasHashAlgorithm(sbom.HashAlgorithm_SHA512),
+	},
+	&Requirement{
+		ID: "bsi.component.properties", Element: "Executable, archive and structured properties",
+		Field: "Node.Properties[bsi:component:*]", Scope: ScopeNode, Check: hasBSIProperties,
+	},
+)
+
+// Names of the node properties with the BSI component properties
+const (
+	BSIPropertyExecutable = "bsi:component:executable"
+	BSIPropertyArchive    = "bsi:component:archive"
+	BSIPropertyStructured = "bsi:component:structured"
+)
+
+// hasBSIProperties checks that the node has values for the executable,
+// archive and structured properties.
+func hasBSIProperties(_ *Context, n *sbom.Node) bool {
+	for _, name := range []string{BSIPropertyExecutable, BSIPropertyArchive, BSIPropertyStructured} {
+		if n.GetPropertyValue(name) == "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compliance
+
+import (
+	"strings"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// This file has the requirement checks shared by the built-in profiles
+
+// hasAuthor checks that the document has an author with a name
+func hasAuthor(ctx *Context, _ *sbom.Node) bool {
+	return hasPerson(ctx.doc.GetMetadata().GetAuthors(), func(p *sbom.Person) bool {
+		return p.GetName() != ""
+	})
+}
+
+// hasAuthorContact checks that the document has an author with an email
+// address or, failing that, a URL.
+func hasAuthorContact(ctx *Context, _ *sbom.Node) bool {
+	return hasPerson(ctx.doc.GetMetadata().GetAuthors(), hasContact)
+}
+
+// hasTimestamp checks that the document has a creation date
+func hasTimestamp(ctx *Context, _ *sbom.Node) bool {
+	date := ctx.doc.GetMetadata().GetDate()
+	return date != nil && date.IsValid() && date.AsTime().Unix() > 0
+}
+
+// hasDocumentType checks that the document declares its SBOM type
+func hasDocumentType(ctx *Context, _ *sbom.Node) bool {
+	for _, dt := range ctx.doc.GetMetadata().GetDocumentTypes() {
+		if dt.Type != nil || dt.GetName() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// hasRoots checks that the document declares its primary components
+func hasRoots(ctx *Context, _ *sbom.Node) bool {
+	return len(ctx.roots) > 0
+}
+
+// hasDependencies checks that the document declares its root elements and,
+// when it has more than one package, the relationships between them.
+func hasDependencies(ctx *Context, _ *sbom.Node) bool {
+	if len(ctx.roots) == 0 {
+		return false
+	}
+	return ctx.packages <= 1 || len(ctx.related) > 0
+}
+
+// hasSupplier checks that the node has a supplier with a name
+func hasSupplier(_ *Context, n *sbom.Node) bool {
+	return hasPerson(n.GetSuppliers(), func(p *sbom.Person) bool {
+		return p.GetName() != ""
+	})
+}
+
+// hasCreatorContact checks that the node has an originator with an email
+// address or, failing that, a URL. Suppliers are not considered as they are
+// not necessarily the creators of the component.
+func hasCreatorContact(_ *Context, n *sbom.Node) bool {
+	return hasPerson(n.GetOriginators(), hasContact)
+}
+
+// hasName checks that the node has a name
+func hasName(_ *Context, n *sbom.Node) bool {
+	return n.GetName() != ""
+}
+
+// hasVersion checks that the node has a version
+func hasVersion(_ *Context, n *sbom.Node) bool {
+	return n.GetVersion() != ""
+}
+
+// hasFileName checks that the node has the name of its file
+func hasFileName(_ *Context, n *sbom.Node) bool {
+	return n.GetFileName() != ""
+}
+
+// hasIdentifier checks that the node has a software identifier such as a
+// purl or a CPE.
+func hasIdentifier(_ *Context, n *sbom.Node) bool {
+	for _, v := range n.GetIdentifiers() {
+		if v != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// hasHash checks that the node has a hash of any algorithm
+func hasHash(_ *Context, n *sbom.Node) bool {
+	for _, v := range n.GetHashes() {
+		if v != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// hasHashAlgorithm returns a check for a hash of a specific algorithm
+func hasHashAlgorithm(algo sbom.HashAlgorithm) func(*Context, *sbom.Node) bool {
+	return func(_ *Context, n *sbom.Node) bool {
+		return n.GetHashes()[int32(algo)] != ""
+	}
+}
+
+// hasLicenseConcluded checks that the node has a concluded license. The
+// NOASSERTION value does not meet the requirement.
+func hasLicenseConcluded(_ *Context, n *sbom.Node) bool {
+	l := strings.TrimSpace(n.GetLicenseConcluded())
+	return l != "" && !strings.EqualFold(l, "NOASSERTION")
+}
+
+// hasLicenses checks that the node has declared licenses
+func hasLicenses(_ *Context, n *sbom.Node) bool {
+	for _, l := range n.GetLicenses() {
+		l = strings.TrimSpace(l)
+		if l != "" && !strings.EqualFold(l, "NOASSERTION") {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAnyLicense checks that the node has declared or concluded licenses
+func hasAnyLicense(ctx *Context, n *sbom.Node) bool {
+	return hasLicenses(ctx, n) || hasLicenseConcluded(ctx, n)
+}
+
+// hasCopyright checks that the node has a copyright text
+func hasCopyright(_ *Context, n *sbom.Node) bool {
+	c := strings.TrimSpace(n.GetCopyright())
+	return c != "" && !strings.EqualFold(c, "NOASSERTION")
+}
+
+// isRelated checks that the node is part of the dependency graph. Root
+// elements pass if the document has a single package.
+func isRelated(ctx *Context, n *sbom.Node) bool {
+	if ctx.IsRelated(n.GetId()) {
+		return true
+	}
+	return ctx.IsRoot(n.GetId()) && ctx.packages == 1
+}
+
+// hasContact returns true if the person has an email address or a URL
+func hasContact(p *sbom.Person) bool {
+	return p.GetEmail() != "" || p.GetUrl() != ""
+}
+
+// hasPerson returns true if any of the persons meets the condition
+func hasPerson(persons []*sbom.Person, cond func(*sbom.Person) bool) bool {
+	for _, p := range persons {
+		if p != nil && cond(p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compliance
+
+// CISA is the name of the CISA Framing Software Component Transparency profile
+const CISA = "CISA Framing Software Component Transparency"
+
+// CISAProfile checks the minimum attributes defined in the CISA Framing
+// Software Component Transparency document, third edition
+// (https://www.cisa.gov/resources-tools/resources/framing-software-component-transparency-2024).
+var CISAProfile = NewProfile(CISA,
+	&Requirement{
+		ID: "cisa.author", Element: "SBOM Author", Field: "Metadata.Authors[].Name",
+		Scope: ScopeDocument, Check: hasAuthor,
+	},
+	&Requirement{
+		ID: "cisa.timestamp", Element: "Timestamp", Field: "Metadata.Date",
+		Scope: ScopeDocument, Check: hasTimestamp,
+	},
+	&Requirement{
+		ID: "cisa.type", Element: "SBOM Type", Field: "Metadata.DocumentTypes",
+		Scope: ScopeDocument, Check: hasDocumentType,
+	},
+	&Requirement{
+		ID: "cisa.primary", Element: "SBOM Primary Component", Field: "NodeList.RootElements",
+		Scope: ScopeDocument, Check: hasRoots,
+	},
+	&Requirement{
+		ID: "cisa.component.name", Element: "Component Name", Field: "Node.Name",
+		Scope: ScopeNode, Check: hasName,
+	},
+	&Requirement{
+		ID: "cisa.component.version", Element: "Component Version", Field: "Node.Version",
+		Scope: ScopeNode, Check: hasVersion,
+	},
+	&Requirement{
+		ID: "cisa.component.supplier", Element: "Supplier Name", Field: "Node.Suppliers[].Name",
+		Scope: ScopeNode, Check: hasSupplier,
+	},
+	&Requirement{
+		ID: "cisa.component.identifier", Element: "Unique Identifier", Field: "Node.Identifiers",
+		Scope: ScopeNode, Check: hasIdentifier,
+	},
+	&Requirement{
+		ID: "cisa.component.hash", Element: "Cryptographic Hash", Field: "Node.Hashes",
+		Scope: ScopeNode, Check: hasHash,
+	},
+	&Requirement{
+		ID: "cisa.component.relationship", Element: "Relationship", Field: "NodeList.Edges",
+		Scope: ScopeNode, Check: isRelated,
+	},
+	&Requirement{
+		ID: "cisa.component.license", Element: "License", Field: "Node.Licenses",
+		Scope: ScopeNode, Check: hasAnyLicense,
+	},
+	&Requirement{
+		ID: "cisa.component.copyright", Element: "Copyright Holder", Field: "Node.Copyright",
+		Scope: ScopeNode, Check: hasCopyright,
+	},
+)
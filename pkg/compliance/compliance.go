@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package compliance checks that SBOM documents contain the data required
+// by SBOM standards. Standards are modeled as profiles: named sets of
+// requirements checked on the document metadata or on each of its package
+// nodes. The package includes profiles for the NTIA minimum elements, BSI
+// TR-03183-2 and the CISA Framing Software Component Transparency minimum
+// attributes, custom profiles can be created with NewProfile.
+//
+// Checks produce a report listing the exact protobom fields missing in the
+// document and in each node.
+package compliance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// Scope defines what a requirement applies to
+type Scope string
+
+const (
+	// ScopeDocument requirements are checked once per document
+	ScopeDocument Scope = "document"
+
+	// ScopeNode requirements are checked on every package node
+	ScopeNode Scope = "node"
+)
+
+// Requirement is a single data element required by a standard
+type Requirement struct {
+	// ID identifies the requirement in reports, for example ntia.supplier
+	ID string
+
+	// Element is the name of the data element in the standard
+	Element string
+
+	// Field is the protobom field that holds the element, for example
+	// Node.Suppliers
+	Field string
+
+	// Scope defines if the requirement is checked on the document or on
+	// each node.
+	Scope Scope
+
+	// Check returns true if the document or node meets the requirement.
+	// Document requirements are called with a nil node.
+	Check func(*Context, *sbom.Node) bool
+}
+
+// Context gives the requirement checks access to the document being checked
+// and to data computed once for all of them.
+type Context struct {
+	doc *sbom.Document
+
+	// related has the IDs of the nodes found in any edge
+	related map[string]struct{}
+
+	// roots has the IDs of the document root elements
+	roots map[string]struct{}
+
+	// packages is the number of package nodes in the document
+	packages int
+}
+
+// newContext indexes the document graph
+func newContext(doc *sbom.Document) *Context {
+	ctx := &Context{
+		doc:     doc,
+		related: map[string]struct{}{},
+		roots:   map[string]struct{}{},
+	}
+	for _, e := range doc.GetNodeList().GetEdges() {
+		if len(e.GetTo()) == 0 {
+			continue
+		}
+		ctx.related[e.GetFrom()] = struct{}{}
+		for _, to := range e.GetTo() {
+			ctx.related[to] = struct{}{}
+		}
+	}
+	for _, id := range doc.GetNodeList().GetRootElements() {
+		ctx.roots[id] = struct{}{}
+	}
+	for _, n := range doc.GetNodeList().GetNodes() {
+		if n.GetType() == sbom.Node_PACKAGE {
+			ctx.packages++
+		}
+	}
+	return ctx
+}
+
+// Document returns the document being checked
+func (ctx *Context) Document() *sbom.Document {
+	return ctx.doc
+}
+
+// IsRoot returns true if the node is a root element of the document
+func (ctx *Context) IsRoot(id string) bool {
+	_, ok := ctx.roots[id]
+	return ok
+}
+
+// IsRelated returns true if the node is found in any edge of the document
+func (ctx *Context) IsRelated(id string) bool {
+	_, ok := ctx.related[id]
+	return ok
+}
+
+// Packages returns the number of package nodes in the document
+func (ctx *Context) Packages() int {
+	return ctx.packages
+}
+
+// Finding is a requirement not met by the document or a node
+type Finding struct {
+	Requirement string `json:"requirement"`
+	Element     string `json:"element"`
+	Field       string `json:"field"`
+}
+
+// Result lists the requirements not met by the document or a node
+type Result struct {
+	Passed  bool       `json:"passed"`
+	Missing []*Finding `json:"missing,omitempty"`
+}
+
+// NodeResult is the result of checking a node
+type NodeResult struct {
+	NodeID string `json:"node"`
+	Name   string `json:"name,omitempty"`
+	Result
+}
+
+// Summary counts the checked nodes
+type Summary struct {
+	Nodes  int `json:"nodes"`
+	Passed int `json:"passed"`
+	Failed int `json:"failed"`
+}
+
+// Report is the result of checking a document against a standard
+type Report struct {
+	// Standard is the name of the checked standard
+	Standard string `json:"standard"`
+
+	// Passed is true if the document and all its nodes meet the standard
+	Passed bool `json:"passed"`
+
+	// Document has the findings of the document level requirements
+	Document *Result `json:"document"`
+
+	// Nodes has the result of every package node in document order
+	Nodes []*NodeResult `json:"nodes"`
+
+	Summary Summary `json:"summary"`
+}
+
+// Failed returns the results of the nodes that do not meet the standard
+func (r *Report) Failed() []*NodeResult {
+	ret := []*NodeResult{}
+	for _, n := range r.Nodes {
+		if !n.Passed {
+			ret = append(ret, n)
+		}
+	}
+	return ret
+}
+
+// Write renders the report as JSON
+func (r *Report) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(r); err != nil {
+		return fmt.Errorf("encoding report: %w", err)
+	}
+	return nil
+}
+
+// Check evaluates the requirements of the profile on the document. Node
+// requirements are only checked on package nodes, files are not components
+// for the purpose of the standards.
+func Check(doc *sbom.Document, p Profile) (*Report, error) {
+	if doc == nil {
+		return nil, errors.New("document is nil")
+	}
+	if p == nil {
+		return nil, errors.New("profile is nil")
+	}
+
+	requirements := p.Requirements()
+	for _, req := range requirements {
+		if req.Check == nil {
+			return nil, fmt.Errorf("requirement %q has no check function", req.ID)
+		}
+		if req.Scope != ScopeDocument && req.Scope != ScopeNode {
+			return nil, fmt.Errorf("requirement %q has an invalid scope %q", req.ID, req.Scope)
+		}
+	}
+
+	ctx := newContext(doc)
+	report := &Report{
+		Standard: p.Name(),
+		Document: &Result{Passed: true, Missing: []*Finding{}},
+		Nodes:    []*NodeResult{},
+	}
+
+	for _, req := range requirements {
+		if req.Scope == ScopeDocument && !req.Check(ctx, nil) {
+			report.Document.add(req)
+		}
+	}
+
+	for _, n := range doc.GetNodeList().GetNodes() {
+		if n.GetType() != sbom.Node_PACKAGE {
+			continue
+		}
+		res := &NodeResult{
+			NodeID: n.GetId(),
+			Name:   n.GetName(),
+			Result: Result{Passed: true, Missing: []*Finding{}},
+		}
+		for _, req := range requirements {
+			if req.Scope == ScopeNode && !req.Check(ctx, n) {
+				res.add(req)
+			}
+		}
+		report.Nodes = append(report.Nodes, res)
+		report.Summary.Nodes++
+		if res.Passed {
+			report.Summary.Passed++
+		} else {
+			report.Summary.Failed++
+		}
+	}
+
+	report.Passed = report.Document.Passed && report.Summary.Failed == 0
+	return report, nil
+}
+
+// add records a requirement as missing
+func (r *Result) add(req *Requirement) {
+	r.Passed = false
+	r.Missing = append(r.Missing, &Finding{
+		Requirement: req.ID,
+		Element:     req.Element,
+		Field:       req.Field,
+	})
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compliance
+
+import (
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// NTIA is the name of the NTIA minimum elements profile
+const NTIA = "NTIA minimum elements"
+
+// NTIAProfile checks the data fields of the NTIA minimum elements for a
+// Software Bill of Materials (https://www.ntia.gov/report/2021/minimum-elements-software-bill-materials-sbom)
+var NTIAProfile = NewProfile(NTIA,
+	&Requirement{
+		ID: "ntia.author", Element: "Author of SBOM Data", Field: "Metadata.Authors[].Name",
+		Scope: ScopeDocument, Check: hasAuthor,
+	},
+	&Requirement{
+		ID: "ntia.timestamp", Element: "Timestamp", Field: "Metadata.Date",
+		Scope: ScopeDocument, Check: hasTimestamp,
+	},
+	&Requirement{
+		ID: "ntia.dependencies", Element: "Dependency Relationship", Field: "NodeList.Edges",
+		Scope: ScopeDocument, Check: hasDependencies,
+	},
+	&Requirement{
+		ID: "ntia.supplier", Element: "Supplier Name", Field: "Node.Suppliers[].Name",
+		Scope: ScopeNode, Check: hasSupplier,
+	},
+	&Requirement{
+		ID: "ntia.name", Element: "Component Name", Field: "Node.Name",
+		Scope: ScopeNode, Check: hasName,
+	},
+	&Requirement{
+		ID: "ntia.version", Element: "Version of the Component", Field: "Node.Version",
+		Scope: ScopeNode, Check: hasVersion,
+	},
+	&Requirement{
+		ID: "ntia.identifier", Element: "Other Unique Identifiers", Field: "Node.Identifiers",
+		Scope: ScopeNode, Check: hasIdentifier,
+	},
+	&Requirement{
+		ID: "ntia.relationship", Element: "Dependency Relationship", Field: "NodeList.Edges",
+		Scope: ScopeNode, Check: isRelated,
+	},
+)
+
+// CheckNTIA checks that the document has the NTIA minimum elements: the
+// SBOM author and timestamp, and the supplier, name, version, a unique
+// identifier and the dependency relationships of every package.
+func CheckNTIA(doc *sbom.Document) (*Report, error) {
+	return Check(doc, NTIAProfile)
+}
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compliance
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// ntiaDocument returns a document meeting all the NTIA minimum elements
+func ntiaDocument() *sbom.Document {
+	return &sbom.Document{
+		Metadata: &sbom.Metadata{
+			Authors: []*sbom.Person{{Name: "ACME Corp", IsOrg: true}},
+			Date:    timestamppb.New(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		NodeList: &sbom.NodeList{
+			Nodes: []*sbom.Node{
+				{
+					Id: "app", Name: "app", Version: "1.0.0",
+					Suppliers:   []*sbom.Person{{Name: "ACME Corp"}},
+					Identifiers: map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "pkg:generic/app@1.0.0"},
+				},
+				{
+					Id: "lib", Name: "lib", Version: "2.0.0",
+					Suppliers:   []*sbom.Person{{Name: "Lib Maintainers"}},
+					Identifiers: map[int32]string{int32(sbom.SoftwareIdentifierType_CPE23): "cpe:2.3:a:lib:lib:2.0.0:*:*:*:*:*:*:*"},
+				},
+				{Id: "file", Type: sbom.Node_FILE, Name: "README"},
+			},
+			Edges: []*sbom.Edge{
+				{Type: sbom.Edge_dependsOn, From: "app", To: []string{"lib"}},
+			},
+			RootElements: []string{"app"},
+		},
+	}
+}
+
+func TestCheckNTIA(t *testing.T) {
+	for name, tc := range map[string]struct {
+		prepare  func(*sbom.Document)
+		document []string
+		nodes    map[string][]string
+	}{
+		"compliant": {
+			prepare: func(*sbom.Document) {},
+		},
+		"no author": {
+			prepare: func(doc *sbom.Document) {
+				doc.Metadata.Authors = []*sbom.Person{{Email: "sbom@example.com"}}
+			},
+			document: []string{"ntia.author"},
+		},
+		"no metadata": {
+			prepare: func(doc *sbom.Document) {
+				doc.Metadata = nil
+			},
+			document: []string{"ntia.author", "ntia.timestamp"},
+		},
+		"zero timestamp": {
+			prepare: func(doc *sbom.Document) {
+				doc.Metadata.Date = timestamppb.New(time.Unix(0, 0))
+			},
+			document: []string{"ntia.timestamp"},
+		},
+		"missing node fields": {
+			prepare: func(doc *sbom.Document) {
+				doc.NodeList.Nodes[1].Suppliers = nil
+				doc.NodeList.Nodes[1].Version = ""
+				doc.NodeList.Nodes[1].Identifiers = map[int32]string{1: ""}
+			},
+			nodes: map[string][]string{"lib": {"ntia.supplier", "ntia.version", "ntia.identifier"}},
+		},
+		"unnamed supplier": {
+			prepare: func(doc *sbom.Document) {
+				doc.NodeList.Nodes[0].Suppliers = []*sbom.Person{{Url: "https://example.com"}}
+				doc.NodeList.Nodes[0].Name = ""
+			},
+			nodes: map[string][]string{"app": {"ntia.supplier", "ntia.name"}},
+		},
+		"no relationships": {
+			prepare: func(doc *sbom.Document) {
+				doc.NodeList.Edges = []*sbom.Edge{}
+			},
+			document: []string{"ntia.dependencies"},
+			nodes:    map[string][]string{"app": {"ntia.relationship"}, "lib": {"ntia.relationship"}},
+		},
+		"no roots": {
+			prepare: func(doc *sbom.Document) {
+				doc.NodeList.RootElements = []string{}
+			},
+			document: []string{"ntia.dependencies"},
+		},
+		"single package": {
+			prepare: func(doc *sbom.Document) {
+				doc.NodeList.Nodes = doc.NodeList.Nodes[:1]
+				doc.NodeList.Edges = []*sbom.Edge{}
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			doc := ntiaDocument()
+			tc.prepare(doc)
+			report, err := CheckNTIA(doc)
+			require.NoError(t, err)
+			require.Equal(t, NTIA, report.Standard)
+
+			require.Equal(t, len(tc.document) == 0, report.Document.Passed)
+			require.Equal(t, tc.document, requirementIDs(report.Document.Missing))
+
+			failed := 0
+			for _, n := range report.Nodes {
+				require.NotEqual(t, "file", n.NodeID)
+				require.Equal(t, len(tc.nodes[n.NodeID]) == 0, n.Passed, n.NodeID)
+				require.Equal(t, tc.nodes[n.NodeID], requirementIDs(n.Missing), n.NodeID)
+				if !n.Passed {
+					failed++
+				}
+			}
+			require.Equal(t, failed, report.Summary.Failed)
+			require.Len(t, report.Failed(), failed)
+			require.Equal(t, report.Summary.Nodes, report.Summary.Passed+report.Summary.Failed)
+			require.Equal(t, len(tc.document) == 0 && len(tc.nodes) == 0, report.Passed)
+		})
+	}
+
+	_, err := CheckNTIA(nil)
+	require.Error(t, err)
+}
+
+func TestReportWrite(t *testing.T) {
+	doc := ntiaDocument()
+	doc.NodeList.Nodes[1].Version = ""
+	report, err := CheckNTIA(doc)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.Write(&buf))
+
+	parsed := map[string]any{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &parsed))
+	require.Equal(t, false, parsed["passed"])
+	nodes, ok := parsed["nodes"].([]any)
+	require.True(t, ok)
+	require.Len(t, nodes, 2)
+	require.Equal(t, map[string]any{
+		"node":   "lib",
+		"name":   "lib",
+		"passed": false,
+		"missing": []any{map[string]any{
+			"requirement": "ntia.version",
+			"element":     "Version of the Component",
+			"field":       "Node.Version",
+		}},
+	}, nodes[1])
+}
+
+// requirementIDs returns the IDs of the missing requirements or nil if
+// there are none.
+func requirementIDs(findings []*Finding) []string {
+	var ids []string
+	for _, f := range findings {
+		ids = append(ids, f.Requirement)
+	}
+	return ids
+}
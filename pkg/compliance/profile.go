@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compliance
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// Profile is a set of requirements defined by an SBOM standard
+type Profile interface {
+	// Name identifies the profile in reports and in the registry
+	Name() string
+
+	// Requirements returns the requirements checked by the profile
+	Requirements() []*Requirement
+}
+
+// profile is a Profile with a fixed list of requirements
+type profile struct {
+	name         string
+	requirements []*Requirement
+}
+
+// NewProfile creates a profile from a list of requirements
+func NewProfile(name string, requirements ...*Requirement) Profile {
+	return &profile{name: name, requirements: requirements}
+}
+
+// Name returns the name of the profile
+func (p *profile) Name() string {
+	return p.name
+}
+
+// Requirements returns the requirements of the profile
+func (p *profile) Requirements() []*Requirement {
+	return p.requirements
+}
+
+var (
+	registryMutex sync.RWMutex
+	registry      = map[string]Profile{}
+)
+
+func init() {
+	for _, p := range []Profile{NTIAProfile, BSIProfile, CISAProfile} {
+		if err := RegisterProfile(p); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterProfile adds a profile to the registry so it can be looked up by
+// name with GetProfile.
+func RegisterProfile(p Profile) error {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	if _, ok := registry[p.Name()]; ok {
+		return fmt.Errorf("profile %q is already registered", p.Name())
+	}
+	registry[p.Name()] = p
+	return nil
+}
+
+// GetProfile returns a registered profile
+func GetProfile(name string) (Profile, bool) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	p, ok := registry[name]
+	return p, ok
+}
+
+// Profiles returns the registered profiles sorted by name
+func Profiles() []Profile {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	ret := make([]Profile, 0, len(registry))
+	for _, p := range registry {
+		ret = append(ret, p)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name() < ret[j].Name()
+	})
+	return ret
+}
+
+// CheckProfile checks the document against a registered profile
+func CheckProfile(doc *sbom.Document, name string) (*Report, error) {
+	p, ok := GetProfile(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown compliance profile %q", name)
+	}
+	return Check(doc, p)
+}
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compliance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+func TestRegistry(t *testing.T) {
+	names := []string{}
+	for _, p := range Profiles() {
+		names = append(names, p.Name())
+	}
+	require.Equal(t, []string{BSI, CISA, NTIA}, names)
+
+	p, ok := GetProfile(BSI)
+	require.True(t, ok)
+	require.Equal(t, BSIProfile, p)
+
+	_, ok = GetProfile("unknown")
+	require.False(t, ok)
+
+	require.Error(t, RegisterProfile(NewProfile(NTIA)))
+
+	_, err := CheckProfile(ntiaDocument(), "unknown")
+	require.Error(t, err)
+	report, err := CheckProfile(ntiaDocument(), NTIA)
+	require.NoError(t, err)
+	require.True(t, report.Passed)
+}
+
+func TestCustomProfile(t *testing.T) {
+	custom := NewProfile("custom",
+		&Requirement{
+			ID: "custom.roots", Element: "Single root", Field: "NodeList.RootElements", Scope: ScopeDocument,
+			Check: func(ctx *Context, _ *sbom.Node) bool {
+				return len(ctx.Document().GetNodeList().GetRootElements()) == 1
+			},
+		},
+		&Requirement{
+			ID: "custom.description", Element: "Description", Field: "Node.Description", Scope: ScopeNode,
+			Check: func(ctx *Context, n *sbom.Node) bool {
+				return ctx.IsRoot(n.Id) || n.Description != ""
+			},
+		},
+	)
+	report, err := Check(ntiaDocument(), custom)
+	require.NoError(t, err)
+	require.Equal(t, "custom", report.Standard)
+	require.True(t, report.Document.Passed)
+	require.Len(t, report.Failed(), 1)
+	require.Equal(t, "lib", report.Failed()[0].NodeID)
+	require.Equal(t, []string{"custom.description"}, requirementIDs(report.Failed()[0].Missing))
+
+	_, err = Check(ntiaDocument(), nil)
+	require.Error(t, err)
+	_, err = Check(ntiaDocument(), NewProfile("invalid", &Requirement{ID: "nocheck", Scope: ScopeNode}))
+	require.Error(t, err)
+	_, err = Check(ntiaDocument(), NewProfile("invalid", &Requirement{
+		ID: "noscope", Check: func(*Context, *sbom.Node) bool { return true },
+	}))
+	require.Error(t, err)
+}
+
+// bsiDocument returns a document meeting the BSI TR-03183-2 requirements
+func bsiDocument() *sbom.Document {
+	doc := ntiaDocument()
+	doc.Metadata.Authors[0].Email = "sbom@example.com"
+	for _, n := range doc.NodeList.Nodes {
+		n.Originators = []*sbom.Person{{Name: n.Name, Url: "https://example.com/" + n.Name}}
+		n.FileName = n.Name + ".tar.gz"
+		n.Licenses = []string{"Apache-2.0"}
+		n.LicenseConcluded = "Apache-2.0"
+		n.Hashes = map[int32]string{int32(sbom.HashAlgorithm_SHA512): "e9c5a2"}
+		n.PrimaryPurpose = []sbom.Purpose{sbom.Purpose_LIBRARY}
+		n.AddProperty(BSIPropertyExecutable, "non-executable")
+		n.AddProperty(BSIPropertyArchive, "archive")
+		n.AddProperty(BSIPropertyStructured, "structured")
+	}
+	return doc
+}
+
+func TestCheckBSI(t *testing.T) {
+	for name, tc := range map[string]struct {
+		prepare  func(*sbom.Document)
+		document []string
+		lib      []string
+	}{
+		"compliant": {
+			prepare: func(*sbom.Document) {},
+		},
+		"author without contact": {
+			prepare: func(doc *sbom.Document) {
+				doc.Metadata.Authors[0].Email = ""
+			},
+			document: []string{"bsi.creator"},
+		},
+		"author with url": {
+			prepare: func(doc *sbom.Document) {
+				doc.Metadata.Authors[0].Email = ""
+				doc.Metadata.Authors[0].Url = "https://example.com"
+			},
+		},
+		"supplier contact": {
+			prepare: func(doc *sbom.Document) {
+				lib := doc.NodeList.Nodes[1]
+				lib.Originators = nil
+				lib.Suppliers[0].Email = "lib@example.com"
+			},
+			lib: []string{"bsi.component.creator"},
+		},
+		"originator with url": {
+			prepare: func(doc *sbom.Document) {
+				doc.NodeList.Nodes[1].Originators = []*sbom.Person{{Name: "lib", Url: "https://example.com"}}
+			},
+		},
+		"missing component fields": {
+			prepare: func(doc *sbom.Document) {
+				lib := doc.NodeList.Nodes[1]
+				lib.Originators = []*sbom.Person{{Name: "no contact"}}
+				lib.FileName = ""
+				lib.Licenses = []string{"NOASSERTION"}
+				lib.LicenseConcluded = "NOASSERTION"
+				lib.Properties = lib.Properties[1:]
+			},
+			lib: []string{
+				"bsi.component.creator", "bsi.component.filename", "bsi.component.license.declared",
+				"bsi.component.license.concluded", "bsi.component.properties",
+			},
+		},
+		"purpose without properties": {
+			prepare: func(doc *sbom.Document) {
+				doc.NodeList.Nodes[1].Properties = nil
+			},
+			lib: []string{"bsi.component.properties"},
+		},
+		"weak hash": {
+			prepare: func(doc *sbom.Document) {
+				doc.NodeList.Nodes[1].Hashes = map[int32]string{int32(sbom.HashAlgorithm_SHA256): "abc"}
+			},
+			lib: []string{"bsi.component.hash"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			doc := bsiDocument()
+			tc.prepare(doc)
+			report, err := Check(doc, BSIProfile)
+			require.NoError(t, err)
+			require.Equal(t, BSI, report.Standard)
+			require.Equal(t, tc.document, requirementIDs(report.Document.Missing))
+			require.Equal(t, tc.lib, requirementIDs(report.Nodes[1].Missing))
+			require.True(t, report.Nodes[0].Passed)
+			require.Equal(t, len(tc.document) == 0 && len(tc.lib) == 0, report.Passed)
+		})
+	}
+}
+
+func TestCheckCISA(t *testing.T) {
+	doc := bsiDocument()
+	report, err := Check(doc, CISAProfile)
+	require.NoError(t, err)
+	require.Equal(t, []string{"cisa.type"}, requirementIDs(report.Document.Missing))
+	for _, n := range report.Nodes {
+		require.Equal(t, []string{"cisa.component.copyright"}, requirementIDs(n.Missing))
+	}
+
+	doc.Metadata.DocumentTypes = []*sbom.DocumentType{{Type: sbom.DocumentType_BUILD.Enum()}}
+	for _, n := range doc.NodeList.Nodes {
+		n.Copyright = "Copyright ACME Corp"
+	}
+	report, err = Check(doc, CISAProfile)
+	require.NoError(t, err)
+	require.True(t, report.Passed)
+
+	// CISA accepts any hash algorithm and either declared or concluded licenses
+	lib := doc.NodeList.Nodes[1]
+	lib.Hashes = map[int32]string{int32(sbom.HashAlgorithm_SHA1): "abc"}
+	lib.Licenses = []string{}
+	report, err = Check(doc, CISAProfile)
+	require.NoError(t, err)
+	require.True(t, report.Passed)
+
+	lib.Hashes = map[int32]string{}
+	lib.LicenseConcluded = ""
+	doc.NodeList.RootElements = []string{}
+	report, err = Check(doc, CISAProfile)
+	require.NoError(t, err)
+	require.Equal(t, []string{"cisa.primary"}, requirementIDs(report.Document.Missing))
+	require.Equal(t, []string{"cisa.component.hash", "cisa.component.license"}, requirementIDs(report.Nodes[1].Missing))
+}
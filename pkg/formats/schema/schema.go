@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: Copyright 2023 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package schema validates native SBOM documents against JSON schemas
+// embedded in the package so validation works offline. The CycloneDX schemas
+// are verbatim copies of the published ones. SPDX documents are validated
+// against the upstream SPDX schemas when they are vendored and against the
+// schemas maintained by protobom otherwise, see schemas/README.md.
+package schema
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/protobom/protobom/pkg/formats"
+)
+
+//go:embed schemas/*.json
+var schemaFS embed.FS
+
+// baseURL is the location the CycloneDX schemas expect their companions
+// (spdx.schema.json and jsf-0.82.schema.json) to be found at. All embedded
+// schemas are registered under it so relative references resolve without
+// hitting the network.
+const baseURL = "http://cyclonedx.org/schema/"
+
+// schemaFiles maps each supported format to the embedded schema files that
+// describe it, in order of preference. The first file found is used.
+var schemaFiles = map[formats.Format][]string{
+	formats.CDX12JSON:  {"bom-1.2.schema.json"},
+	formats.CDX13JSON:  {"bom-1.3.schema.json"},
+	formats.CDX14JSON:  {"bom-1.4.schema.json"},
+	formats.CDX15JSON:  {"bom-1.5.schema.json"},
+	formats.SPDX22JSON: {"spdx-2.2.schema.json", "spdx-2.2.protobom.schema.json"},
+	formats.SPDX23JSON: {"spdx-2.3.schema.json", "spdx-2.3.protobom.schema.json"},
+}
+
+// schemaFile returns the name of the embedded schema file used to validate
+// documents in format.
+func schemaFile(format formats.Format) (string, bool) {
+	for _, name := range schemaFiles[format] {
+		if _, err := fs.Stat(schemaFS, "schemas/"+name); err == nil {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// ErrUnsupportedFormat is returned when there is no bundled schema for the
+// requested format.
+var ErrUnsupportedFormat = errors.New("no schema available for format")
+
+// Violation describes a single place where a document does not conform to
+// its schema.
+type Violation struct {
+	// Path is the JSON pointer to the offending value in the document
+	Path string `json:"path"`
+
+	// SchemaPath is the location of the failing keyword in the schema
+	SchemaPath string `json:"schemaPath"`
+
+	// Message is the human readable error reported by the validator
+	Message string `json:"message"`
+}
+
+// String returns a one line representation of the violation
+func (v Violation) String() string {
+	path := v.Path
+	if path == "" {
+		path = "/"
+	}
+	return fmt.Sprintf("%s: %s", path, v.Message)
+}
+
+// ValidationError is returned when a document fails schema validation. It
+// carries every violation found so callers can report all of them at once.
+type ValidationError struct {
+	Format     formats.Format
+	Violations []Violation
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	msgs := []string{}
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.String())
+	}
+	return fmt.Sprintf(
+		"document does not conform to %s schema (%d violations): %s",
+		e.Format, len(e.Violations), strings.Join(msgs, "; "),
+	)
+}
+
+// SupportsFormat returns true if there is a bundled schema for format
+func SupportsFormat(format formats.Format) bool {
+	_, ok := schemaFile(format)
+	return ok
+}
+
+// Validator checks documents against the bundled schemas. Schemas are
+// compiled lazily the first time a format is validated and then cached.
+// A Validator is safe for concurrent use.
+type Validator struct {
+	mtx      sync.Mutex
+	compiled map[formats.Format]*jsonschema.Schema
+}
+
+// NewValidator returns a new schema validator
+func NewValidator() *Validator {
+	return &Validator{
+		compiled: map[formats.Format]*jsonschema.Schema{},
+	}
+}
+
+var defaultValidator = NewValidator()
+
+// Validate reads a document from r and checks it against the schema of
+// format using a shared validator.
+func Validate(format formats.Format, r io.Reader) error {
+	return defaultValidator.Validate(format, r)
+}
+
+// Validate reads a document from r and checks it against the schema of
+// format. If the document does not conform, the returned error is a
+// *ValidationError listing the violations.
+func (v *Validator) Validate(format formats.Format, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("reading document: %w", err)
+	}
+	return v.ValidateBytes(format, data)
+}
+
+// ValidateBytes checks the document in data against the schema of format.
+func (v *Validator) ValidateBytes(format formats.Format, data []byte) error {
+	s, err := v.schema(format)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return fmt.Errorf("decoding JSON document: %w", err)
+	}
+
+	if err := s.Validate(doc); err != nil {
+		verr := &jsonschema.ValidationError{}
+		if !errors.As(err, &verr) {
+			return fmt.Errorf("validating document: %w", err)
+		}
+		return &ValidationError{
+			Format:     format,
+			Violations: violationsFromError(verr),
+		}
+	}
+	return nil
+}
+
+// schema returns the compiled schema for format, compiling it if needed.
+func (v *Validator) schema(format formats.Format) (*jsonschema.Schema, error) {
+	fileName, ok := schemaFile(format)
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedFormat, format)
+	}
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if s, ok := v.compiled[format]; ok {
+		return s, nil
+	}
+
+	compiler, err := newCompiler()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := compiler.Compile(baseURL + fileName)
+	if err != nil {
+		return nil, fmt.Errorf("compiling %s schema: %w", format, err)
+	}
+
+	v.compiled[format] = s
+	return s, nil
+}
+
+// newCompiler returns a compiler with all the embedded schemas loaded.
+func newCompiler() (*jsonschema.Compiler, error) {
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft7
+
+	entries, err := schemaFS.ReadDir("schemas")
+	if err != nil {
+		return nil, fmt.Errorf("reading embedded schemas: %w", err)
+	}
+
+	for _, e := range entries {
+		data, err := schemaFS.ReadFile("schemas/" + e.Name())
+		if err != nil {
+			return nil, fmt.Errorf("reading schema %s: %w", e.Name(), err)
+		}
+		if err := compiler.AddResource(baseURL+e.Name(), bytes.NewReader(data)); err != nil {
+			return nil, fmt.Errorf("loading schema %s: %w", e.Name(), err)
+		}
+	}
+	return compiler, nil
+}
+
+// violationsFromError flattens the tree of errors returned by the validator
+// into a list of violations. Only the leaves are kept as the intermediate
+// nodes only say that some subschema failed.
+func violationsFromError(verr *jsonschema.ValidationError) []Violation {
+	ret := []Violation{}
+	seen := map[Violation]struct{}{}
+
+	var walk func(*jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) > 0 {
+			for _, c := range e.Causes {
+				walk(c)
+			}
+			return
+		}
+		v := Violation{
+			Path:       e.InstanceLocation,
+			SchemaPath: e.KeywordLocation,
+			Message:    e.Message,
+		}
+		if _, ok := seen[v]; ok {
+			return
+		}
+		seen[v] = struct{}{}
+		ret = append(ret, v)
+	}
+	walk(verr)
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Path < ret[j].Path
+	})
+	return ret
+}
@@ -0,0 +1,130 @@
+package schema
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/protobom/protobom/pkg/formats"
+)
+
+func TestValidate(t *testing.T) {
+	v := NewValidator()
+	for name, tc := range map[string]struct {
+		format     formats.Format
+		filename   string
+		data       string
+		mustError  bool
+		violations bool
+	}{
+		"valid spdx 2.2": {
+			format:   formats.SPDX22JSON,
+			filename: "../testdata/linux-x64-manifest.spdx.json",
+		},
+		"valid spdx 2.3": {
+			format:   formats.SPDX23JSON,
+			filename: "../testdata/nginx.spdx.json",
+		},
+		"valid cyclonedx 1.4": {
+			format:   formats.CDX14JSON,
+			filename: "../testdata/juice-shop-11.1.2.cdx.json",
+		},
+		"minimal cyclonedx 1.5": {
+			format: formats.CDX15JSON,
+			data:   `{"bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1}`,
+		},
+		"cyclonedx missing required field": {
+			format:     formats.CDX15JSON,
+			data:       `{"specVersion": "1.5"}`,
+			mustError:  true,
+			violations: true,
+		},
+		"cyclonedx wrong component type": {
+			format:     formats.CDX13JSON,
+			data:       `{"bomFormat": "CycloneDX", "specVersion": "1.3", "components": [{"type": "spaceship", "name": "x", "version": "1"}]}`,
+			mustError:  true,
+			violations: true,
+		},
+		"spdx missing required fields": {
+			format:     formats.SPDX23JSON,
+			data:       `{"spdxVersion": "SPDX-2.3", "packages": [{"name": "nopkgid"}]}`,
+			mustError:  true,
+			violations: true,
+		},
+		"invalid json": {
+			format:    formats.SPDX23JSON,
+			data:      `{"spdxVersion": `,
+			mustError: true,
+		},
+		"unsupported format": {
+			format:    formats.SPDX23TV,
+			data:      `SPDXVersion: SPDX-2.3`,
+			mustError: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data := []byte(tc.data)
+			if tc.filename != "" {
+				var err error
+				data, err = os.ReadFile(tc.filename)
+				require.NoError(t, err)
+			}
+			err := v.Validate(tc.format, strings.NewReader(string(data)))
+			if !tc.mustError {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+
+			verr := &ValidationError{}
+			require.Equal(t, tc.violations, errors.As(err, &verr))
+			if tc.violations {
+				require.Equal(t, tc.format, verr.Format)
+				require.NotEmpty(t, verr.Violations)
+			}
+		})
+	}
+}
+
+func TestValidationErrorPaths(t *testing.T) {
+	err := Validate(
+		formats.SPDX23JSON,
+		strings.NewReader(`{"SPDXID": "SPDXRef-DOCUMENT", "spdxVersion": "SPDX-2.3", "name": "test", "dataLicense": "CC0-1.0", "creationInfo": {"created": "2023-01-01T00:00:00Z", "creators": ["Tool: test"]}, "packages": [{"SPDXID": "SPDXRef-Package", "name": "pkg"}]}`),
+	)
+	require.Error(t, err)
+	verr := &ValidationError{}
+	require.True(t, errors.As(err, &verr))
+	require.Len(t, verr.Violations, 1)
+	require.Equal(t, "/packages/0", verr.Violations[0].Path)
+	require.Contains(t, verr.Violations[0].Message, "downloadLocation")
+}
+
+func TestSupportsFormat(t *testing.T) {
+	require.True(t, SupportsFormat(formats.CDX12JSON))
+	require.True(t, SupportsFormat(formats.SPDX22JSON))
+	require.False(t, SupportsFormat(formats.CDX10JSON))
+	require.False(t, SupportsFormat(formats.SPDX23TV))
+}
+
+func TestSchemaFile(t *testing.T) {
+	// Every format has at least one of its schema files embedded
+	for format, files := range schemaFiles {
+		name, ok := schemaFile(format)
+		require.True(t, ok, format)
+		require.Contains(t, files, name)
+	}
+
+	// Upstream SPDX schemas are preferred over the protobom ones
+	for _, format := range []formats.Format{formats.SPDX22JSON, formats.SPDX23JSON} {
+		name, ok := schemaFile(format)
+		require.True(t, ok)
+		if _, err := schemaFS.ReadFile("schemas/" + schemaFiles[format][0]); err == nil {
+			require.Equal(t, schemaFiles[format][0], name)
+		} else {
+			require.Equal(t, schemaFiles[format][1], name)
+		}
+	}
+}
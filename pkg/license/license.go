@@ -0,0 +1,398 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package license parses, normalizes and evaluates SPDX license expressions
+// such as:
+//
+//	(MIT OR Apache-2.0) AND GPL-2.0-only WITH Classpath-exception-2.0
+//
+// License and exception identifiers are matched against a bundled copy of
+// the SPDX license list ignoring case. Expressions can be normalized to
+// replace deprecated identifiers and checked against allow or deny lists.
+package license
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// invalidIDCharsRe matches the characters not allowed in SPDX identifiers
+var invalidIDCharsRe = regexp.MustCompile(`[^a-zA-Z0-9.-]+`)
+
+// ErrUnknownLicense is returned when validating expressions that reference
+// identifiers not in the SPDX license list.
+var ErrUnknownLicense = errors.New("unknown license identifier")
+
+// Expression is a parsed SPDX license expression. It is either a single
+// *License or a *Compound expression joining others with AND or OR.
+type Expression interface {
+	// String returns the expression in SPDX syntax
+	String() string
+
+	// Licenses returns all the licenses in the expression
+	Licenses() []*License
+
+	// Choices returns the expression in disjunctive normal form: a list of
+	// alternatives, each one being the set of licenses that have to be
+	// complied with when choosing it. The number of choices grows
+	// exponentially with the ORs nested in AND expressions, use a List to
+	// check expressions against allow or deny lists.
+	Choices() [][]*License
+
+	// Normalize returns a copy of the expression with the deprecated SPDX
+	// identifiers replaced by their current equivalents.
+	Normalize() Expression
+
+	// Validate returns an error if the expression references license or
+	// exception identifiers not in the SPDX list. Custom licenses declared
+	// with the LicenseRef- prefix are always valid.
+	Validate() error
+}
+
+// Operator joins the expressions of a compound expression
+type Operator string
+
+const (
+	// And requires complying with all the expressions
+	And Operator = "AND"
+
+	// Or offers a choice between the expressions
+	Or Operator = "OR"
+)
+
+// License is a single license in an expression, with an optional exception
+// and the or-later (+) operator.
+type License struct {
+	ID        string
+	OrLater   bool
+	Exception string
+}
+
+// String returns the license in SPDX syntax
+func (l *License) String() string {
+	s := l.ID
+	if l.OrLater {
+		s += "+"
+	}
+	if l.Exception != "" {
+		s += " WITH " + l.Exception
+	}
+	return s
+}
+
+// Licenses returns the license itself
+func (l *License) Licenses() []*License {
+	return []*License{l}
+}
+
+// Choices returns the license as the only choice
+func (l *License) Choices() [][]*License {
+	return [][]*License{{l}}
+}
+
+// NewLicenseRef returns a custom license for text that is not a license
+// expression, such as a license name. Its LicenseRef- identifier is made
+// from the text replacing the characters not allowed in SPDX identifiers
+// with dashes.
+func NewLicenseRef(text string) *License {
+	id := strings.Trim(invalidIDCharsRe.ReplaceAllString(text, "-"), "-")
+	if id == "" {
+		id = "unknown"
+	}
+	return &License{ID: LicenseRefPrefix + id}
+}
+
+// IsSPDX returns true if the license identifier is in the SPDX list
+func (l *License) IsSPDX() bool {
+	_, ok := LookupLicense(l.ID)
+	return ok
+}
+
+// IsLicenseRef returns true if the license is a custom license declared with
+// a LicenseRef- identifier.
+func (l *License) IsLicenseRef() bool {
+	return isLicenseRef(l.ID)
+}
+
+// Validate checks the license and exception identifiers
+func (l *License) Validate() error {
+	if l.ID == None || l.ID == NoAssertion {
+		return nil
+	}
+	if !l.IsSPDX() && !l.IsLicenseRef() {
+		return fmt.Errorf("%w: %q", ErrUnknownLicense, l.ID)
+	}
+	if l.Exception == "" || hasPrefixFold(l.Exception, AdditionRefPrefix) {
+		return nil
+	}
+	if _, ok := LookupException(l.Exception); !ok {
+		return fmt.Errorf("%w: exception %q", ErrUnknownLicense, l.Exception)
+	}
+	return nil
+}
+
+// isDeprecated returns true if Normalize would replace the license
+func (l *License) isDeprecated() bool {
+	if _, ok := deprecatedLicenses[l.ID]; ok {
+		return true
+	}
+	_, ok := deprecatedLicenses[l.ID+"+"]
+	return ok && l.OrLater
+}
+
+// Normalize replaces the license identifier if it is deprecated. Deprecated
+// GNU identifiers are replaced by their -only or -or-later variants and
+// those bundling an exception are split into a WITH expression.
+func (l *License) Normalize() Expression {
+	replacement, ok := deprecatedLicenses[l.ID+"+"]
+	if !ok || !l.OrLater {
+		replacement, ok = deprecatedLicenses[l.ID]
+	}
+	if !ok {
+		return &License{ID: l.ID, OrLater: l.OrLater, Exception: l.Exception}
+	}
+
+	// Replacements are single licenses from the bundled table
+	ret := mustParseLicense(replacement)
+	if l.OrLater && !strings.HasSuffix(ret.ID, "-or-later") {
+		if base, ok := strings.CutSuffix(ret.ID, "-only"); ok {
+			ret.ID = base + "-or-later"
+		} else {
+			ret.OrLater = true
+		}
+	}
+	if l.Exception != "" {
+		ret.Exception = l.Exception
+	}
+	return ret
+}
+
+// Compound is an expression joining others with an operator
+type Compound struct {
+	Operator    Operator
+	Expressions []Expression
+}
+
+// String returns the expression in SPDX syntax. OR expressions nested in an
+// AND are enclosed in parentheses.
+func (c *Compound) String() string {
+	parts := []string{}
+	for _, e := range c.Expressions {
+		s := e.String()
+		if sub, ok := e.(*Compound); ok && c.Operator == And && sub.Operator != And && len(sub.Expressions) > 1 {
+			s = "(" + s + ")"
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, " "+string(c.Operator)+" ")
+}
+
+// Licenses returns all the licenses in the expression
+func (c *Compound) Licenses() []*License {
+	ret := []*License{}
+	for _, e := range c.Expressions {
+		ret = append(ret, e.Licenses()...)
+	}
+	return ret
+}
+
+// Choices returns the alternatives of the expression. OR concatenates the
+// choices of its operands while AND combines them.
+func (c *Compound) Choices() [][]*License {
+	if c.Operator == Or {
+		ret := [][]*License{}
+		for _, e := range c.Expressions {
+			ret = append(ret, e.Choices()...)
+		}
+		return ret
+	}
+
+	ret := [][]*License{{}}
+	for _, e := range c.Expressions {
+		next := [][]*License{}
+		for _, prefix := range ret {
+			for _, choice := range e.Choices() {
+				combined := make([]*License, 0, len(prefix)+len(choice))
+				combined = append(combined, prefix...)
+				combined = append(combined, choice...)
+				next = append(next, combined)
+			}
+		}
+		ret = next
+	}
+	return ret
+}
+
+// Normalize returns a copy of the expression with all its licenses normalized
+func (c *Compound) Normalize() Expression {
+	ret := &Compound{Operator: c.Operator, Expressions: []Expression{}}
+	for _, e := range c.Expressions {
+		ret.Expressions = append(ret.Expressions, e.Normalize())
+	}
+	return ret
+}
+
+// Validate checks all the licenses of the expression
+func (c *Compound) Validate() error {
+	errs := []error{}
+	for _, l := range c.Licenses() {
+		if err := l.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Join combines expressions with the operator. Nil expressions are skipped
+// and a single expression is returned as is.
+func Join(op Operator, exprs ...Expression) Expression {
+	ret := &Compound{Operator: op, Expressions: []Expression{}}
+	for _, e := range exprs {
+		if e == nil {
+			continue
+		}
+		// Flatten expressions using the same operator
+		if sub, ok := e.(*Compound); ok && sub.Operator == op {
+			ret.Expressions = append(ret.Expressions, sub.Expressions...)
+			continue
+		}
+		ret.Expressions = append(ret.Expressions, e)
+	}
+	switch len(ret.Expressions) {
+	case 0:
+		return nil
+	case 1:
+		return ret.Expressions[0]
+	default:
+		return ret
+	}
+}
+
+// IsAllowed returns true if the expression can be complied with using only
+// licenses in the allow list. For example, MIT OR GPL-3.0-only is allowed if
+// MIT is in the list. Both the expression and the list are normalized before
+// matching. A list entry with an exception (GPL-2.0-only WITH
+// Classpath-exception-2.0) only matches licenses with the same exception
+// while an entry with just the license matches it with any exception. The
+// or-later operator has to match too: Apache-2.0+ is not matched by an
+// Apache-2.0 entry, just as GPL-2.0-or-later is not matched by GPL-2.0-only.
+func IsAllowed(e Expression, allowList []string) bool {
+	return NewList(allowList).Allows(e)
+}
+
+// IsDenied returns true if the expression cannot be complied with without
+// using a license in the deny list. For example, MIT OR GPL-3.0-only is not
+// denied by a list with GPL-3.0-only as MIT can be chosen instead. Entries
+// are matched as in IsAllowed.
+func IsDenied(e Expression, denyList []string) bool {
+	return NewList(denyList).Denies(e)
+}
+
+// List is a parsed allow or deny list of licenses. Parsing a list once and
+// reusing it avoids the cost of IsAllowed and IsDenied parsing it on every
+// call.
+type List struct {
+	entries []*License
+}
+
+// NewList parses and normalizes the licenses of an allow or deny list.
+// Entries that are not single licenses are ignored.
+func NewList(entries []string) *List {
+	return &List{entries: parseList(entries)}
+}
+
+// Merge returns a new list with the entries of l and the other lists
+func (l *List) Merge(others ...*List) *List {
+	ret := &List{entries: append([]*License{}, l.entries...)}
+	for _, o := range others {
+		ret.entries = append(ret.entries, o.entries...)
+	}
+	return ret
+}
+
+// Allows returns true if the expression can be complied with using only
+// licenses in the list. See IsAllowed for details.
+func (l *List) Allows(e Expression) bool {
+	if e == nil {
+		return false
+	}
+	return satisfiable(e, l.contains)
+}
+
+// Denies returns true if the expression cannot be complied with without
+// using a license in the list. See IsDenied for details.
+func (l *List) Denies(e Expression) bool {
+	if e == nil {
+		return false
+	}
+	return !satisfiable(e, func(lic *License) bool { return !l.contains(lic) })
+}
+
+// contains returns true if the normalized license matches any list entry
+func (l *List) contains(lic *License) bool {
+	if lic.isDeprecated() {
+		if n, ok := lic.Normalize().(*License); ok {
+			lic = n
+		}
+	}
+	return matchesList(lic, l.entries)
+}
+
+// satisfiable returns true if the expression can be complied with choosing
+// only licenses for which ok returns true. It walks the expression instead
+// of expanding its choices: an OR needs one of its operands to be
+// satisfiable while an AND needs all of them.
+func satisfiable(e Expression, ok func(*License) bool) bool {
+	switch expr := e.(type) {
+	case *License:
+		return ok(expr)
+	case *Compound:
+		if expr.Operator == Or {
+			for _, sub := range expr.Expressions {
+				if satisfiable(sub, ok) {
+					return true
+				}
+			}
+			return false
+		}
+		for _, sub := range expr.Expressions {
+			if !satisfiable(sub, ok) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// parseList parses and normalizes the licenses of an allow or deny list.
+// Entries that are not single licenses are ignored.
+func parseList(list []string) []*License {
+	ret := []*License{}
+	for _, s := range list {
+		e, err := Parse(s)
+		if err != nil {
+			continue
+		}
+		if l, ok := e.Normalize().(*License); ok {
+			ret = append(ret, l)
+		}
+	}
+	return ret
+}
+
+// matchesList returns true if the license matches any list entry
+func matchesList(l *License, entries []*License) bool {
+	for _, entry := range entries {
+		if !strings.EqualFold(entry.ID, l.ID) || entry.OrLater != l.OrLater {
+			continue
+		}
+		if entry.Exception == "" || strings.EqualFold(entry.Exception, l.Exception) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	for expr, expected := range map[string]string{
+		"MIT":                              "MIT",
+		"GPL-2.0":                          "GPL-2.0-only",
+		"gpl-2.0+":                         "GPL-2.0-or-later",
+		"LGPL-2.1+ OR MIT":                 "LGPL-2.1-or-later OR MIT",
+		"AGPL-3.0+":                        "AGPL-3.0-or-later",
+		"GPL-3.0 WITH GCC-exception-3.1":   "GPL-3.0-only WITH GCC-exception-3.1",
+		"GPL-2.0-with-classpath-exception": "GPL-2.0-only WITH Classpath-exception-2.0",
+		"wxWindows AND (Nunit OR ISC)":     "GPL-2.0-or-later WITH WxWindows-exception-3.1 AND (zlib-acknowledgement OR ISC)",
+		"StandardML-NJ":                    "SMLNJ",
+		"Apache-2.0+":                      "Apache-2.0+",
+		"LicenseRef-GPL-2.0":               "LicenseRef-GPL-2.0",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			e, err := Parse(expr)
+			require.NoError(t, err)
+			require.Equal(t, expected, e.Normalize().String())
+		})
+	}
+
+	// Normalizing returns a copy
+	e := MustParse("GPL-2.0 OR MIT")
+	e.Normalize()
+	require.Equal(t, "GPL-2.0 OR MIT", e.String())
+}
+
+func TestChoices(t *testing.T) {
+	for expr, expected := range map[string][][]string{
+		"MIT":                          {{"MIT"}},
+		"MIT OR ISC":                   {{"MIT"}, {"ISC"}},
+		"MIT AND ISC":                  {{"MIT", "ISC"}},
+		"(MIT OR ISC) AND 0BSD":        {{"MIT", "0BSD"}, {"ISC", "0BSD"}},
+		"(MIT OR ISC) AND (0BSD OR X)": {{"MIT", "0BSD"}, {"MIT", "X"}, {"ISC", "0BSD"}, {"ISC", "X"}},
+		"MIT AND ISC OR 0BSD":          {{"MIT", "ISC"}, {"0BSD"}},
+	} {
+		t.Run(expr, func(t *testing.T) {
+			choices := [][]string{}
+			for _, c := range MustParse(expr).Choices() {
+				ids := []string{}
+				for _, l := range c {
+					ids = append(ids, l.ID)
+				}
+				choices = append(choices, ids)
+			}
+			require.Equal(t, expected, choices)
+		})
+	}
+}
+
+func TestLicenses(t *testing.T) {
+	e := MustParse("(MIT OR GPL-2.0+) AND LicenseRef-foo")
+	ids := []string{}
+	for _, l := range e.Licenses() {
+		ids = append(ids, l.String())
+	}
+	require.Equal(t, []string{"MIT", "GPL-2.0+", "LicenseRef-foo"}, ids)
+}
+
+func TestValidate(t *testing.T) {
+	for expr, valid := range map[string]bool{
+		"MIT":                                  true,
+		"NOASSERTION":                          true,
+		"LicenseRef-custom OR Apache-2.0":      true,
+		"GPL-2.0-only WITH LLVM-exception":     true,
+		"GPL-2.0-only WITH AdditionRef-custom": true,
+		"GPL-2.0":                              true,
+		"Foo-1.0":                              false,
+		"MIT AND Foo-1.0":                      false,
+		"GPL-2.0-only WITH Foo-exception":      false,
+	} {
+		t.Run(expr, func(t *testing.T) {
+			err := MustParse(expr).Validate()
+			if valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrUnknownLicense)
+			}
+		})
+	}
+}
+
+func TestJoin(t *testing.T) {
+	require.Nil(t, Join(And))
+	require.Nil(t, Join(Or, nil))
+	require.Equal(t, &License{ID: "MIT"}, Join(And, nil, &License{ID: "MIT"}))
+	require.Equal(t, "MIT AND ISC AND 0BSD", Join(And, MustParse("MIT AND ISC"), MustParse("0BSD")).String())
+	require.Equal(t, "(MIT OR ISC) AND 0BSD", Join(And, MustParse("MIT OR ISC"), MustParse("0BSD")).String())
+	require.Equal(t, "MIT OR ISC AND 0BSD", Join(Or, MustParse("MIT"), MustParse("ISC AND 0BSD")).String())
+}
+
+func TestIsAllowed(t *testing.T) {
+	allowList := []string{"MIT", "apache-2.0", "GPL-2.0", "ISC+", "LGPL-2.1-only WITH LGPL-3.0-linking-exception", "not a license"}
+	for expr, expected := range map[string]bool{
+		"MIT":                         true,
+		"MIT+":                        false,
+		"Apache-2.0+":                 false,
+		"GPL-2.0+":                    false,
+		"ISC":                         false,
+		"ISC+":                        true,
+		"MIT OR ISC":                  true,
+		"MIT AND ISC":                 false,
+		"(MIT OR ISC) AND Apache-2.0": true,
+		"GPL-2.0-only":                true,
+		"GPL-2.0-only WITH Classpath-exception-2.0": true,
+		"LGPL-2.1-only": false,
+		"LGPL-2.1 WITH LGPL-3.0-linking-exception":   true,
+		"LGPL-2.1-only WITH Classpath-exception-2.0": false,
+	} {
+		t.Run(expr, func(t *testing.T) {
+			require.Equal(t, expected, IsAllowed(MustParse(expr), allowList))
+		})
+	}
+	require.False(t, IsAllowed(nil, allowList))
+}
+
+func TestIsDenied(t *testing.T) {
+	denyList := []string{"GPL-3.0-only", "AGPL-3.0-or-later", "MIT WITH LicenseRef-weird"}
+	for expr, expected := range map[string]bool{
+		"MIT":                                 false,
+		"GPL-3.0":                             true,
+		"gpl-3.0-only":                        true,
+		"GPL-3.0-only OR MIT":                 false,
+		"GPL-3.0-only AND MIT":                true,
+		"(GPL-3.0-only OR MIT) AND ISC":       false,
+		"AGPL-3.0+":                           true,
+		"GPL-3.0-only WITH GCC-exception-3.1": true,
+		"GPL-3.0-or-later":                    false,
+	} {
+		t.Run(expr, func(t *testing.T) {
+			require.Equal(t, expected, IsDenied(MustParse(expr), denyList))
+		})
+	}
+	require.False(t, IsDenied(nil, denyList))
+}
+
+func TestNewLicenseRef(t *testing.T) {
+	for text, expected := range map[string]string{
+		"Apache License 2.0": "LicenseRef-Apache-License-2.0",
+		"(c) Acme, Inc.":     "LicenseRef-c-Acme-Inc.",
+		"   ":                "LicenseRef-unknown",
+	} {
+		l := NewLicenseRef(text)
+		require.Equal(t, expected, l.String())
+		require.NoError(t, l.Validate())
+	}
+}
+
+func TestList(t *testing.T) {
+	allow := NewList([]string{"MIT"})
+	review := NewList([]string{"ISC"})
+	require.False(t, allow.Allows(MustParse("MIT AND ISC")))
+	require.True(t, allow.Merge(review).Allows(MustParse("MIT AND ISC")))
+	require.False(t, allow.Allows(MustParse("ISC")), "merging must not modify the list")
+
+	// Long expressions are evaluated without expanding their 2^40 choices
+	clauses := []string{}
+	for i := 0; i < 40; i++ {
+		clauses = append(clauses, "(MIT OR GPL-3.0 OR Apache-2.0)")
+	}
+	e := MustParse(strings.Join(clauses, " AND "))
+	require.True(t, allow.Allows(e))
+	require.False(t, review.Allows(e))
+	require.False(t, NewList([]string{"GPL-3.0-only"}).Denies(e))
+	require.True(t, NewList([]string{"GPL-3.0-only", "MIT", "Apache-2.0"}).Denies(e))
+}
+
+func TestLookup(t *testing.T) {
+	id, ok := LookupLicense("apache-2.0")
+	require.True(t, ok)
+	require.Equal(t, "Apache-2.0", id)
+
+	_, ok = LookupLicense("Apache License 2.0")
+	require.False(t, ok)
+
+	id, ok = LookupException("llvm-exception")
+	require.True(t, ok)
+	require.Equal(t, "LLVM-exception", id)
+
+	_, ok = LookupException("MIT")
+	require.False(t, ok)
+
+	require.NotEmpty(t, LicenseListVersion)
+	for _, id := range []string{"Unicode-3.0", "OpenSSL-standalone"} {
+		_, ok = LookupLicense(id)
+		require.True(t, ok, id)
+	}
+
+	require.True(t, IsDeprecated("gpl-2.0+"))
+	require.True(t, IsDeprecated("GPL-2.0"))
+	require.False(t, IsDeprecated("GPL-2.0-only"))
+	require.False(t, IsDeprecated("LicenseRef-GPL-2.0"))
+
+	// Every replacement must be a valid license of the list
+	for id, replacement := range deprecatedLicenses {
+		_, ok := LookupLicense(id)
+		require.True(t, ok, id)
+		l := mustParseLicense(replacement)
+		require.NoError(t, l.Validate(), replacement)
+		require.False(t, IsDeprecated(l.ID), replacement)
+	}
+}
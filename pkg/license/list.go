@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import "strings"
+
+// Special values used in SPDX documents in place of a license expression
+const (
+	None        = "NONE"
+	NoAssertion = "NOASSERTION"
+)
+
+// Prefixes of the identifiers of licenses and exceptions not in the SPDX list
+const (
+	LicenseRefPrefix  = "LicenseRef-"
+	DocumentRefPrefix = "DocumentRef-"
+	AdditionRefPrefix = "AdditionRef-"
+)
+
+var (
+	// licenseIndex maps the lowercase license IDs to their canonical form
+	licenseIndex = indexIDs(licenseIDs)
+
+	// exceptionIndex maps the lowercase exception IDs to their canonical form
+	exceptionIndex = indexIDs(exceptionIDs)
+)
+
+// deprecatedLicenses maps the deprecated license identifiers to the
+// expression that replaces them in the SPDX license list.
+var deprecatedLicenses = map[string]string{
+	"AGPL-1.0":                         "AGPL-1.0-only",
+	"AGPL-3.0":                         "AGPL-3.0-only",
+	"BSD-2-Clause-FreeBSD":             "BSD-2-Clause",
+	"BSD-2-Clause-NetBSD":              "BSD-2-Clause",
+	"bzip2-1.0.5":                      "bzip2-1.0.6",
+	"eCos-2.0":                         "GPL-2.0-or-later WITH eCos-exception-2.0",
+	"GFDL-1.1":                         "GFDL-1.1-only",
+	"GFDL-1.2":                         "GFDL-1.2-only",
+	"GFDL-1.3":                         "GFDL-1.3-only",
+	"GPL-1.0":                          "GPL-1.0-only",
+	"GPL-1.0+":                         "GPL-1.0-or-later",
+	"GPL-2.0":                          "GPL-2.0-only",
+	"GPL-2.0+":                         "GPL-2.0-or-later",
+	"GPL-2.0-with-autoconf-exception":  "GPL-2.0-only WITH Autoconf-exception-2.0",
+	"GPL-2.0-with-bison-exception":     "GPL-2.0-or-later WITH Bison-exception-2.2",
+	"GPL-2.0-with-classpath-exception": "GPL-2.0-only WITH Classpath-exception-2.0",
+	"GPL-2.0-with-font-exception":      "GPL-2.0-only WITH Font-exception-2.0",
+	"GPL-2.0-with-GCC-exception":       "GPL-2.0-only WITH GCC-exception-2.0",
+	"GPL-3.0":                          "GPL-3.0-only",
+	"GPL-3.0+":                         "GPL-3.0-or-later",
+	"GPL-3.0-with-autoconf-exception":  "GPL-3.0-only WITH Autoconf-exception-3.0",
+	"GPL-3.0-with-GCC-exception":       "GPL-3.0-only WITH GCC-exception-3.1",
+	"LGPL-2.0":                         "LGPL-2.0-only",
+	"LGPL-2.0+":                        "LGPL-2.0-or-later",
+	"LGPL-2.1":                         "LGPL-2.1-only",
+	"LGPL-2.1+":                        "LGPL-2.1-or-later",
+	"LGPL-3.0":                         "LGPL-3.0-only",
+	"LGPL-3.0+":                        "LGPL-3.0-or-later",
+	"Nunit":                            "zlib-acknowledgement",
+	"StandardML-NJ":                    "SMLNJ",
+	"wxWindows":                        "GPL-2.0-or-later WITH WxWindows-exception-3.1",
+}
+
+// indexIDs builds a case insensitive index of identifiers
+func indexIDs(ids []string) map[string]string {
+	index := make(map[string]string, len(ids))
+	for _, id := range ids {
+		index[strings.ToLower(id)] = id
+	}
+	return index
+}
+
+// LookupLicense finds a license in the SPDX license list ignoring case and
+// returns its canonical identifier.
+func LookupLicense(id string) (string, bool) {
+	canonical, ok := licenseIndex[strings.ToLower(id)]
+	return canonical, ok
+}
+
+// LookupException finds an exception in the SPDX license list ignoring
+// case and returns its canonical identifier.
+func LookupException(id string) (string, bool) {
+	canonical, ok := exceptionIndex[strings.ToLower(id)]
+	return canonical, ok
+}
+
+// IsDeprecated returns true if the license identifier is deprecated in the
+// SPDX license list.
+func IsDeprecated(id string) bool {
+	canonical, ok := LookupLicense(id)
+	if !ok {
+		return false
+	}
+	_, ok = deprecatedLicenses[canonical]
+	return ok
+}
+
+// isLicenseRef returns true if the id references a license not in the SPDX
+// list, optionally defined in another document.
+func isLicenseRef(id string) bool {
+	if doc, ref, ok := strings.Cut(id, ":"); ok {
+		return hasPrefixFold(doc, DocumentRefPrefix) && len(doc) > len(DocumentRefPrefix) &&
+			hasPrefixFold(ref, LicenseRefPrefix) && len(ref) > len(LicenseRefPrefix)
+	}
+	return hasPrefixFold(id, LicenseRefPrefix) && len(id) > len(LicenseRefPrefix)
+}
+
+// hasPrefixFold is a case insensitive strings.HasPrefix
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
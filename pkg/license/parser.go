@@ -0,0 +1,302 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidExpression is returned when a license expression cannot be parsed
+var ErrInvalidExpression = errors.New("invalid license expression")
+
+// The parser implements the grammar of SPDX license expressions (SPDX 2.3
+// annex D) with the usual precedence of its operators:
+//
+//	expr     = and { "OR" and }
+//	and      = with { "AND" with }
+//	with     = license [ "WITH" exception ] | "(" expr ")"
+//	license  = idstring [ "+" ] | [ "DocumentRef-" idstring ":" ] "LicenseRef-" idstring
+//
+// Operators are matched ignoring case.
+
+// token is a word or parenthesis of the expression
+type token struct {
+	value string
+	pos   int
+}
+
+// tokenize splits the expression in parentheses and words
+func tokenize(expr string) []token {
+	tokens := []token{}
+	start := -1
+	for i := 0; i <= len(expr); i++ {
+		var c byte
+		if i < len(expr) {
+			c = expr[i]
+		}
+		boundary := i == len(expr) || c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '(' || c == ')'
+		if boundary {
+			if start != -1 {
+				tokens = append(tokens, token{expr[start:i], start})
+				start = -1
+			}
+			if c == '(' || c == ')' {
+				tokens = append(tokens, token{string(c), i})
+			}
+			continue
+		}
+		if start == -1 {
+			start = i
+		}
+	}
+	return tokens
+}
+
+// parser is a recursive descent parser of license expressions
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// peek returns the current token, if any
+func (p *parser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// peekOperator returns true if the next token is the operator
+func (p *parser) peekOperator(op string) bool {
+	t, ok := p.peek()
+	return ok && strings.EqualFold(t.value, op)
+}
+
+// Parse parses an SPDX license expression. Identifiers found in the SPDX
+// license list are returned in their canonical case, others are kept as
+// written and can be checked with Validate. The special values NONE and
+// NOASSERTION are parsed as licenses when used alone.
+func Parse(expr string) (Expression, error) {
+	p := &parser{tokens: tokenize(expr)}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("%w: expression is empty", ErrInvalidExpression)
+	}
+
+	if len(p.tokens) == 1 {
+		switch strings.ToUpper(p.tokens[0].value) {
+		case None:
+			return &License{ID: None}, nil
+		case NoAssertion:
+			return &License{ID: NoAssertion}, nil
+		}
+	}
+
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExpression, err)
+	}
+	if t, ok := p.peek(); ok {
+		return nil, fmt.Errorf("%w: unexpected %q at position %d", ErrInvalidExpression, t.value, t.pos)
+	}
+	return e, nil
+}
+
+// MustParse parses an expression and panics if it is invalid. It is
+// intended for expressions known at compile time.
+func MustParse(expr string) Expression {
+	e, err := Parse(expr)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// mustParseLicense parses an expression known to be a single license
+func mustParseLicense(expr string) *License {
+	l, ok := MustParse(expr).(*License)
+	if !ok {
+		panic(fmt.Sprintf("%q is not a single license", expr))
+	}
+	return l
+}
+
+func (p *parser) parseOr() (Expression, error) {
+	exprs := []Expression{}
+	for {
+		e, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+		if !p.peekOperator(string(Or)) {
+			break
+		}
+		p.pos++
+	}
+	return Join(Or, exprs...), nil
+}
+
+func (p *parser) parseAnd() (Expression, error) {
+	exprs := []Expression{}
+	for {
+		e, err := p.parseWith()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+		if !p.peekOperator(string(And)) {
+			break
+		}
+		p.pos++
+	}
+	return Join(And, exprs...), nil
+}
+
+func (p *parser) parseWith() (Expression, error) {
+	t, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("expression ends unexpectedly")
+	}
+
+	if t.value == "(" {
+		p.pos++
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		closing, ok := p.peek()
+		if !ok || closing.value != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis for position %d", t.pos)
+		}
+		p.pos++
+		if p.peekOperator("WITH") {
+			return nil, fmt.Errorf("WITH at position %d must follow a license", p.tokens[p.pos].pos)
+		}
+		return e, nil
+	}
+
+	l, err := parseLicense(t)
+	if err != nil {
+		return nil, err
+	}
+	p.pos++
+
+	if !p.peekOperator("WITH") {
+		return l, nil
+	}
+	p.pos++
+	exc, ok := p.peek()
+	if !ok || exc.value == "(" || exc.value == ")" || isOperator(exc.value) {
+		return nil, fmt.Errorf("missing exception after WITH at position %d", t.pos)
+	}
+	exception, err := parseException(exc)
+	if err != nil {
+		return nil, err
+	}
+	l.Exception = exception
+	p.pos++
+	return l, nil
+}
+
+// isOperator returns true if the word is an operator
+func isOperator(s string) bool {
+	for _, op := range []string{string(And), string(Or), "WITH"} {
+		if strings.EqualFold(s, op) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseLicense reads a license identifier token
+func parseLicense(t token) (*License, error) {
+	if t.value == ")" || isOperator(t.value) {
+		return nil, fmt.Errorf("expected a license at position %d, found %q", t.pos, t.value)
+	}
+
+	l := &License{}
+	id := t.value
+	if base, ok := strings.CutSuffix(id, "+"); ok {
+		l.OrLater = true
+		id = base
+	}
+
+	if strings.Contains(id, ":") || hasPrefixFold(id, LicenseRefPrefix) {
+		if !isLicenseRef(id) || !validRef(id) {
+			return nil, fmt.Errorf("invalid license reference %q at position %d", t.value, t.pos)
+		}
+		if l.OrLater {
+			return nil, fmt.Errorf("license reference %q at position %d cannot use +", t.value, t.pos)
+		}
+		l.ID = canonicalRef(id)
+		return l, nil
+	}
+
+	if !validIDString(id) {
+		return nil, fmt.Errorf("invalid license identifier %q at position %d", t.value, t.pos)
+	}
+	if strings.EqualFold(id, None) || strings.EqualFold(id, NoAssertion) {
+		return nil, fmt.Errorf("%s at position %d must be used alone", strings.ToUpper(id), t.pos)
+	}
+
+	// The list has a few deprecated identifiers ending in +, those are
+	// parsed as the license with the or-later operator.
+	l.ID = id
+	if canonical, ok := LookupLicense(id); ok {
+		l.ID = canonical
+	}
+	return l, nil
+}
+
+// parseException reads an exception identifier token
+func parseException(t token) (string, error) {
+	if hasPrefixFold(t.value, AdditionRefPrefix) {
+		if len(t.value) == len(AdditionRefPrefix) || !validIDString(t.value) {
+			return "", fmt.Errorf("invalid exception reference %q at position %d", t.value, t.pos)
+		}
+		return AdditionRefPrefix + t.value[len(AdditionRefPrefix):], nil
+	}
+	if !validIDString(t.value) {
+		return "", fmt.Errorf("invalid exception identifier %q at position %d", t.value, t.pos)
+	}
+	if canonical, ok := LookupException(t.value); ok {
+		return canonical, nil
+	}
+	return t.value, nil
+}
+
+// validIDString checks that s only contains the characters allowed in
+// license and exception identifiers.
+func validIDString(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && !(c >= '0' && c <= '9') && c != '-' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// validRef checks the characters of a (DocumentRef-x:)LicenseRef-y reference
+func validRef(id string) bool {
+	doc, ref, ok := strings.Cut(id, ":")
+	if !ok {
+		return validIDString(id)
+	}
+	return validIDString(doc) && validIDString(ref)
+}
+
+// canonicalRef returns the reference with the prefixes in their canonical case
+func canonicalRef(id string) string {
+	doc, ref, ok := strings.Cut(id, ":")
+	if !ok {
+		return LicenseRefPrefix + id[len(LicenseRefPrefix):]
+	}
+	return DocumentRefPrefix + doc[len(DocumentRefPrefix):] + ":" + LicenseRefPrefix + ref[len(LicenseRefPrefix):]
+}
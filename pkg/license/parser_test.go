@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		expr     string
+		expected Expression
+		str      string
+	}{
+		"single": {
+			expr: "MIT", expected: &License{ID: "MIT"}, str: "MIT",
+		},
+		"canonical case": {
+			expr: "apache-2.0", expected: &License{ID: "Apache-2.0"}, str: "Apache-2.0",
+		},
+		"or later": {
+			expr: "GPL-2.0+", expected: &License{ID: "GPL-2.0", OrLater: true}, str: "GPL-2.0+",
+		},
+		"with": {
+			expr:     "GPL-2.0-only with classpath-exception-2.0",
+			expected: &License{ID: "GPL-2.0-only", Exception: "Classpath-exception-2.0"},
+			str:      "GPL-2.0-only WITH Classpath-exception-2.0",
+		},
+		"license ref": {
+			expr: "licenseref-my-license", expected: &License{ID: "LicenseRef-my-license"}, str: "LicenseRef-my-license",
+		},
+		"document ref": {
+			expr:     "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2",
+			expected: &License{ID: "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2"},
+			str:      "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2",
+		},
+		"addition ref": {
+			expr:     "MIT WITH AdditionRef-custom",
+			expected: &License{ID: "MIT", Exception: "AdditionRef-custom"},
+			str:      "MIT WITH AdditionRef-custom",
+		},
+		"unknown identifier": {
+			expr: "Foo-1.0", expected: &License{ID: "Foo-1.0"}, str: "Foo-1.0",
+		},
+		"none": {
+			expr: "none", expected: &License{ID: None}, str: "NONE",
+		},
+		"noassertion": {
+			expr: "NOASSERTION", expected: &License{ID: NoAssertion}, str: "NOASSERTION",
+		},
+		"and": {
+			expr: "MIT AND BSD-3-Clause AND ISC",
+			expected: &Compound{Operator: And, Expressions: []Expression{
+				&License{ID: "MIT"}, &License{ID: "BSD-3-Clause"}, &License{ID: "ISC"},
+			}},
+			str: "MIT AND BSD-3-Clause AND ISC",
+		},
+		"precedence": {
+			expr: "MIT or Apache-2.0 and BSD-2-Clause",
+			expected: &Compound{Operator: Or, Expressions: []Expression{
+				&License{ID: "MIT"},
+				&Compound{Operator: And, Expressions: []Expression{
+					&License{ID: "Apache-2.0"}, &License{ID: "BSD-2-Clause"},
+				}},
+			}},
+			str: "MIT OR Apache-2.0 AND BSD-2-Clause",
+		},
+		"parentheses": {
+			expr: "(MIT OR Apache-2.0) AND GPL-2.0-only WITH Classpath-exception-2.0",
+			expected: &Compound{Operator: And, Expressions: []Expression{
+				&Compound{Operator: Or, Expressions: []Expression{
+					&License{ID: "MIT"}, &License{ID: "Apache-2.0"},
+				}},
+				&License{ID: "GPL-2.0-only", Exception: "Classpath-exception-2.0"},
+			}},
+			str: "(MIT OR Apache-2.0) AND GPL-2.0-only WITH Classpath-exception-2.0",
+		},
+		"redundant parentheses": {
+			expr: "((MIT))", expected: &License{ID: "MIT"}, str: "MIT",
+		},
+		"nested flattening": {
+			expr: "MIT OR (ISC OR 0BSD)",
+			expected: &Compound{Operator: Or, Expressions: []Expression{
+				&License{ID: "MIT"}, &License{ID: "ISC"}, &License{ID: "0BSD"},
+			}},
+			str: "MIT OR ISC OR 0BSD",
+		},
+		"no spaces around parentheses": {
+			expr: "(MIT)AND(ISC)",
+			expected: &Compound{Operator: And, Expressions: []Expression{
+				&License{ID: "MIT"}, &License{ID: "ISC"},
+			}},
+			str: "MIT AND ISC",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			e, err := Parse(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, e)
+			require.Equal(t, tc.str, e.String())
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for name, expr := range map[string]string{
+		"empty":                 "",
+		"blank":                 "   ",
+		"dangling operator":     "MIT AND",
+		"leading operator":      "OR MIT",
+		"double operator":       "MIT AND OR ISC",
+		"missing operator":      "MIT ISC",
+		"unbalanced":            "(MIT OR ISC",
+		"extra parenthesis":     "MIT OR ISC)",
+		"empty parentheses":     "()",
+		"with without license":  "WITH Classpath-exception-2.0",
+		"with without exc":      "GPL-2.0-only WITH",
+		"with on compound":      "(MIT OR ISC) WITH LLVM-exception",
+		"double with":           "GPL-2.0-only WITH LLVM-exception WITH Classpath-exception-2.0",
+		"invalid characters":    "Apache_2.0",
+		"spaces in name":        "Apache License 2.0",
+		"bad ref":               "LicenseRef-",
+		"bad document ref":      "DocumentRef-x:MIT",
+		"ref with plus":         "LicenseRef-foo+",
+		"bad addition ref":      "MIT WITH AdditionRef-",
+		"none in expression":    "NONE OR MIT",
+		"noassertion with exc":  "NOASSERTION WITH LLVM-exception",
+		"invalid exception":     "MIT WITH LLVM_exception",
+		"operator as exception": "MIT WITH AND",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(expr)
+			require.Error(t, err)
+			require.ErrorIs(t, err, ErrInvalidExpression)
+		})
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	require.Equal(t, &License{ID: "MIT"}, MustParse("MIT"))
+	require.Panics(t, func() { MustParse("MIT AND") })
+}
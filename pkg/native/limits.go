@@ -0,0 +1,71 @@
+package native
+
+import "fmt"
+
+// Limits caps the resources a document may consume when it is read. They are
+// meant to protect programs that parse untrusted input. A zero value in any
+// of the fields means no limit is enforced.
+//
+// MaxInputBytes is the only limit checked before the native document is
+// decoded. The node, edge and depth limits are enforced while the decoded
+// document is converted to protobom, so the native parser has already built
+// the whole document in memory by then. Always set MaxInputBytes when
+// reading untrusted input.
+type Limits struct {
+	// MaxInputBytes is the largest input, in bytes, that will be read. It
+	// is enforced by the reader before decoding.
+	MaxInputBytes int64
+
+	// MaxNodes is the maximum number of nodes a document can have. It is
+	// checked after the native document is decoded.
+	MaxNodes int
+
+	// MaxEdges is the maximum number of relationships a document can have,
+	// counted as individual from/to pairs. It is checked after the native
+	// document is decoded.
+	MaxEdges int
+
+	// MaxDepth is the deepest a component can be nested in formats that
+	// support nesting (eg CycloneDX). It is checked after the native
+	// document is decoded.
+	MaxDepth int
+}
+
+// InputSizeLimitError is returned when the input exceeds Limits.MaxInputBytes
+type InputSizeLimitError struct {
+	Limit int64
+}
+
+func (e *InputSizeLimitError) Error() string {
+	return fmt.Sprintf("input exceeds the maximum size of %d bytes", e.Limit)
+}
+
+// NodeLimitError is returned when a document has more nodes than allowed
+// by Limits.MaxNodes
+type NodeLimitError struct {
+	Limit int
+}
+
+func (e *NodeLimitError) Error() string {
+	return fmt.Sprintf("document exceeds the maximum of %d nodes", e.Limit)
+}
+
+// EdgeLimitError is returned when a document has more relationships than
+// allowed by Limits.MaxEdges
+type EdgeLimitError struct {
+	Limit int
+}
+
+func (e *EdgeLimitError) Error() string {
+	return fmt.Sprintf("document exceeds the maximum of %d relationships", e.Limit)
+}
+
+// DepthLimitError is returned when components are nested deeper than
+// Limits.MaxDepth
+type DepthLimitError struct {
+	Limit int
+}
+
+func (e *DepthLimitError) Error() string {
+	return fmt.Sprintf("component nesting exceeds the maximum depth of %d", e.Limit)
+}
@@ -70,6 +70,23 @@ type file struct {
 	ContentIdentifier string        `json:"contentIdentifier,omitempty"`
 	CreationInfo      *creationInfo `json:"creationInfo,omitempty"`
 }
+type snippet struct {
+	Type            string                `json:"type"` // Snippet
+	SpdxId          string                `json:"SpdxId,omitempty"`
+	Name            string                `json:"name,omitempty"`
+	Comment         string                `json:"comment,omitempty"`
+	SnippetFromFile string                `json:"snippetFromFile"`
+	ByteRange       *positiveIntegerRange `json:"byteRange,omitempty"`
+	LineRange       *positiveIntegerRange `json:"lineRange,omitempty"`
+	CreationInfo    *creationInfo         `json:"creationInfo,omitempty"`
+}
+
+type positiveIntegerRange struct {
+	Type  string `json:"type"` // PositiveIntegerRange
+	Begin int64  `json:"beginIntegerRange"`
+	End   int64  `json:"endIntegerRange"`
+}
+
 type relationship struct {
 	Type             string        `json:"type"` // Relationship
 	SpdxId           string        `json:"SpdxId,omitempty"`
@@ -137,7 +154,9 @@ func (spdx3 *SPDX3) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _
 	// Cycle nodes and add them to the elements array
 	for _, n := range bom.NodeList.Nodes {
 		switch n.Type {
-		case sbom.Node_PACKAGE:
+		// TODO(degradation): SPDX 3 has no services, they are written as
+		// packages to keep their relationships, as in SPDX 2.3.
+		case sbom.Node_PACKAGE, sbom.Node_SERVICE:
 			p, err := spdx3.nodeToPackage(n)
 			if err != nil {
 				return nil, fmt.Errorf("creating package from node: %w", err)
@@ -149,6 +168,12 @@ func (spdx3 *SPDX3) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _
 				return nil, fmt.Errorf("converting node to SPDX3 file: %w", err)
 			}
 			spdxSBOM.Elements = append(spdxSBOM.Elements, f)
+		case sbom.Node_SNIPPET:
+			sn, err := spdx3.nodeToSnippet(n, bom.NodeList.GetSnippetFile(n.Id))
+			if err != nil {
+				return nil, fmt.Errorf("converting node to SPDX3 snippet: %w", err)
+			}
+			spdxSBOM.Elements = append(spdxSBOM.Elements, sn)
 		}
 	}
 
@@ -160,6 +185,8 @@ func (spdx3 *SPDX3) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _
 		spdxSBOM.Elements = append(spdxSBOM.Elements, r)
 	}
 
+	spdxSBOM.Elements = append(spdxSBOM.Elements, spdx3.vulnerabilitiesToElements(bom.Vulnerabilities)...)
+
 	return spdxSBOM, nil
 }
 
@@ -178,7 +205,7 @@ func (spdx3 *SPDX3) edgeToRelationship(e *sbom.Edge) (relationship, error) {
 }
 
 func (spdx3 *SPDX3) nodeToPackage(n *sbom.Node) (pkg, error) {
-	if n.Type != sbom.Node_PACKAGE {
+	if n.Type != sbom.Node_PACKAGE && n.Type != sbom.Node_SERVICE {
 		return pkg{}, fmt.Errorf("attempt to serialize SPDX package from non pkg node")
 	}
 	p := pkg{
@@ -240,6 +267,34 @@ func (spdx3 *SPDX3) nodeToFile(n *sbom.Node) (file, error) {
 	return f, nil
 }
 
+// nodeToSnippet converts a snippet node to an SPDX3 snippet of the file f
+func (spdx3 *SPDX3) nodeToSnippet(n, f *sbom.Node) (snippet, error) {
+	if n.Type != sbom.Node_SNIPPET {
+		return snippet{}, errors.New("attempt to build a snippet from a non snippet node")
+	}
+	if f == nil {
+		return snippet{}, fmt.Errorf("snippet %q is not contained in a file", n.Id)
+	}
+	sn := snippet{
+		Type:            "Snippet",
+		SpdxId:          n.Id,
+		Name:            n.Name,
+		Comment:         n.Comment,
+		SnippetFromFile: f.Id,
+	}
+	if n.ByteRange != nil {
+		sn.ByteRange = &positiveIntegerRange{
+			Type: "PositiveIntegerRange", Begin: n.ByteRange.Start, End: n.ByteRange.End,
+		}
+	}
+	if n.LineRange != nil {
+		sn.LineRange = &positiveIntegerRange{
+			Type: "PositiveIntegerRange", Begin: n.LineRange.Start, End: n.LineRange.End,
+		}
+	}
+	return sn, nil
+}
+
 func (spdx3 *SPDX3) Render(rawDoc interface{}, w io.Writer, o *native.RenderOptions, _ interface{}) error {
 	doc, ok := rawDoc.(sbomType)
 	if !ok {
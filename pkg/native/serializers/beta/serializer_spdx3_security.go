@@ -0,0 +1,261 @@
+package beta
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// This file renders the protobom vulnerabilities as elements of the SPDX 3
+// security profile. There is no SPDX 3 unserializer yet so the mapping only
+// goes in one direction.
+
+type vulnerability struct {
+	Type                string               `json:"type"` // security_Vulnerability
+	SpdxId              string               `json:"SpdxId,omitempty"`
+	Name                string               `json:"name"`
+	Summary             string               `json:"summary,omitempty"`
+	Description         string               `json:"description,omitempty"`
+	ExternalIdentifiers []externalIdentifier `json:"externalIdentifier,omitempty"`
+	ExternalReferences  []externalReference  `json:"externalReference,omitempty"`
+	PublishedTime       *time.Time           `json:"security_publishedTime,omitempty"`
+	ModifiedTime        *time.Time           `json:"security_modifiedTime,omitempty"`
+}
+
+type externalIdentifier struct {
+	Type                   string `json:"type"` // ExternalIdentifier
+	ExternalIdentifierType string `json:"externalIdentifierType"`
+	Identifier             string `json:"identifier"`
+	IdentifierLocator      string `json:"identifierLocator,omitempty"`
+}
+
+type vulnAssessmentRelationship struct {
+	Type              string     `json:"type"` // security_*VulnAssessmentRelationship
+	SpdxId            string     `json:"SpdxId,omitempty"`
+	From              string     `json:"from"`
+	To                []string   `json:"to"`
+	RelationshipType  string     `json:"relationshipType"`
+	Score             *float64   `json:"security_score,omitempty"`
+	Severity          string     `json:"security_severity,omitempty"`
+	VectorString      string     `json:"security_vectorString,omitempty"`
+	Probability       *float64   `json:"security_probability,omitempty"`
+	StatusNotes       string     `json:"security_statusNotes,omitempty"`
+	ActionStatement   string     `json:"security_actionStatement,omitempty"`
+	JustificationType string     `json:"security_justificationType,omitempty"`
+	ImpactStatement   string     `json:"security_impactStatement,omitempty"`
+	PublishedTime     *time.Time `json:"security_publishedTime,omitempty"`
+	ModifiedTime      *time.Time `json:"security_modifiedTime,omitempty"`
+}
+
+// vulnerabilityID returns the SPDX ID of the element of a vulnerability
+func vulnerabilityID(v *sbom.Vulnerability) string {
+	return "vulnerability-" + v.GetId()
+}
+
+// vulnerabilitiesToElements converts the vulnerabilities of the document to
+// security profile elements. Vulnerabilities listed more than once, for
+// example with different analyses, are rendered as a single element.
+func (spdx3 *SPDX3) vulnerabilitiesToElements(vulns []*sbom.Vulnerability) []interface{} {
+	elements := []interface{}{}
+	seen := map[string]struct{}{}
+	for _, v := range vulns {
+		if _, ok := seen[v.GetId()]; !ok {
+			seen[v.GetId()] = struct{}{}
+			elements = append(elements, spdx3.vulnerabilityToElement(v))
+		}
+		if len(v.GetAffects()) == 0 {
+			continue
+		}
+		for _, r := range v.GetRatings() {
+			if a := spdx3.ratingToAssessment(v, r); a != nil {
+				elements = append(elements, *a)
+			}
+		}
+
+		if vex := spdx3.analysisToVexAssessment(v); vex != nil {
+			elements = append(elements, *vex)
+			continue
+		}
+
+		// Without a VEX status we can only record that the vulnerability
+		// is associated to the nodes.
+		for _, id := range v.GetAffects() {
+			elements = append(elements, relationship{
+				Type:             "Relationship",
+				From:             id,
+				To:               []string{vulnerabilityID(v)},
+				RelationshipType: "hasAssociatedVulnerability",
+			})
+		}
+	}
+	return elements
+}
+
+// vulnerabilityToElement converts a protobom vulnerability to an SPDX 3
+// security_Vulnerability element.
+func (spdx3 *SPDX3) vulnerabilityToElement(v *sbom.Vulnerability) vulnerability {
+	vuln := vulnerability{
+		Type:                "security_Vulnerability",
+		SpdxId:              vulnerabilityID(v),
+		Name:                v.GetId(),
+		Summary:             v.GetDescription(),
+		Description:         v.GetDetail(),
+		ExternalIdentifiers: []externalIdentifier{},
+		ExternalReferences:  []externalReference{},
+		PublishedTime:       spdx3Time(v.GetPublished()),
+		ModifiedTime:        spdx3Time(v.GetUpdated()),
+	}
+
+	for i, id := range append([]string{v.GetId()}, v.GetAliases()...) {
+		ei := externalIdentifier{
+			Type:                   "ExternalIdentifier",
+			ExternalIdentifierType: "securityOther",
+			Identifier:             id,
+		}
+		if strings.HasPrefix(strings.ToUpper(id), "CVE-") {
+			ei.ExternalIdentifierType = "cve"
+		}
+		if i == 0 && v.GetSource() != nil {
+			ei.IdentifierLocator = v.GetSource().GetUrl()
+		}
+		vuln.ExternalIdentifiers = append(vuln.ExternalIdentifiers, ei)
+	}
+
+	for _, a := range v.GetAdvisories() {
+		vuln.ExternalReferences = append(vuln.ExternalReferences, externalReference{
+			Type:                  "ExternalReference",
+			ExternalReferenceType: spdx3.extRefTypeFromProtobomExtRef(a),
+			Locator:               []string{a.GetUrl()},
+		})
+	}
+
+	// TODO(degradation): Recommendations and CWEs have no place in the SPDX 3
+	// vulnerability element
+	return vuln
+}
+
+// ratingToAssessment converts a vulnerability rating to a CVSS or EPSS
+// assessment relationship. Returns nil for scoring methods not supported
+// by SPDX 3.
+func (spdx3 *SPDX3) ratingToAssessment(v *sbom.Vulnerability, r *sbom.VulnerabilityRating) *vulnAssessmentRelationship {
+	score := r.GetScore()
+	a := &vulnAssessmentRelationship{
+		From:             vulnerabilityID(v),
+		To:               v.GetAffects(),
+		RelationshipType: "hasAssessmentFor",
+		Score:            &score,
+		VectorString:     r.GetVector(),
+		Severity:         spdx3Severity(r.GetSeverity()),
+	}
+
+	switch r.GetMethod() {
+	case sbom.VulnerabilityRating_CVSSV2:
+		a.Type = "security_CvssV2VulnAssessmentRelationship"
+		// CVSS v2 assessments have no severity
+		a.Severity = ""
+	case sbom.VulnerabilityRating_CVSSV3, sbom.VulnerabilityRating_CVSSV31:
+		a.Type = "security_CvssV3VulnAssessmentRelationship"
+	case sbom.VulnerabilityRating_CVSSV4:
+		a.Type = "security_CvssV4VulnAssessmentRelationship"
+	case sbom.VulnerabilityRating_EPSS:
+		a.Type = "security_EpssVulnAssessmentRelationship"
+		a.Probability = &score
+		a.Score = nil
+		a.Severity = ""
+	default:
+		// TODO(degradation): SSVC, OWASP and other ratings are not rendered
+		return nil
+	}
+	return a
+}
+
+// analysisToVexAssessment converts the analysis of a vulnerability to an SPDX 3
+// VEX assessment relationship. Returns nil if the vulnerability has no status.
+func (spdx3 *SPDX3) analysisToVexAssessment(v *sbom.Vulnerability) *vulnAssessmentRelationship {
+	a := v.GetAnalysis()
+	vex := &vulnAssessmentRelationship{
+		From:          vulnerabilityID(v),
+		To:            v.GetAffects(),
+		PublishedTime: spdx3Time(a.GetFirstIssued()),
+		ModifiedTime:  spdx3Time(a.GetLastUpdated()),
+	}
+
+	switch a.GetStatus() {
+	case sbom.VulnerabilityAnalysis_AFFECTED:
+		vex.Type = "security_VexAffectedVulnAssessmentRelationship"
+		vex.RelationshipType = "affects"
+		vex.ActionStatement = a.GetAction()
+		vex.StatusNotes = a.GetDetail()
+	case sbom.VulnerabilityAnalysis_NOT_AFFECTED:
+		vex.Type = "security_VexNotAffectedVulnAssessmentRelationship"
+		vex.RelationshipType = "doesNotAffect"
+		vex.JustificationType = spdx3Justification(a.GetJustification())
+		vex.ImpactStatement = a.GetDetail()
+	case sbom.VulnerabilityAnalysis_FIXED:
+		vex.Type = "security_VexFixedVulnAssessmentRelationship"
+		vex.RelationshipType = "fixedIn"
+		vex.StatusNotes = a.GetDetail()
+	case sbom.VulnerabilityAnalysis_UNDER_INVESTIGATION:
+		vex.Type = "security_VexUnderInvestigationVulnAssessmentRelationship"
+		vex.RelationshipType = "underInvestigationFor"
+		vex.StatusNotes = a.GetDetail()
+	default:
+		return nil
+	}
+	// TODO(degradation): The vendor responses are not rendered
+	return vex
+}
+
+// spdx3Severity returns the SPDX 3 severity of a rating
+func spdx3Severity(s sbom.VulnerabilityRating_Severity) string {
+	switch s {
+	case sbom.VulnerabilityRating_NONE, sbom.VulnerabilityRating_INFO:
+		// TODO(degradation): Informational severity is rendered as none
+		return "none"
+	case sbom.VulnerabilityRating_LOW:
+		return "low"
+	case sbom.VulnerabilityRating_MEDIUM:
+		return "medium"
+	case sbom.VulnerabilityRating_HIGH:
+		return "high"
+	case sbom.VulnerabilityRating_CRITICAL:
+		return "critical"
+	default:
+		return ""
+	}
+}
+
+// spdx3Justification returns the SPDX 3 VEX justification type
+func spdx3Justification(j sbom.VulnerabilityAnalysis_Justification) string {
+	switch j {
+	case sbom.VulnerabilityAnalysis_COMPONENT_NOT_PRESENT:
+		return "componentNotPresent"
+	case sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_PRESENT:
+		return "vulnerableCodeNotPresent"
+	case sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_IN_EXECUTE_PATH:
+		return "vulnerableCodeNotInExecutePath"
+	case sbom.VulnerabilityAnalysis_VULNERABLE_CODE_CANNOT_BE_CONTROLLED_BY_ADVERSARY:
+		return "vulnerableCodeCannotBeControlledByAdversary"
+	case sbom.VulnerabilityAnalysis_INLINE_MITIGATIONS_ALREADY_EXIST,
+		sbom.VulnerabilityAnalysis_PROTECTED_BY_COMPILER,
+		sbom.VulnerabilityAnalysis_PROTECTED_AT_RUNTIME,
+		sbom.VulnerabilityAnalysis_PROTECTED_AT_PERIMETER:
+		// TODO(degradation): CDX protections are rendered as inline mitigations
+		return "inlineMitigationsAlreadyExist"
+	default:
+		// TODO(degradation): CDX requirements have no SPDX 3 equivalent
+		return ""
+	}
+}
+
+// spdx3Time converts a protobom timestamp, returns nil if it is not set
+func spdx3Time(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	ret := t.AsTime()
+	return &ret
+}
@@ -5,14 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
 	cdxformats "github.com/protobom/protobom/pkg/formats/cyclonedx"
+	"github.com/protobom/protobom/pkg/license"
 	"github.com/protobom/protobom/pkg/native"
 	"github.com/protobom/protobom/pkg/sbom"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ native.Serializer = &CDX{}
@@ -73,20 +77,36 @@ func (s *CDX) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _ interf
 
 	// .. or has too many root elements:
 
-	// TODO(deprecation): If there are more root nodes we need to hack them
-	// into the CycloneDX graph or error
-	if l := len(bom.NodeList.RootElements); l > 1 {
-		return nil, fmt.Errorf("unable to serialize multiroot cyclonedx, document has %d root nodes", l)
+	// TODO(degradation): The metadata component of a CycloneDX BOM cannot be
+	// a service. Service root nodes are kept in the services section and
+	// when there is no other root, the document has no metadata component.
+	var rootNode *sbom.Node
+	for _, id := range bom.NodeList.RootElements {
+		n := bom.NodeList.GetNodeByID(id)
+		if n == nil {
+			return nil, fmt.Errorf("integrity error: root node %q not found", id)
+		}
+		if n.Type == sbom.Node_SERVICE {
+			continue
+		}
+
+		// TODO(deprecation): If there are more root nodes we need to hack them
+		// into the CycloneDX graph or error
+		if rootNode != nil {
+			return nil, fmt.Errorf(
+				"unable to serialize multiroot cyclonedx, document has %d root nodes", len(bom.NodeList.RootElements),
+			)
+		}
+		rootNode = n
 	}
 
-	rootNode := bom.NodeList.GetNodeByID(bom.NodeList.RootElements[0])
 	if rootNode == nil {
-		return nil, fmt.Errorf("integrity error: root node %q not found", bom.NodeList.RootElements[0])
+		doc.Metadata.Component = nil
+	} else {
+		doc.Metadata.Component = s.nodeToComponent(rootNode)
+		state.addedDict[rootNode.Id] = struct{}{}
 	}
 
-	doc.Metadata.Component = s.nodeToComponent(rootNode)
-	state.addedDict[rootNode.Id] = struct{}{}
-
 	if err := s.componentsMaps(ctx, bom); err != nil {
 		return nil, err
 	}
@@ -132,10 +152,28 @@ func (s *CDX) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _ interf
 		}
 	}
 
-	if bom.Metadata != nil && bom.GetMetadata().GetName() != "" {
+	// TODO(degradation): The document name is lost when there is no
+	// metadata component to hold it.
+	if bom.Metadata != nil && bom.GetMetadata().GetName() != "" && doc.Metadata.Component != nil {
 		doc.Metadata.Component.Name = bom.GetMetadata().GetName()
 	}
 
+	if len(bom.GetMetadata().GetProperties()) > 0 {
+		metadata.Properties = s.propertiesToCDX(bom.GetMetadata().GetProperties())
+	}
+
+	if err := s.pedigree(ctx, bom); err != nil {
+		return nil, err
+	}
+
+	// Generated refs are cleared from the output unless something in the
+	// document points to them.
+	referenced := referencedRefs(bom)
+	if root, ok := state.componentsDict[rootNode.GetId()]; ok && doc.Metadata.Component != nil {
+		doc.Metadata.Component.Pedigree = root.Pedigree
+		clearPedigreeAutoRefs(doc.Metadata.Component.Pedigree, referenced)
+	}
+
 	deps, err := s.dependencies(ctx, bom)
 	if err != nil {
 		return nil, err
@@ -143,12 +181,305 @@ func (s *CDX) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _ interf
 	doc.Dependencies = &deps
 
 	components := state.components()
-	clearAutoRefs(&components)
+	clearAutoRefs(&components, referenced)
 	doc.Components = &components
 
+	if services := state.services(); len(services) > 0 {
+		clearServiceAutoRefs(&services, referenced)
+		doc.Services = &services
+	}
+
+	if annotations := s.annotations(bom); len(annotations) > 0 {
+		doc.Annotations = &annotations
+	}
+
+	if len(bom.GetVulnerabilities()) > 0 {
+		vulns := []cdx.Vulnerability{}
+		for _, v := range bom.GetVulnerabilities() {
+			vulns = append(vulns, s.vulnerabilityToCDX(v))
+		}
+		doc.Vulnerabilities = &vulns
+	}
+
 	return doc, nil
 }
 
+// annotations collects the annotations of the document metadata and nodes as
+// CycloneDX annotations. Document annotations use the serial number as subject.
+func (s *CDX) annotations(bom *sbom.Document) []cdx.Annotation {
+	annotations := []cdx.Annotation{}
+	if id := bom.GetMetadata().GetId(); id != "" {
+		for _, a := range bom.GetMetadata().GetAnnotations() {
+			annotations = append(annotations, s.annotationToCDX(a, id))
+		}
+	}
+	// TODO(degradation): Document annotations are lost if it has no serial number
+
+	for _, n := range bom.GetNodeList().GetNodes() {
+		for _, a := range n.GetAnnotations() {
+			annotations = append(annotations, s.annotationToCDX(a, n.GetId()))
+		}
+	}
+	return annotations
+}
+
+// annotationToCDX converts a protobom annotation of the element with the
+// subject ID to a CycloneDX annotation
+func (s *CDX) annotationToCDX(a *sbom.Annotation, subject string) cdx.Annotation {
+	// TODO(degradation): CycloneDX annotations have no type
+	ca := cdx.Annotation{
+		Subjects:  &[]cdx.BOMReference{cdx.BOMReference(subject)},
+		Timestamp: cdxTimestamp(a.GetDate()),
+		Text:      a.GetText(),
+	}
+
+	switch {
+	case a.GetAnnotator() != nil && a.GetAnnotator().GetIsOrg():
+		org := &cdx.OrganizationalEntity{Name: a.GetAnnotator().GetName()}
+		if a.GetAnnotator().GetUrl() != "" {
+			org.URL = &[]string{a.GetAnnotator().GetUrl()}
+		}
+		ca.Annotator = &cdx.Annotator{Organization: org}
+	case a.GetAnnotator() != nil:
+		ca.Annotator = &cdx.Annotator{Individual: &cdx.OrganizationalContact{
+			Name:  a.GetAnnotator().GetName(),
+			Email: a.GetAnnotator().GetEmail(),
+			Phone: a.GetAnnotator().GetPhone(),
+		}}
+	case a.GetTool() != nil:
+		ca.Annotator = &cdx.Annotator{Component: &cdx.Component{
+			Type:      cdx.ComponentTypeApplication,
+			Name:      a.GetTool().GetName(),
+			Version:   a.GetTool().GetVersion(),
+			Publisher: a.GetTool().GetVendor(),
+		}}
+	}
+	return ca
+}
+
+// propertiesToCDX converts protobom properties to CycloneDX properties. CDX
+// properties are flat so nested properties are hoisted to the top level.
+func (s *CDX) propertiesToCDX(props []*sbom.Property) *[]cdx.Property {
+	ret := []cdx.Property{}
+	for _, p := range sbom.FlattenProperties(props) {
+		ret = append(ret, cdx.Property{Name: p.GetName(), Value: p.GetValue()})
+	}
+	return &ret
+}
+
+// vulnerabilityToCDX converts a protobom vulnerability to a CycloneDX
+// vulnerability. The affected node IDs are used as the component refs.
+func (s *CDX) vulnerabilityToCDX(v *sbom.Vulnerability) cdx.Vulnerability {
+	vuln := cdx.Vulnerability{
+		ID:             v.GetId(),
+		Description:    v.GetDescription(),
+		Detail:         v.GetDetail(),
+		Recommendation: v.GetRecommendation(),
+		Published:      cdxTimestamp(v.GetPublished()),
+		Updated:        cdxTimestamp(v.GetUpdated()),
+	}
+
+	if v.GetSource() != nil {
+		vuln.Source = &cdx.Source{Name: v.GetSource().GetName(), URL: v.GetSource().GetUrl()}
+	}
+
+	if len(v.GetAliases()) > 0 {
+		refs := []cdx.VulnerabilityReference{}
+		for _, a := range v.GetAliases() {
+			refs = append(refs, cdx.VulnerabilityReference{ID: a})
+		}
+		vuln.References = &refs
+	}
+
+	if len(v.GetRatings()) > 0 {
+		ratings := []cdx.VulnerabilityRating{}
+		for _, r := range v.GetRatings() {
+			rating := cdx.VulnerabilityRating{
+				Severity:      s.protobomSeverityToCdx(r.GetSeverity()),
+				Method:        s.protobomScoringMethodToCdx(r.GetMethod()),
+				Vector:        r.GetVector(),
+				Justification: r.GetJustification(),
+			}
+			if r.GetScore() != 0 {
+				score := r.GetScore()
+				rating.Score = &score
+			}
+			if r.GetSource() != nil {
+				rating.Source = &cdx.Source{Name: r.GetSource().GetName(), URL: r.GetSource().GetUrl()}
+			}
+			ratings = append(ratings, rating)
+		}
+		vuln.Ratings = &ratings
+	}
+
+	if len(v.GetCwes()) > 0 {
+		cwes := []int{}
+		for _, cwe := range v.GetCwes() {
+			cwes = append(cwes, int(cwe))
+		}
+		vuln.CWEs = &cwes
+	}
+
+	if len(v.GetAdvisories()) > 0 {
+		// TODO(degradation): Only the URL and comment of advisories are kept
+		advisories := []cdx.Advisory{}
+		for _, a := range v.GetAdvisories() {
+			advisories = append(advisories, cdx.Advisory{Title: a.GetComment(), URL: a.GetUrl()})
+		}
+		vuln.Advisories = &advisories
+	}
+
+	if len(v.GetAffects()) > 0 {
+		affects := []cdx.Affects{}
+		for _, id := range v.GetAffects() {
+			affects = append(affects, cdx.Affects{Ref: id})
+		}
+		vuln.Affects = &affects
+	}
+
+	if a := v.GetAnalysis(); a != nil {
+		vuln.Workaround = a.GetAction()
+		analysis := &cdx.VulnerabilityAnalysis{
+			State:         s.protobomStatusToCdxImpactState(a.GetStatus()),
+			Justification: s.protobomJustificationToCdx(a.GetJustification()),
+			Detail:        a.GetDetail(),
+			FirstIssued:   cdxTimestamp(a.GetFirstIssued()),
+			LastUpdated:   cdxTimestamp(a.GetLastUpdated()),
+		}
+		if len(a.GetResponses()) > 0 {
+			responses := []cdx.ImpactAnalysisResponse{}
+			for _, r := range a.GetResponses() {
+				if cr := s.protobomResponseToCdx(r); cr != "" {
+					responses = append(responses, cr)
+				}
+			}
+			analysis.Response = &responses
+		}
+		// The action is rendered as the workaround, skip the analysis if
+		// there is nothing else in it.
+		if *analysis != (cdx.VulnerabilityAnalysis{}) {
+			vuln.Analysis = analysis
+		}
+	}
+
+	return vuln
+}
+
+// cdxTimestamp formats a protobom timestamp as a CycloneDX date. Unset
+// timestamps return an empty string.
+func cdxTimestamp(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().UTC().Format(time.RFC3339)
+}
+
+// protobomSeverityToCdx converts a protobom rating severity to CycloneDX
+func (s *CDX) protobomSeverityToCdx(severity sbom.VulnerabilityRating_Severity) cdx.Severity {
+	switch severity {
+	case sbom.VulnerabilityRating_NONE:
+		return cdx.SeverityNone
+	case sbom.VulnerabilityRating_INFO:
+		return cdx.SeverityInfo
+	case sbom.VulnerabilityRating_LOW:
+		return cdx.SeverityLow
+	case sbom.VulnerabilityRating_MEDIUM:
+		return cdx.SeverityMedium
+	case sbom.VulnerabilityRating_HIGH:
+		return cdx.SeverityHigh
+	case sbom.VulnerabilityRating_CRITICAL:
+		return cdx.SeverityCritical
+	default:
+		return cdx.SeverityUnknown
+	}
+}
+
+// protobomScoringMethodToCdx converts a protobom scoring method to CycloneDX
+func (s *CDX) protobomScoringMethodToCdx(method sbom.VulnerabilityRating_Method) cdx.ScoringMethod {
+	switch method {
+	case sbom.VulnerabilityRating_CVSSV2:
+		return cdx.ScoringMethodCVSSv2
+	case sbom.VulnerabilityRating_CVSSV3:
+		return cdx.ScoringMethodCVSSv3
+	case sbom.VulnerabilityRating_CVSSV31:
+		return cdx.ScoringMethodCVSSv31
+	case sbom.VulnerabilityRating_CVSSV4:
+		return cdx.ScoringMethodCVSSv4
+	case sbom.VulnerabilityRating_OWASP:
+		return cdx.ScoringMethodOWASP
+	case sbom.VulnerabilityRating_SSVC:
+		return cdx.ScoringMethodSSVC
+	default:
+		// TODO(degradation): EPSS scores are rendered as other
+		return cdx.ScoringMethodOther
+	}
+}
+
+// protobomStatusToCdxImpactState converts a protobom VEX status to a
+// CycloneDX impact analysis state.
+func (s *CDX) protobomStatusToCdxImpactState(status sbom.VulnerabilityAnalysis_Status) cdx.ImpactAnalysisState {
+	switch status {
+	case sbom.VulnerabilityAnalysis_AFFECTED:
+		return cdx.IASExploitable
+	case sbom.VulnerabilityAnalysis_NOT_AFFECTED:
+		return cdx.IASNotAffected
+	case sbom.VulnerabilityAnalysis_FIXED:
+		return cdx.IASResolved
+	case sbom.VulnerabilityAnalysis_UNDER_INVESTIGATION:
+		return cdx.IASInTriage
+	default:
+		return ""
+	}
+}
+
+// protobomJustificationToCdx converts a protobom not affected justification
+// to CycloneDX.
+func (s *CDX) protobomJustificationToCdx(j sbom.VulnerabilityAnalysis_Justification) cdx.ImpactAnalysisJustification {
+	switch j {
+	case sbom.VulnerabilityAnalysis_COMPONENT_NOT_PRESENT, sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_PRESENT:
+		// TODO(degradation): CDX has no justification for missing components
+		return cdx.IAJCodeNotPresent
+	case sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_IN_EXECUTE_PATH:
+		return cdx.IAJCodeNotReachable
+	case sbom.VulnerabilityAnalysis_INLINE_MITIGATIONS_ALREADY_EXIST:
+		return cdx.IAJProtectedByMitigatingControl
+	case sbom.VulnerabilityAnalysis_REQUIRES_CONFIGURATION:
+		return cdx.IAJRequiresConfiguration
+	case sbom.VulnerabilityAnalysis_REQUIRES_DEPENDENCY:
+		return cdx.IAJRequiresDependency
+	case sbom.VulnerabilityAnalysis_REQUIRES_ENVIRONMENT:
+		return cdx.IAJRequiresEnvironment
+	case sbom.VulnerabilityAnalysis_PROTECTED_BY_COMPILER:
+		return cdx.IAJProtectedByCompiler
+	case sbom.VulnerabilityAnalysis_PROTECTED_AT_RUNTIME:
+		return cdx.IAJProtectedAtRuntime
+	case sbom.VulnerabilityAnalysis_PROTECTED_AT_PERIMETER:
+		return cdx.IAJProtectedAtPerimeter
+	default:
+		// TODO(degradation): vulnerable_code_cannot_be_controlled_by_adversary
+		// has no CDX equivalent
+		return ""
+	}
+}
+
+// protobomResponseToCdx converts a protobom vendor response to CycloneDX
+func (s *CDX) protobomResponseToCdx(r sbom.VulnerabilityAnalysis_Response) cdx.ImpactAnalysisResponse {
+	switch r {
+	case sbom.VulnerabilityAnalysis_CAN_NOT_FIX:
+		return cdx.IARCanNotFix
+	case sbom.VulnerabilityAnalysis_WILL_NOT_FIX:
+		return cdx.IARWillNotFix
+	case sbom.VulnerabilityAnalysis_UPDATE:
+		return cdx.IARUpdate
+	case sbom.VulnerabilityAnalysis_ROLLBACK:
+		return cdx.IARRollback
+	case sbom.VulnerabilityAnalysis_WORKAROUND_AVAILABLE:
+		return cdx.IARWorkaroundAvailable
+	default:
+		return ""
+	}
+}
+
 // sbomTypeToPhase converts a SBOM document type to a CDX lifecycle phase
 func sbomTypeToPhase(dt *sbom.DocumentType) (cdx.LifecyclePhase, error) {
 	switch *dt.Type {
@@ -177,19 +508,76 @@ func sbomTypeToPhase(dt *sbom.DocumentType) (cdx.LifecyclePhase, error) {
 // The last step of the CDX serialization recursively removes all autogenerated
 // refs added by the protobom reader. These are added on CycloneDX ingestion
 // to all nodes that don't have them. To maintain the closest fidelity, we
-// clear their refs again before output to CDX
-func clearAutoRefs(comps *[]cdx.Component) {
+// clear their refs again before output to CDX. Refs listed in keep are
+// referenced from elsewhere in the document and are not removed.
+func clearAutoRefs(comps *[]cdx.Component, keep map[string]struct{}) {
 	for i := range *comps {
-		if strings.HasPrefix((*comps)[i].BOMRef, "protobom-") {
-			flags := strings.Split((*comps)[i].BOMRef, "--")
-			if strings.Contains(flags[0], "-auto") {
-				(*comps)[i].BOMRef = ""
-			}
+		if isClearableAutoRef((*comps)[i].BOMRef, keep) {
+			(*comps)[i].BOMRef = ""
 		}
 		if (*comps)[i].Components != nil && len(*(*comps)[i].Components) != 0 {
-			clearAutoRefs((*comps)[i].Components)
+			clearAutoRefs((*comps)[i].Components, keep)
+		}
+		clearPedigreeAutoRefs((*comps)[i].Pedigree, keep)
+	}
+}
+
+// clearPedigreeAutoRefs removes the generated references from the components
+// in a pedigree.
+func clearPedigreeAutoRefs(pedigree *cdx.Pedigree, keep map[string]struct{}) {
+	if pedigree == nil {
+		return
+	}
+	for _, comps := range []*[]cdx.Component{pedigree.Ancestors, pedigree.Descendants, pedigree.Variants} {
+		if comps != nil {
+			clearAutoRefs(comps, keep)
+		}
+	}
+}
+
+// clearServiceAutoRefs removes the generated references from services, like
+// clearAutoRefs does for components.
+func clearServiceAutoRefs(services *[]cdx.Service, keep map[string]struct{}) {
+	for i := range *services {
+		if isClearableAutoRef((*services)[i].BOMRef, keep) {
+			(*services)[i].BOMRef = ""
+		}
+		if (*services)[i].Services != nil && len(*(*services)[i].Services) != 0 {
+			clearServiceAutoRefs((*services)[i].Services, keep)
+		}
+	}
+}
+
+// isClearableAutoRef returns true if ref was generated by the protobom reader
+// and it is not listed in keep.
+func isClearableAutoRef(ref string, keep map[string]struct{}) bool {
+	if !strings.HasPrefix(ref, "protobom-") {
+		return false
+	}
+	flags := strings.Split(ref, "--")
+	if !strings.Contains(flags[0], "-auto") {
+		return false
+	}
+	_, ok := keep[ref]
+	return !ok
+}
+
+// referencedRefs returns the IDs of the nodes that are referenced by the
+// vulnerabilities and annotations of the document. Their bom-refs need to be
+// kept in the output, even when they were generated by the protobom reader.
+func referencedRefs(bom *sbom.Document) map[string]struct{} {
+	refs := map[string]struct{}{}
+	for _, v := range bom.GetVulnerabilities() {
+		for _, id := range v.GetAffects() {
+			refs[id] = struct{}{}
 		}
 	}
+	for _, n := range bom.GetNodeList().GetNodes() {
+		if len(n.GetAnnotations()) > 0 {
+			refs[n.GetId()] = struct{}{}
+		}
+	}
+	return refs
 }
 
 func (s *CDX) componentsMaps(ctx context.Context, bom *sbom.Document) error {
@@ -199,6 +587,11 @@ func (s *CDX) componentsMaps(ctx context.Context, bom *sbom.Document) error {
 	}
 
 	for _, n := range bom.NodeList.Nodes {
+		if n.Type == sbom.Node_SERVICE {
+			state.servicesDict[n.Id] = s.nodeToService(n)
+			continue
+		}
+
 		comp := s.nodeToComponent(n)
 		if comp == nil {
 			// Error? Warn?
@@ -218,12 +611,23 @@ func (s *CDX) dependencies(ctx context.Context, bom *sbom.Document) ([]cdx.Depen
 		return nil, fmt.Errorf("reading state: %w", err)
 	}
 
+	// TODO(degradation): CycloneDX dependencies have no properties, the
+	// properties of the edges are lost.
 	for _, e := range bom.NodeList.Edges {
 		e := e
 		if _, ok := state.addedDict[e.From]; ok {
 			continue
 		}
 
+		if _, ok := state.servicesDict[e.From]; ok {
+			deps, err := s.serviceEdge(state, e)
+			if err != nil {
+				return nil, err
+			}
+			dependencies = append(dependencies, deps...)
+			continue
+		}
+
 		if _, ok := state.componentsDict[e.From]; !ok {
 			logrus.Info("serialize")
 			return nil, fmt.Errorf("unable to find component %s", e.From)
@@ -236,6 +640,16 @@ func (s *CDX) dependencies(ctx context.Context, bom *sbom.Document) ([]cdx.Depen
 		case sbom.Edge_contains:
 			// Make sure we have the target component
 			for _, targetID := range e.To {
+				// TODO(degradation): Nodes in external documents are not
+				// supported in CycloneDX, edges to them are dropped.
+				if _, ok := state.componentsDict[targetID]; !ok && isExternalNodeID(targetID) {
+					continue
+				}
+				// TODO(degradation): Components cannot contain services,
+				// the service is kept at the top level of the document.
+				if _, ok := state.servicesDict[targetID]; ok {
+					continue
+				}
 				state.addedDict[targetID] = struct{}{}
 				if _, ok := state.componentsDict[targetID]; !ok {
 					return nil, fmt.Errorf("unable to locate node %s", targetID)
@@ -247,6 +661,9 @@ func (s *CDX) dependencies(ctx context.Context, bom *sbom.Document) ([]cdx.Depen
 				*state.componentsDict[e.From].Components = append(*state.componentsDict[e.From].Components, *state.componentsDict[targetID])
 			}
 
+		case sbom.Edge_ancestor, sbom.Edge_descendant, sbom.Edge_variant:
+			// Captured in the component pedigree
+
 		case sbom.Edge_dependsOn:
 			// Add to the dependency tree
 			targetStrings := []string{}
@@ -257,6 +674,19 @@ func (s *CDX) dependencies(ctx context.Context, bom *sbom.Document) ([]cdx.Depen
 					continue
 				}
 
+				// TODO(degradation): Dependencies on external documents
+				if _, ok := state.componentsDict[targetID]; !ok && isExternalNodeID(targetID) {
+					continue
+				}
+
+				// Services are listed in the dependency tree but they
+				// remain in the services section of the document.
+				if _, ok := state.servicesDict[targetID]; ok {
+					depListCheck[targetID] = struct{}{}
+					targetStrings = append(targetStrings, targetID)
+					continue
+				}
+
 				if _, ok := state.componentsDict[targetID]; !ok {
 					return nil, fmt.Errorf("unable to locate node %s", targetID)
 				}
@@ -281,6 +711,348 @@ func (s *CDX) dependencies(ctx context.Context, bom *sbom.Document) ([]cdx.Depen
 	return dependencies, nil
 }
 
+// pedigree nests the ancestors, descendants, variants and patches of the
+// components in their pedigree. The component nodes are related to their
+// pedigree with descendant, ancestor and variant edges while patches point to
+// the patched components. It needs to run before dependencies as components
+// are copied when they are nested.
+func (s *CDX) pedigree(ctx context.Context, bom *sbom.Document) error {
+	state, err := getCDXState(ctx)
+	if err != nil {
+		return fmt.Errorf("reading state: %w", err)
+	}
+
+	related := map[string]map[sbom.Edge_Type][]string{}
+	patches := map[string][]*sbom.Node{}
+	for _, e := range bom.NodeList.Edges {
+		switch e.Type {
+		case sbom.Edge_ancestor, sbom.Edge_descendant, sbom.Edge_variant:
+			if _, ok := state.componentsDict[e.From]; !ok {
+				continue
+			}
+			if related[e.From] == nil {
+				related[e.From] = map[sbom.Edge_Type][]string{}
+			}
+			related[e.From][e.Type] = append(related[e.From][e.Type], e.To...)
+		case sbom.Edge_patch:
+			patch := bom.NodeList.GetNodeByID(e.From)
+			if patch == nil {
+				continue
+			}
+			for _, targetID := range e.To {
+				patches[targetID] = append(patches[targetID], patch)
+			}
+			state.addedDict[e.From] = struct{}{}
+		default:
+		}
+	}
+
+	// Components are nested once their own pedigree is complete
+	done := map[string]struct{}{}
+	var build func(id string) error
+	build = func(id string) error {
+		if _, ok := done[id]; ok {
+			return nil
+		}
+		done[id] = struct{}{}
+		c := state.componentsDict[id]
+
+		for _, rel := range []struct {
+			edgeType sbom.Edge_Type
+			list     func(*cdx.Pedigree) **[]cdx.Component
+		}{
+			{sbom.Edge_descendant, func(p *cdx.Pedigree) **[]cdx.Component { return &p.Ancestors }},
+			{sbom.Edge_ancestor, func(p *cdx.Pedigree) **[]cdx.Component { return &p.Descendants }},
+			{sbom.Edge_variant, func(p *cdx.Pedigree) **[]cdx.Component { return &p.Variants }},
+		} {
+			for _, targetID := range related[id][rel.edgeType] {
+				// TODO(degradation): Pedigree components in external
+				// documents are dropped.
+				if _, ok := state.componentsDict[targetID]; !ok && isExternalNodeID(targetID) {
+					continue
+				}
+				if _, ok := state.componentsDict[targetID]; !ok {
+					return fmt.Errorf("unable to locate node %s", targetID)
+				}
+				if err := build(targetID); err != nil {
+					return err
+				}
+				state.addedDict[targetID] = struct{}{}
+				if c.Pedigree == nil {
+					c.Pedigree = &cdx.Pedigree{}
+				}
+				list := rel.list(c.Pedigree)
+				if *list == nil {
+					*list = &[]cdx.Component{}
+				}
+				**list = append(**list, *state.componentsDict[targetID])
+			}
+		}
+
+		for _, p := range patches[id] {
+			if c.Pedigree == nil {
+				c.Pedigree = &cdx.Pedigree{}
+			}
+			if c.Pedigree.Patches == nil {
+				c.Pedigree.Patches = &[]cdx.Patch{}
+			}
+			*c.Pedigree.Patches = append(*c.Pedigree.Patches, s.nodeToPatch(p))
+		}
+		return nil
+	}
+
+	for _, n := range bom.NodeList.Nodes {
+		if _, ok := state.componentsDict[n.Id]; !ok {
+			continue
+		}
+		if err := build(n.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nodeToPatch converts a patch node to a CycloneDX patch. The download URL
+// of the node is the URL of the diff.
+func (s *CDX) nodeToPatch(n *sbom.Node) cdx.Patch {
+	p := cdx.Patch{
+		Type: s.protobomPatchTypeToCdx(n.GetPatch().GetType()),
+	}
+
+	if n.GetUrlDownload() != "" || n.GetPatch().GetDiff() != "" {
+		p.Diff = &cdx.Diff{URL: n.GetUrlDownload()}
+		if n.GetPatch().GetDiff() != "" {
+			p.Diff.Text = &cdx.AttachedText{Content: n.GetPatch().GetDiff()}
+		}
+	}
+
+	if len(n.GetPatch().GetResolves()) > 0 {
+		issues := []cdx.Issue{}
+		for _, i := range n.GetPatch().GetResolves() {
+			issue := cdx.Issue{
+				Type:        s.protobomIssueTypeToCdx(i.GetType()),
+				ID:          i.GetId(),
+				Name:        i.GetName(),
+				Description: i.GetDescription(),
+			}
+			if i.GetSourceName() != "" || i.GetSourceUrl() != "" {
+				issue.Source = &cdx.Source{Name: i.GetSourceName(), URL: i.GetSourceUrl()}
+			}
+			if len(i.GetReferences()) > 0 {
+				refs := slices.Clone(i.GetReferences())
+				issue.References = &refs
+			}
+			issues = append(issues, issue)
+		}
+		p.Resolves = &issues
+	}
+
+	return p
+}
+
+// commitToCDX converts a commit to a CycloneDX pedigree commit
+func (s *CDX) commitToCDX(c *sbom.Commit) cdx.Commit {
+	commit := cdx.Commit{
+		UID:     c.GetUid(),
+		URL:     c.GetUrl(),
+		Message: c.GetMessage(),
+	}
+	if c.GetAuthor() != nil || c.GetAuthored() != nil {
+		commit.Author = &cdx.IdentifiableAction{
+			Timestamp: cdxTimestamp(c.GetAuthored()),
+			Name:      c.GetAuthor().GetName(),
+			Email:     c.GetAuthor().GetEmail(),
+		}
+	}
+	if c.GetCommitter() != nil || c.GetCommitted() != nil {
+		commit.Committer = &cdx.IdentifiableAction{
+			Timestamp: cdxTimestamp(c.GetCommitted()),
+			Name:      c.GetCommitter().GetName(),
+			Email:     c.GetCommitter().GetEmail(),
+		}
+	}
+	return commit
+}
+
+// evidenceToCDX converts the evidence of a node to CycloneDX
+func (s *CDX) evidenceToCDX(e *sbom.Evidence) *cdx.Evidence {
+	evidence := &cdx.Evidence{}
+
+	// TODO(degradation): CycloneDX 1.5 supports a single identity evidence,
+	// only the first one is kept.
+	if len(e.GetIdentity()) > 0 {
+		id := e.GetIdentity()[0]
+		evidence.Identity = &cdx.EvidenceIdentity{
+			Field:      cdx.EvidenceIdentityFieldType(id.GetField()),
+			Confidence: id.Confidence,
+		}
+		if len(id.GetMethods()) > 0 {
+			methods := []cdx.EvidenceIdentityMethod{}
+			for _, m := range id.GetMethods() {
+				methods = append(methods, cdx.EvidenceIdentityMethod{
+					Technique:  cdx.EvidenceIdentityTechnique(m.GetTechnique()),
+					Confidence: m.Confidence,
+					Value:      m.GetValue(),
+				})
+			}
+			evidence.Identity.Methods = &methods
+		}
+		if len(id.GetTools()) > 0 {
+			tools := []cdx.BOMReference{}
+			for _, t := range id.GetTools() {
+				tools = append(tools, cdx.BOMReference(t))
+			}
+			evidence.Identity.Tools = &tools
+		}
+	}
+
+	if len(e.GetOccurrences()) > 0 {
+		occurrences := []cdx.EvidenceOccurrence{}
+		for _, o := range e.GetOccurrences() {
+			occurrences = append(occurrences, cdx.EvidenceOccurrence{
+				BOMRef:            o.GetId(),
+				Location:          o.GetLocation(),
+				Line:              int32ToInt(o.Line),
+				Offset:            int32ToInt(o.Offset),
+				Symbol:            o.GetSymbol(),
+				AdditionalContext: o.GetAdditionalContext(),
+			})
+		}
+		evidence.Occurrences = &occurrences
+	}
+
+	if len(e.GetCallstack()) > 0 {
+		frames := []cdx.CallstackFrame{}
+		for _, f := range e.GetCallstack() {
+			frame := cdx.CallstackFrame{
+				Package:      f.GetPackage(),
+				Module:       f.GetModule(),
+				Function:     f.GetFunction(),
+				Line:         int32ToInt(f.Line),
+				Column:       int32ToInt(f.Column),
+				FullFilename: f.GetFullFilename(),
+			}
+			if len(f.GetParameters()) > 0 {
+				params := slices.Clone(f.GetParameters())
+				frame.Parameters = &params
+			}
+			frames = append(frames, frame)
+		}
+		evidence.Callstack = &cdx.Callstack{Frames: &frames}
+	}
+
+	if len(e.GetLicenses()) > 0 {
+		evidence.Licenses = s.licensesToLicenseChoices(e.GetLicenses())
+	}
+
+	if len(e.GetCopyright()) > 0 {
+		copyright := []cdx.Copyright{}
+		for _, c := range e.GetCopyright() {
+			copyright = append(copyright, cdx.Copyright{Text: c})
+		}
+		evidence.Copyright = &copyright
+	}
+
+	return evidence
+}
+
+// int32ToInt converts the optional int32 evidence fields
+func int32ToInt(i *int32) *int {
+	if i == nil {
+		return nil
+	}
+	ret := int(*i)
+	return &ret
+}
+
+// protobomPatchTypeToCdx converts a protobom patch type to CycloneDX
+func (s *CDX) protobomPatchTypeToCdx(t sbom.Patch_Type) cdx.PatchType {
+	switch t {
+	case sbom.Patch_MONKEY:
+		return cdx.PatchTypeMonkey
+	case sbom.Patch_BACKPORT:
+		return cdx.PatchTypeBackport
+	case sbom.Patch_CHERRY_PICK:
+		return cdx.PatchTypeCherryPick
+	default:
+		// TODO(degradation): CycloneDX patches require a type, unknown
+		// patches are written as unofficial.
+		return cdx.PatchTypeUnofficial
+	}
+}
+
+// protobomIssueTypeToCdx converts the type of an issue resolved by a patch
+func (s *CDX) protobomIssueTypeToCdx(t sbom.PatchIssue_Type) cdx.IssueType {
+	switch t {
+	case sbom.PatchIssue_ENHANCEMENT:
+		return cdx.IssueTypeEnhancement
+	case sbom.PatchIssue_SECURITY:
+		return cdx.IssueTypeSecurity
+	default:
+		// TODO(degradation): CycloneDX issues require a type, unknown
+		// issues are written as defects.
+		return cdx.IssueTypeDefect
+	}
+}
+
+// serviceEdge handles an edge originating in a service node. Services nest
+// the services they contain and list their dependencies in the dependency
+// tree of the document.
+func (s *CDX) serviceEdge(state *serializerCDXState, e *sbom.Edge) ([]cdx.Dependency, error) {
+	service := state.servicesDict[e.From]
+	switch e.Type {
+	case sbom.Edge_contains:
+		for _, targetID := range e.To {
+			if _, ok := state.servicesDict[targetID]; !ok {
+				// TODO(degradation): Services can only contain other
+				// services, other nodes are left in place.
+				if _, ok := state.componentsDict[targetID]; !ok && !isExternalNodeID(targetID) {
+					return nil, fmt.Errorf("unable to locate node %s", targetID)
+				}
+				continue
+			}
+			state.addedDict[targetID] = struct{}{}
+			if service.Services == nil {
+				service.Services = &[]cdx.Service{}
+			}
+			*service.Services = append(*service.Services, *state.servicesDict[targetID])
+		}
+	case sbom.Edge_dependsOn:
+		targetStrings := []string{}
+		depListCheck := map[string]struct{}{}
+		for _, targetID := range e.To {
+			if _, ok := depListCheck[targetID]; ok {
+				continue
+			}
+			_, isService := state.servicesDict[targetID]
+			_, isComponent := state.componentsDict[targetID]
+			if !isService && !isComponent {
+				// TODO(degradation): Dependencies on external documents
+				if isExternalNodeID(targetID) {
+					continue
+				}
+				return nil, fmt.Errorf("unable to locate node %s", targetID)
+			}
+			depListCheck[targetID] = struct{}{}
+			targetStrings = append(targetStrings, targetID)
+		}
+		return []cdx.Dependency{{Ref: e.From, Dependencies: &targetStrings}}, nil
+	default:
+		// TODO(degradation) here, we would document how relationships are lost
+		logrus.Warnf(
+			"service %s is related with %s to %d other nodes, data will be lost",
+			e.From, e.Type, len(e.To),
+		)
+	}
+	return nil, nil
+}
+
+// isExternalNodeID returns true if the ID points to a node in another document
+func isExternalNodeID(id string) bool {
+	_, _, ok := sbom.ParseExternalNodeID(id)
+	return ok
+}
+
 // nodeToComponent converts a node in protobuf to a CycloneDX component
 func (s *CDX) nodeToComponent(n *sbom.Node) *cdx.Component {
 	if n == nil {
@@ -296,7 +1068,7 @@ func (s *CDX) nodeToComponent(n *sbom.Node) *cdx.Component {
 		ExternalReferences: &[]cdx.ExternalReference{},
 	}
 
-	if n.Type == sbom.Node_FILE {
+	if n.Type == sbom.Node_FILE || n.Type == sbom.Node_SNIPPET {
 		c.Type = cdx.ComponentTypeFile
 	} else if len(n.PrimaryPurpose) > 0 {
 		componentType, err := s.purposeToComponentType(n.PrimaryPurpose[0])
@@ -307,19 +1079,10 @@ func (s *CDX) nodeToComponent(n *sbom.Node) *cdx.Component {
 		// cdx.Component only allows single Type so we are using the first
 	}
 
-	if n.Licenses != nil && len(n.Licenses) > 0 {
-		var licenseChoices []cdx.LicenseChoice
-		var licenses cdx.Licenses
-		for _, l := range n.Licenses {
-			licenseChoices = append(licenseChoices, cdx.LicenseChoice{
-				License: &cdx.License{
-					ID: l,
-				},
-			})
+	if len(n.Licenses) > 0 {
+		if licenses := s.licensesToLicenseChoices(n.Licenses); len(*licenses) > 0 {
+			c.Licenses = licenses
 		}
-
-		licenses = licenseChoices
-		c.Licenses = &licenses
 	}
 
 	if n.Hashes != nil && len(n.Hashes) > 0 {
@@ -338,27 +1101,7 @@ func (s *CDX) nodeToComponent(n *sbom.Node) *cdx.Component {
 
 	if n.ExternalReferences != nil {
 		for _, er := range n.ExternalReferences {
-			cdxRef := cdx.ExternalReference{
-				URL:     er.Url,
-				Comment: er.Comment,
-				Type:    s.protobomExtRefTypeToCdxType(er.Type),
-			}
-			hashList := []cdx.Hash{}
-			for protoAlgo, val := range er.Hashes {
-				cdxAlgo, err := s.protoHashAlgoToCdxAlgo(sbom.HashAlgorithm(protoAlgo))
-				if err != nil {
-					// TODO(degradation): Hash not supported
-					continue
-				}
-				hashList = append(hashList, cdx.Hash{
-					Algorithm: cdxAlgo,
-					Value:     val,
-				})
-			}
-			if len(hashList) > 0 {
-				cdxRef.Hashes = &hashList
-			}
-			*c.ExternalReferences = append(*c.ExternalReferences, cdxRef)
+			*c.ExternalReferences = append(*c.ExternalReferences, s.externalReferenceToCDX(er))
 		}
 	}
 
@@ -381,32 +1124,214 @@ func (s *CDX) nodeToComponent(n *sbom.Node) *cdx.Component {
 	if n.Suppliers != nil && len(n.GetSuppliers()) > 0 {
 		// TODO(degradation): CDX type Component only supports one Supplier while protobom supports multiple
 
-		nodesupplier := n.GetSuppliers()[0]
-		oe := cdx.OrganizationalEntity{
-			Name: nodesupplier.GetName(),
-		}
-		if nodesupplier.Contacts != nil {
-			var contacts []cdx.OrganizationalContact
-			for _, nodecontact := range nodesupplier.GetContacts() {
-				newcontact := cdx.OrganizationalContact{
-					Name:  nodecontact.GetName(),
-					Email: nodecontact.GetEmail(),
-					Phone: nodecontact.GetPhone(),
-				}
-				contacts = append(contacts, newcontact)
-			}
-			oe.Contact = &contacts
-		}
-		c.Supplier = &oe
+		c.Supplier = s.personToOrganizationalEntity(n.GetSuppliers()[0])
 	}
 
 	if n.GetCopyright() != "" {
 		c.Copyright = n.GetCopyright()
 	}
 
+	// Provenance entries are serialized as protobom:provenance properties
+	props := slices.Clone(n.GetProperties())
+	for _, p := range n.GetProvenance() {
+		props = append(props, p.ToProperty())
+	}
+
+	// TODO(degradation): CycloneDX has no snippets. They are rendered as
+	// file components, nested in the component of their file, with their
+	// ranges recorded in protobom:snippet properties.
+	if n.Type == sbom.Node_SNIPPET {
+		if n.ByteRange != nil {
+			props = append(props, n.ByteRange.ToProperty(sbom.SnippetByteRangePropertyName))
+		}
+		if n.LineRange != nil {
+			props = append(props, n.LineRange.ToProperty(sbom.SnippetLineRangePropertyName))
+		}
+	}
+	if len(props) > 0 {
+		c.Properties = s.propertiesToCDX(props)
+	}
+
+	if len(n.GetCommits()) > 0 || n.GetPedigreeNotes() != "" {
+		c.Pedigree = &cdx.Pedigree{Notes: n.GetPedigreeNotes()}
+		if len(n.GetCommits()) > 0 {
+			commits := []cdx.Commit{}
+			for _, commit := range n.GetCommits() {
+				commits = append(commits, s.commitToCDX(commit))
+			}
+			c.Pedigree.Commits = &commits
+		}
+	}
+
+	if n.GetEvidence() != nil {
+		c.Evidence = s.evidenceToCDX(n.GetEvidence())
+	}
+
 	return c
 }
 
+// externalReferenceToCDX converts a protobom external reference
+func (s *CDX) externalReferenceToCDX(er *sbom.ExternalReference) cdx.ExternalReference {
+	cdxRef := cdx.ExternalReference{
+		URL:     er.Url,
+		Comment: er.Comment,
+		Type:    s.protobomExtRefTypeToCdxType(er.Type),
+	}
+	hashList := []cdx.Hash{}
+	for protoAlgo, val := range er.Hashes {
+		cdxAlgo, err := s.protoHashAlgoToCdxAlgo(sbom.HashAlgorithm(protoAlgo))
+		if err != nil {
+			// TODO(degradation): Hash not supported
+			continue
+		}
+		hashList = append(hashList, cdx.Hash{
+			Algorithm: cdxAlgo,
+			Value:     val,
+		})
+	}
+	if len(hashList) > 0 {
+		cdxRef.Hashes = &hashList
+	}
+	return cdxRef
+}
+
+// personToOrganizationalEntity converts a protobom person to a CycloneDX
+// organizational entity.
+func (s *CDX) personToOrganizationalEntity(p *sbom.Person) *cdx.OrganizationalEntity {
+	oe := cdx.OrganizationalEntity{
+		Name: p.GetName(),
+	}
+	if p.GetUrl() != "" {
+		oe.URL = &[]string{p.GetUrl()}
+	}
+	if p.Contacts != nil {
+		var contacts []cdx.OrganizationalContact
+		for _, nodecontact := range p.GetContacts() {
+			newcontact := cdx.OrganizationalContact{
+				Name:  nodecontact.GetName(),
+				Email: nodecontact.GetEmail(),
+				Phone: nodecontact.GetPhone(),
+			}
+			contacts = append(contacts, newcontact)
+		}
+		oe.Contact = &contacts
+	}
+	return &oe
+}
+
+// nodeToService converts a node of type SERVICE to a CycloneDX service
+func (s *CDX) nodeToService(n *sbom.Node) *cdx.Service {
+	if n == nil {
+		return nil
+	}
+
+	svc := &cdx.Service{
+		BOMRef:               n.Id,
+		Name:                 n.Name,
+		Version:              n.Version,
+		Description:          n.Description,
+		Authenticated:        n.Authenticated,
+		CrossesTrustBoundary: n.CrossesTrustBoundary,
+	}
+
+	if len(n.GetSuppliers()) > 0 {
+		// TODO(degradation): CDX services only support one provider
+		svc.Provider = s.personToOrganizationalEntity(n.GetSuppliers()[0])
+	}
+
+	if len(n.GetEndpoints()) > 0 {
+		endpoints := slices.Clone(n.GetEndpoints())
+		svc.Endpoints = &endpoints
+	}
+
+	if len(n.GetDataFlows()) > 0 {
+		data := []cdx.DataClassification{}
+		for _, df := range n.GetDataFlows() {
+			data = append(data, cdx.DataClassification{
+				Flow:           s.protobomDataFlowToCdx(df.GetFlow()),
+				Classification: df.GetClassification(),
+			})
+		}
+		svc.Data = &data
+	}
+
+	if len(n.GetLicenses()) > 0 {
+		svc.Licenses = s.licensesToLicenseChoices(n.GetLicenses())
+	}
+
+	if len(n.GetExternalReferences()) > 0 {
+		refs := []cdx.ExternalReference{}
+		for _, er := range n.GetExternalReferences() {
+			refs = append(refs, s.externalReferenceToCDX(er))
+		}
+		svc.ExternalReferences = &refs
+	}
+
+	props := slices.Clone(n.GetProperties())
+	for _, p := range n.GetProvenance() {
+		props = append(props, p.ToProperty())
+	}
+	if len(props) > 0 {
+		svc.Properties = s.propertiesToCDX(props)
+	}
+
+	return svc
+}
+
+// protobomDataFlowToCdx converts a protobom data flow direction
+func (s *CDX) protobomDataFlowToCdx(flow sbom.DataFlow_Direction) cdx.DataFlow {
+	switch flow {
+	case sbom.DataFlow_INBOUND:
+		return cdx.DataFlowInbound
+	case sbom.DataFlow_OUTBOUND:
+		return cdx.DataFlowOutbound
+	case sbom.DataFlow_BIDIRECTIONAL:
+		return cdx.DataFlowBidirectional
+	default:
+		return cdx.DataFlowUnknown
+	}
+}
+
+// licensesToLicenseChoices parses the node licenses as SPDX expressions and
+// converts them to CycloneDX license choices. Single SPDX licenses are
+// emitted as license objects with their ID and other single licenses (custom
+// LicenseRefs or strings that are not expressions) with their name. As
+// CycloneDX does not allow mixing licenses and expressions, if any of the
+// licenses is an expression all of them are combined in a single one.
+func (s *CDX) licensesToLicenseChoices(licenses []string) *cdx.Licenses {
+	choices := cdx.Licenses{}
+	exprs := []license.Expression{}
+	isExpression := false
+	for _, l := range licenses {
+		e, err := license.Parse(l)
+		if err != nil {
+			// TODO(degradation): Strings that are not license expressions
+			// become LicenseRefs when they have to be part of an expression.
+			choices = append(choices, cdx.LicenseChoice{License: &cdx.License{Name: l}})
+			exprs = append(exprs, license.NewLicenseRef(l))
+			continue
+		}
+
+		lic, ok := e.(*license.License)
+		switch {
+		case ok && (lic.ID == license.None || lic.ID == license.NoAssertion):
+			continue
+		case ok && !lic.OrLater && lic.Exception == "" && lic.IsSPDX():
+			choices = append(choices, cdx.LicenseChoice{License: &cdx.License{ID: lic.ID}})
+		case ok && !lic.OrLater && lic.Exception == "":
+			choices = append(choices, cdx.LicenseChoice{License: &cdx.License{Name: l}})
+		default:
+			isExpression = true
+		}
+		exprs = append(exprs, e)
+	}
+
+	if isExpression {
+		return &cdx.Licenses{{Expression: license.Join(license.And, exprs...).String()}}
+	}
+	return &choices
+}
+
 // Render calls the official CDX serializer to render the BOM into a specific version
 func (s *CDX) Render(doc interface{}, wr io.Writer, o *native.RenderOptions, _ interface{}) error {
 	if doc == nil {
@@ -440,12 +1365,14 @@ func (s *CDX) Render(doc interface{}, wr io.Writer, o *native.RenderOptions, _ i
 type serializerCDXState struct {
 	addedDict      map[string]struct{}
 	componentsDict map[string]*cdx.Component
+	servicesDict   map[string]*cdx.Service
 }
 
 func newSerializerCDXState() *serializerCDXState {
 	return &serializerCDXState{
 		addedDict:      map[string]struct{}{},
 		componentsDict: map[string]*cdx.Component{},
+		servicesDict:   map[string]*cdx.Service{},
 	}
 }
 
@@ -461,6 +1388,21 @@ func (s *serializerCDXState) components() []cdx.Component {
 	return components
 }
 
+// services returns the services that are not nested in other services
+func (s *serializerCDXState) services() []cdx.Service {
+	services := []cdx.Service{}
+	for id, svc := range s.servicesDict {
+		if _, ok := s.addedDict[id]; ok {
+			continue
+		}
+		services = append(services, *svc)
+	}
+	slices.SortFunc(services, func(a, b cdx.Service) int {
+		return strings.Compare(a.BOMRef, b.BOMRef)
+	})
+	return services
+}
+
 func getCDXState(ctx context.Context) (*serializerCDXState, error) {
 	dm, ok := ctx.Value(stateKey).(*serializerCDXState)
 	if !ok {
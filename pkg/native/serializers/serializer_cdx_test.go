@@ -1,12 +1,18 @@
 package serializers
 
 import (
+	"bytes"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/CycloneDX/cyclonedx-go"
 	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/protobom/protobom/pkg/native"
+	"github.com/protobom/protobom/pkg/native/unserializers"
 	"github.com/protobom/protobom/pkg/sbom"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestComponentType(t *testing.T) {
@@ -190,3 +196,531 @@ func TestPurposeToComponentType(t *testing.T) {
 		require.Equal(t, cdxType, res)
 	}
 }
+
+func TestLicensesToLicenseChoices(t *testing.T) {
+	sut := CDX{}
+	for name, tc := range map[string]struct {
+		licenses []string
+		expected cdx.Licenses
+	}{
+		"empty": {[]string{}, cdx.Licenses{}},
+		"spdx ids": {
+			[]string{"MIT", "apache-2.0"},
+			cdx.Licenses{{License: &cdx.License{ID: "MIT"}}, {License: &cdx.License{ID: "Apache-2.0"}}},
+		},
+		"custom license": {
+			[]string{"LicenseRef-custom", "Apache License 2.0"},
+			cdx.Licenses{{License: &cdx.License{Name: "LicenseRef-custom"}}, {License: &cdx.License{Name: "Apache License 2.0"}}},
+		},
+		"noassertion skipped": {
+			[]string{"NOASSERTION", "MIT"},
+			cdx.Licenses{{License: &cdx.License{ID: "MIT"}}},
+		},
+		"expressions joined": {
+			[]string{"MIT", "GPL-2.0+", "ISC OR 0BSD"},
+			cdx.Licenses{{Expression: "MIT AND GPL-2.0+ AND (ISC OR 0BSD)"}},
+		},
+		"expression with invalid license": {
+			[]string{"MIT OR ISC", "Apache License 2.0"},
+			cdx.Licenses{{Expression: "(MIT OR ISC) AND LicenseRef-Apache-License-2.0"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, *sut.licensesToLicenseChoices(tc.licenses))
+		})
+	}
+}
+
+func TestLicensesRoundTrip(t *testing.T) {
+	for name, licenses := range map[string][]string{
+		"ids":         {"MIT", "Apache-2.0"},
+		"expressions": {"MIT OR ISC", "GPL-2.0+"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			node := &sbom.Node{Id: "pkg", Type: sbom.Node_PACKAGE, Name: "pkg", Licenses: licenses}
+			expected, err := node.LicenseExpression()
+			require.NoError(t, err)
+
+			doc := sbom.NewDocument()
+			doc.NodeList.AddRootNode(node)
+			s := NewCDX("1.5", "json")
+			res, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(res, &buf, &native.RenderOptions{}, nil))
+
+			doc2, err := unserializers.NewCDX("1.5", "json").Unserialize(&buf, &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+			node2 := doc2.NodeList.GetNodeByID("pkg")
+			require.NotNil(t, node2)
+			require.Equal(t, expected.String(), node2.LicenseConcluded)
+			res2, err := node2.LicenseExpression()
+			require.NoError(t, err)
+			require.Equal(t, expected.String(), res2.String())
+		})
+	}
+}
+
+func TestVulnerabilityToCDX(t *testing.T) {
+	s := &CDX{}
+	for _, tc := range []struct {
+		name     string
+		sut      *sbom.Vulnerability
+		expected cdx.Vulnerability
+	}{
+		{
+			name:     "id only",
+			sut:      &sbom.Vulnerability{Id: "CVE-2022-22965"},
+			expected: cdx.Vulnerability{ID: "CVE-2022-22965"},
+		},
+		{
+			name: "full vulnerability",
+			sut: &sbom.Vulnerability{
+				Id:      "CVE-2021-44228",
+				Source:  &sbom.VulnerabilitySource{Name: "NVD"},
+				Aliases: []string{"GHSA-jfh8-c2jp-5v3q"},
+				Ratings: []*sbom.VulnerabilityRating{
+					{Score: 10, Severity: sbom.VulnerabilityRating_CRITICAL, Method: sbom.VulnerabilityRating_CVSSV31},
+				},
+				Cwes:      []int32{502},
+				Published: timestamppb.New(time.Date(2021, 12, 10, 10, 15, 9, 0, time.UTC)),
+				Affects:   []string{"log4j"},
+				Analysis: &sbom.VulnerabilityAnalysis{
+					Status:        sbom.VulnerabilityAnalysis_NOT_AFFECTED,
+					Justification: sbom.VulnerabilityAnalysis_COMPONENT_NOT_PRESENT,
+					Responses:     []sbom.VulnerabilityAnalysis_Response{sbom.VulnerabilityAnalysis_UPDATE},
+					Action:        "Upgrade to 2.17.1",
+				},
+			},
+			expected: cdx.Vulnerability{
+				ID:         "CVE-2021-44228",
+				Source:     &cdx.Source{Name: "NVD"},
+				References: &[]cdx.VulnerabilityReference{{ID: "GHSA-jfh8-c2jp-5v3q"}},
+				Ratings: &[]cdx.VulnerabilityRating{
+					{Score: func() *float64 { f := 10.0; return &f }(), Severity: cdx.SeverityCritical, Method: cdx.ScoringMethodCVSSv31},
+				},
+				CWEs:       &[]int{502},
+				Published:  "2021-12-10T10:15:09Z",
+				Workaround: "Upgrade to 2.17.1",
+				Analysis: &cdx.VulnerabilityAnalysis{
+					State:         cdx.IASNotAffected,
+					Justification: cdx.IAJCodeNotPresent,
+					Response:      &[]cdx.ImpactAnalysisResponse{cdx.IARUpdate},
+				},
+				Affects: &[]cdx.Affects{{Ref: "log4j"}},
+			},
+		},
+		{
+			name: "analysis with only an action",
+			sut: &sbom.Vulnerability{
+				Id:       "CVE-2022-22965",
+				Analysis: &sbom.VulnerabilityAnalysis{Action: "Downgrade to JDK 8"},
+			},
+			expected: cdx.Vulnerability{ID: "CVE-2022-22965", Workaround: "Downgrade to JDK 8"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, s.vulnerabilityToCDX(tc.sut))
+		})
+	}
+}
+
+func TestAnnotationToCDX(t *testing.T) {
+	s := &CDX{}
+	date := timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	for _, tc := range []struct {
+		name     string
+		sut      *sbom.Annotation
+		expected cdx.Annotation
+	}{
+		{
+			name: "person",
+			sut: &sbom.Annotation{
+				Type: sbom.Annotation_REVIEW, Date: date, Text: "Looks good",
+				Annotator: &sbom.Person{Name: "Jane Doe", Email: "jane@example.com"},
+			},
+			expected: cdx.Annotation{
+				Subjects:  &[]cdx.BOMReference{"log4j"},
+				Timestamp: "2024-01-01T00:00:00Z",
+				Text:      "Looks good",
+				Annotator: &cdx.Annotator{Individual: &cdx.OrganizationalContact{Name: "Jane Doe", Email: "jane@example.com"}},
+			},
+		},
+		{
+			name: "organization",
+			sut: &sbom.Annotation{
+				Text: "Vendored", Annotator: &sbom.Person{Name: "ACME", IsOrg: true, Url: "https://example.com"},
+			},
+			expected: cdx.Annotation{
+				Subjects:  &[]cdx.BOMReference{"log4j"},
+				Text:      "Vendored",
+				Annotator: &cdx.Annotator{Organization: &cdx.OrganizationalEntity{Name: "ACME", URL: &[]string{"https://example.com"}}},
+			},
+		},
+		{
+			name: "tool",
+			sut:  &sbom.Annotation{Text: "Scanned", Tool: &sbom.Tool{Name: "scanner", Version: "1.0", Vendor: "ACME"}},
+			expected: cdx.Annotation{
+				Subjects: &[]cdx.BOMReference{"log4j"},
+				Text:     "Scanned",
+				Annotator: &cdx.Annotator{Component: &cdx.Component{
+					Type: cdx.ComponentTypeApplication, Name: "scanner", Version: "1.0", Publisher: "ACME",
+				}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, s.annotationToCDX(tc.sut, "log4j"))
+		})
+	}
+}
+
+func TestPropertiesToCDX(t *testing.T) {
+	s := &CDX{}
+	props := []*sbom.Property{
+		{Name: "syft", Properties: []*sbom.Property{{Name: "package:foundBy", Value: "java-cataloger"}}},
+		{Name: "scope", Value: "release"},
+	}
+	require.Equal(t, &[]cdx.Property{
+		{Name: "syft:package:foundBy", Value: "java-cataloger"},
+		{Name: "scope", Value: "release"},
+	}, s.propertiesToCDX(props))
+}
+
+func TestNodeToComponentProvenance(t *testing.T) {
+	s := &CDX{}
+	n := &sbom.Node{Id: "log4j", Name: "log4j-core", Properties: []*sbom.Property{{Name: "scope", Value: "release"}}}
+	p := &sbom.Provenance{DocumentId: "scan", Tool: &sbom.Tool{Name: "scanner"}, Fields: []string{"licenses"}}
+	n.AddProvenance(p)
+
+	c := s.nodeToComponent(n)
+	require.NotNil(t, c.Properties)
+	require.Equal(t, []cdx.Property{
+		{Name: "scope", Value: "release"},
+		{Name: sbom.ProvenancePropertyName, Value: "document=scan&field=licenses&tool=scanner"},
+	}, *c.Properties)
+}
+
+func TestNodeToComponentSnippet(t *testing.T) {
+	s := &CDX{}
+	n := &sbom.Node{
+		Id: "snippet", Type: sbom.Node_SNIPPET, Name: "vendored.c",
+		ByteRange: &sbom.SnippetRange{Start: 310, End: 420},
+		LineRange: &sbom.SnippetRange{Start: 5, End: 23},
+	}
+
+	c := s.nodeToComponent(n)
+	require.Equal(t, cdx.ComponentTypeFile, c.Type)
+	require.NotNil(t, c.Properties)
+	require.Equal(t, []cdx.Property{
+		{Name: sbom.SnippetByteRangePropertyName, Value: "310:420"},
+		{Name: sbom.SnippetLineRangePropertyName, Value: "5:23"},
+	}, *c.Properties)
+}
+
+func TestNodeToService(t *testing.T) {
+	s := &CDX{}
+	authenticated := true
+	n := &sbom.Node{
+		Id: "api", Type: sbom.Node_SERVICE, Name: "api", Version: "2.0",
+		Suppliers:     []*sbom.Person{{Name: "Acme", IsOrg: true, Url: "https://acme.example"}},
+		Endpoints:     []string{"https://api.acme.example/v2"},
+		Authenticated: &authenticated,
+		DataFlows: []*sbom.DataFlow{
+			{Flow: sbom.DataFlow_OUTBOUND, Classification: "PII"},
+			{Flow: sbom.DataFlow_UNKNOWN, Classification: "telemetry"},
+		},
+	}
+
+	svc := s.nodeToService(n)
+	require.Equal(t, "api", svc.BOMRef)
+	require.Equal(t, "2.0", svc.Version)
+	require.NotNil(t, svc.Provider)
+	require.Equal(t, "Acme", svc.Provider.Name)
+	require.Equal(t, &[]string{"https://acme.example"}, svc.Provider.URL)
+	require.Equal(t, &[]string{"https://api.acme.example/v2"}, svc.Endpoints)
+	require.Equal(t, &authenticated, svc.Authenticated)
+	require.Nil(t, svc.CrossesTrustBoundary)
+	require.Equal(t, &[]cdx.DataClassification{
+		{Flow: cdx.DataFlowOutbound, Classification: "PII"},
+		{Flow: cdx.DataFlowUnknown, Classification: "telemetry"},
+	}, svc.Data)
+}
+
+func TestSerializeServices(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Id = "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79"
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib", Name: "lib"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "api", Type: sbom.Node_SERVICE, Name: "api"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "auth", Type: sbom.Node_SERVICE, Name: "auth"})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib", "api"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "api", To: []string{"auth"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib", To: []string{"api"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "api", To: []string{"lib"}})
+
+	s := NewCDX("1.5", "json")
+	res, err := s.Serialize(doc, nil, nil)
+	require.NoError(t, err)
+	bom, ok := res.(*cdx.BOM)
+	require.True(t, ok)
+
+	require.NotNil(t, bom.Services)
+	require.Len(t, *bom.Services, 1)
+	api := (*bom.Services)[0]
+	require.Equal(t, "api", api.BOMRef)
+	require.NotNil(t, api.Services)
+	require.Len(t, *api.Services, 1)
+	require.Equal(t, "auth", (*api.Services)[0].BOMRef)
+
+	require.NotNil(t, bom.Dependencies)
+	require.ElementsMatch(t, []cdx.Dependency{
+		{Ref: "lib", Dependencies: &[]string{"api"}},
+		{Ref: "api", Dependencies: &[]string{"lib"}},
+	}, *bom.Dependencies)
+}
+
+func TestSerializeServiceRoot(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Name = "services"
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "api", Type: sbom.Node_SERVICE, Name: "api"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "auth", Type: sbom.Node_SERVICE, Name: "auth"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib", Name: "lib"})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "api", To: []string{"auth", "lib"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "api", To: []string{"lib"}})
+
+	res, err := NewCDX("1.5", "json").Serialize(doc, nil, nil)
+	require.NoError(t, err)
+	bom, ok := res.(*cdx.BOM)
+	require.True(t, ok)
+
+	// The root service is not written as the metadata component
+	require.Nil(t, bom.Metadata.Component)
+	require.NotNil(t, bom.Services)
+	require.Len(t, *bom.Services, 1)
+	api := (*bom.Services)[0]
+	require.Equal(t, "api", api.BOMRef)
+	require.NotNil(t, api.Services)
+	require.Equal(t, "auth", (*api.Services)[0].BOMRef)
+
+	require.NotNil(t, bom.Components)
+	require.Len(t, *bom.Components, 1)
+	require.Equal(t, "lib", (*bom.Components)[0].BOMRef)
+	require.Equal(t, []cdx.Dependency{{Ref: "api", Dependencies: &[]string{"lib"}}}, *bom.Dependencies)
+}
+
+func TestServicesRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		data     string
+		roots    []string
+		services []string
+		contains map[string][]string
+		depends  map[string][]string
+	}{
+		{
+			name: "services only",
+			data: `{
+  "bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+  "serialNumber": "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+  "services": [
+    {
+      "bom-ref": "api", "name": "api", "version": "2.0",
+      "endpoints": ["https://api.acme.example/v2"],
+      "services": [{"bom-ref": "auth", "name": "auth"}]
+    },
+    {"bom-ref": "db", "name": "db"}
+  ],
+  "dependencies": [{"ref": "api", "dependsOn": ["auth", "db"]}]
+}`,
+			roots:    []string{"api", "db"},
+			services: []string{"api", "db"},
+			contains: map[string][]string{"api": {"auth"}},
+			depends:  map[string][]string{"api": {"auth", "db"}},
+		},
+		{
+			name: "services and components",
+			data: `{
+  "bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+  "serialNumber": "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+  "metadata": {"component": {"bom-ref": "app", "type": "application", "name": "app"}},
+  "components": [{"bom-ref": "lib", "type": "library", "name": "lib"}],
+  "services": [{"bom-ref": "api", "name": "api"}, {"bom-ref": "db", "name": "db"}],
+  "dependencies": [{"ref": "api", "dependsOn": ["lib", "db"]}]
+}`,
+			roots:    []string{"app"},
+			services: []string{"api", "db"},
+			contains: map[string][]string{"app": {"lib", "api", "db"}},
+			depends:  map[string][]string{"api": {"lib", "db"}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			check := func(doc *sbom.Document) {
+				t.Helper()
+				require.Equal(t, tc.roots, doc.NodeList.RootElements)
+				require.Len(t, doc.NodeList.Edges, len(tc.contains)+len(tc.depends))
+				for from, to := range tc.contains {
+					e := doc.NodeList.GetEdgeByType(from, sbom.Edge_contains)
+					require.NotNil(t, e, from)
+					require.Equal(t, to, e.To)
+				}
+				for from, to := range tc.depends {
+					e := doc.NodeList.GetEdgeByType(from, sbom.Edge_dependsOn)
+					require.NotNil(t, e, from)
+					require.Equal(t, to, e.To)
+				}
+			}
+
+			doc, err := unserializers.NewCDX("1.5", "json").Unserialize(strings.NewReader(tc.data), &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+			check(doc)
+
+			s := NewCDX("1.5", "json")
+			res, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+			bom, ok := res.(*cdx.BOM)
+			require.True(t, ok)
+			require.NotNil(t, bom.Services)
+			services := []string{}
+			for _, svc := range *bom.Services {
+				services = append(services, svc.BOMRef)
+			}
+			require.Equal(t, tc.services, services)
+
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(res, &buf, &native.RenderOptions{}, nil))
+			doc2, err := unserializers.NewCDX("1.5", "json").Unserialize(&buf, &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+			check(doc2)
+			require.Len(t, doc2.NodeList.Nodes, len(doc.NodeList.Nodes))
+			for _, n := range doc.NodeList.Nodes {
+				n2 := doc2.NodeList.GetNodeByID(n.Id)
+				require.NotNil(t, n2, n.Id)
+				require.True(t, n.Equal(n2), n.Id)
+			}
+		})
+	}
+}
+
+func TestSerializeReferencedAutoRefs(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Id = "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79"
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "protobom-auto--000000001", Name: "plain"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "protobom-auto--000000002", Name: "vulnerable"})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "protobom-auto--000000003", Name: "annotated",
+		Annotations: []*sbom.Annotation{{Text: "reviewed"}},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{
+		Type: sbom.Edge_contains, From: "app",
+		To: []string{"protobom-auto--000000001", "protobom-auto--000000002", "protobom-auto--000000003"},
+	})
+	doc.Vulnerabilities = []*sbom.Vulnerability{{Id: "CVE-2022-22965", Affects: []string{"protobom-auto--000000002"}}}
+
+	res, err := NewCDX("1.5", "json").Serialize(doc, nil, nil)
+	require.NoError(t, err)
+	bom, ok := res.(*cdx.BOM)
+	require.True(t, ok)
+
+	refs := map[string]string{}
+	for _, c := range *bom.Components {
+		refs[c.Name] = c.BOMRef
+	}
+	require.Equal(t, map[string]string{
+		"plain":      "",
+		"vulnerable": "protobom-auto--000000002",
+		"annotated":  "protobom-auto--000000003",
+	}, refs)
+
+	require.Equal(t, "protobom-auto--000000002", (*(*bom.Vulnerabilities)[0].Affects)[0].Ref)
+	require.Equal(t, []cdx.BOMReference{"protobom-auto--000000003"}, *(*bom.Annotations)[0].Subjects)
+}
+
+func TestSerializePedigree(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Id = "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79"
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "tomcat", Name: "tomcat-catalina", Version: "9.0.14-acme",
+		PedigreeNotes: "Patched by Acme",
+		Commits:       []*sbom.Commit{{Uid: "7638417", Author: &sbom.Person{Name: "me"}}},
+	})
+	doc.NodeList.AddNode(&sbom.Node{Id: "upstream", Name: "tomcat-catalina", Version: "9.0.14"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "base", Name: "tomcat", Version: "9.0.0"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "variant", Name: "tomcat-catalina-slim"})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "patch", Type: sbom.Node_FILE, Name: "fix.diff",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_PATCH},
+		UrlDownload:    "https://acme.example/fix.diff",
+		Patch: &sbom.Patch{
+			Type:     sbom.Patch_BACKPORT,
+			Resolves: []*sbom.PatchIssue{{Type: sbom.PatchIssue_SECURITY, Id: "CVE-2019-0232"}},
+		},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"tomcat"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_descendant, From: "tomcat", To: []string{"upstream"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_variant, From: "tomcat", To: []string{"variant"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_patch, From: "patch", To: []string{"tomcat"}})
+	// The ancestor of the ancestor is nested even if its edge comes later
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_descendant, From: "upstream", To: []string{"base"}})
+
+	s := NewCDX("1.5", "json")
+	res, err := s.Serialize(doc, nil, nil)
+	require.NoError(t, err)
+	bom, ok := res.(*cdx.BOM)
+	require.True(t, ok)
+
+	require.NotNil(t, bom.Components)
+	require.Len(t, *bom.Components, 1)
+	tomcat := (*bom.Components)[0]
+	require.Equal(t, "tomcat", tomcat.BOMRef)
+	require.NotNil(t, tomcat.Pedigree)
+	require.Equal(t, "Patched by Acme", tomcat.Pedigree.Notes)
+	require.Equal(t, &[]cdx.Commit{{UID: "7638417", Author: &cdx.IdentifiableAction{Name: "me"}}}, tomcat.Pedigree.Commits)
+
+	require.NotNil(t, tomcat.Pedigree.Ancestors)
+	require.Len(t, *tomcat.Pedigree.Ancestors, 1)
+	upstream := (*tomcat.Pedigree.Ancestors)[0]
+	require.Equal(t, "upstream", upstream.BOMRef)
+	require.NotNil(t, upstream.Pedigree)
+	require.Equal(t, "base", (*upstream.Pedigree.Ancestors)[0].BOMRef)
+
+	require.Nil(t, tomcat.Pedigree.Descendants)
+	require.NotNil(t, tomcat.Pedigree.Variants)
+	require.Equal(t, "variant", (*tomcat.Pedigree.Variants)[0].BOMRef)
+
+	require.Equal(t, &[]cdx.Patch{{
+		Type:     cdx.PatchTypeBackport,
+		Diff:     &cdx.Diff{URL: "https://acme.example/fix.diff"},
+		Resolves: &[]cdx.Issue{{Type: cdx.IssueTypeSecurity, ID: "CVE-2019-0232"}},
+	}}, tomcat.Pedigree.Patches)
+}
+
+func TestEvidenceToCDX(t *testing.T) {
+	s := &CDX{}
+	confidence := float32(0.8)
+	line := int32(12)
+	e := &sbom.Evidence{
+		Identity: []*sbom.IdentityEvidence{
+			{Field: "purl", Confidence: &confidence, Methods: []*sbom.IdentityMethod{{Technique: "manifest-analysis", Value: "package.json"}}},
+			{Field: "name"},
+		},
+		Occurrences: []*sbom.Occurrence{{Location: "/app/index.js", Line: &line}},
+		Callstack:   []*sbom.CallstackFrame{{Module: "lib", Function: "run", Parameters: []string{"a"}}},
+		Copyright:   []string{"Copyright 2024 Acme"},
+	}
+
+	evidence := s.evidenceToCDX(e)
+	require.NotNil(t, evidence.Identity)
+	require.Equal(t, cdx.EvidenceIdentityFieldTypePURL, evidence.Identity.Field)
+	require.Equal(t, &confidence, evidence.Identity.Confidence)
+	require.Equal(t, &[]cdx.EvidenceIdentityMethod{{Technique: cdx.EvidenceIdentityTechniqueManifestAnalysis, Value: "package.json"}}, evidence.Identity.Methods)
+	require.NotNil(t, evidence.Occurrences)
+	require.Equal(t, 12, *(*evidence.Occurrences)[0].Line)
+	require.Nil(t, (*evidence.Occurrences)[0].Offset)
+	require.NotNil(t, evidence.Callstack)
+	require.Equal(t, &[]string{"a"}, (*evidence.Callstack.Frames)[0].Parameters)
+	require.Nil(t, evidence.Licenses)
+	require.Equal(t, &[]cdx.Copyright{{Text: "Copyright 2024 Acme"}}, evidence.Copyright)
+}
@@ -1,10 +1,14 @@
 package serializers
 
 import (
+	"crypto/sha1" //nolint:gosec // Required by the SPDX package verification code
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
@@ -31,11 +35,39 @@ func NewSPDX23() *SPDX23 {
 	return &SPDX23{}
 }
 
+// jsonPackage renders the files of an SPDX package as the list of their IDs
+// in hasFiles. The SPDX library would otherwise embed the files themselves.
+type jsonPackage struct {
+	*spdx.Package
+	Files    *struct{}             `json:"files,omitempty"`
+	HasFiles []common.DocElementID `json:"hasFiles,omitempty"`
+}
+
+// jsonDocument is an SPDX document with its packages wrapped in jsonPackage
+type jsonDocument struct {
+	*spdx.Document
+	Packages []jsonPackage `json:"packages,omitempty"`
+}
+
 func (s *SPDX23) Render(doc interface{}, wr io.Writer, o *native.RenderOptions, _ interface{}) error {
+	spdxDoc, ok := doc.(*spdx.Document)
+	if !ok {
+		return errors.New("unable to render, document is not an SPDX 2.3 document")
+	}
+
+	jdoc := jsonDocument{Document: spdxDoc, Packages: []jsonPackage{}}
+	for _, p := range spdxDoc.Packages {
+		jp := jsonPackage{Package: p}
+		for _, f := range p.Files {
+			jp.HasFiles = append(jp.HasFiles, common.MakeDocElementID("", string(f.FileSPDXIdentifier)))
+		}
+		jdoc.Packages = append(jdoc.Packages, jp)
+	}
+
 	// TODO: add support for XML
 	encoder := json.NewEncoder(wr)
 	encoder.SetIndent("", strings.Repeat(" ", o.Indent))
-	if err := encoder.Encode(doc.(*spdx.Document)); err != nil {
+	if err := encoder.Encode(jdoc); err != nil {
 		return fmt.Errorf("encoding sbom to stream: %w", err)
 	}
 
@@ -75,6 +107,10 @@ func (s *SPDX23) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _ int
 		},
 	}
 
+	for _, ref := range bom.Metadata.ExternalDocumentReferences {
+		doc.ExternalDocumentReferences = append(doc.ExternalDocumentReferences, buildExternalDocumentRef(ref))
+	}
+
 	for _, t := range bom.Metadata.Tools {
 		// TODO(degradation): SPDX is prescriptive on how this field is structured
 		// it is a tool identifier word separated from the version with a dash.
@@ -117,12 +153,19 @@ func (s *SPDX23) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _ int
 		})
 	}
 
-	// TODO(puerco): Files in packages
-	// TODO(puerco): Package verification data
+	reconcilePackageFiles(bom, packages, files)
+	doc.Snippets = buildSnippets(bom, files)
 
 	doc.Packages = packages
 	doc.Files = files
 	doc.Relationships = rels
+	for _, a := range buildAnnotations(protospdx.DOCUMENT, bom.Metadata.Annotations) {
+		a := a
+		doc.Annotations = append(doc.Annotations, &a)
+	}
+
+	// TODO(degradation): SPDX 2.3 has no properties, the properties of the
+	// document, nodes and edges are lost.
 
 	return doc, nil
 }
@@ -131,9 +174,13 @@ func buildRelationships(bom *sbom.Document) ([]*spdx.Relationship, error) { //no
 	relationships := []*spdx.Relationship{}
 	for _, e := range bom.NodeList.Edges {
 		for _, dest := range e.To {
+			refB := common.MakeDocElementID("", dest)
+			if docRef, nodeID, ok := sbom.ParseExternalNodeID(dest); ok && bom.NodeList.GetNodeByID(dest) == nil {
+				refB = common.MakeDocElementID(strings.TrimPrefix(docRef, sbom.ExternalDocumentRefPrefix), nodeID)
+			}
 			rel := spdx.Relationship{
 				RefA:         common.MakeDocElementID("", e.From),
-				RefB:         common.MakeDocElementID("", dest),
+				RefB:         refB,
 				Relationship: e.Type.ToSPDX2(),
 				// RelationshipComment: "",
 			}
@@ -143,10 +190,87 @@ func buildRelationships(bom *sbom.Document) ([]*spdx.Relationship, error) { //no
 	return relationships, nil
 }
 
+// buildExternalDocumentRef converts a protobom external document reference.
+// The ID keeps the DocumentRef- prefix as the SPDX writers output it verbatim.
+// SPDX references carry a single checksum, SHA1 is preferred when available.
+func buildExternalDocumentRef(ref *sbom.ExternalDocumentReference) spdx.ExternalDocumentRef {
+	r := spdx.ExternalDocumentRef{
+		DocumentRefID: ref.Id,
+		URI:           ref.Uri,
+	}
+
+	algos := []int{}
+	for algo := range ref.Hashes {
+		algos = append(algos, int(algo))
+	}
+	sort.Ints(algos)
+	if _, ok := ref.Hashes[int32(sbom.HashAlgorithm_SHA1)]; ok {
+		algos = append([]int{int(sbom.HashAlgorithm_SHA1)}, algos...)
+	}
+	for _, algo := range algos {
+		spdxAlgo := sbom.HashAlgorithm(algo).ToSPDX()
+		if spdxAlgo == "" {
+			continue
+		}
+		// TODO(degradation): Only one hash of the external document is kept
+		r.Checksum = common.Checksum{Algorithm: spdxAlgo, Value: ref.Hashes[int32(algo)]}
+		break
+	}
+	return r
+}
+
+// nodeAnnotations returns the annotations of the node with its provenance
+// entries, which are serialized as annotations as SPDX 2.3 has no properties.
+func nodeAnnotations(node *sbom.Node) []*sbom.Annotation {
+	annotations := slices.Clone(node.Annotations)
+	for _, p := range node.Provenance {
+		annotations = append(annotations, p.ToAnnotation())
+	}
+	return annotations
+}
+
+// buildAnnotations converts the protobom annotations of the element with the
+// SPDX identifier id to SPDX annotations.
+func buildAnnotations(id string, annotations []*sbom.Annotation) []v2_3.Annotation {
+	ret := []v2_3.Annotation{}
+	for _, a := range annotations {
+		sa := v2_3.Annotation{
+			AnnotationType:           spdxOther,
+			AnnotationSPDXIdentifier: common.MakeDocElementID("", id),
+			AnnotationComment:        a.GetText(),
+		}
+		if a.GetType() == sbom.Annotation_REVIEW {
+			sa.AnnotationType = "REVIEW"
+		}
+		if a.GetDate() != nil {
+			sa.AnnotationDate = a.GetDate().AsTime().UTC().Format(time.RFC3339)
+		}
+
+		switch {
+		case a.GetAnnotator() != nil:
+			sa.Annotator = common.Annotator{Annotator: a.GetAnnotator().GetName(), AnnotatorType: protospdx.Person}
+			if a.GetAnnotator().GetIsOrg() {
+				sa.Annotator.AnnotatorType = protospdx.Organization
+			}
+			if a.GetAnnotator().GetEmail() != "" {
+				sa.Annotator.Annotator = fmt.Sprintf("%s (%s)", a.GetAnnotator().GetName(), a.GetAnnotator().GetEmail())
+			}
+		case a.GetTool() != nil:
+			name := a.GetTool().GetName()
+			if a.GetTool().GetVersion() != "" {
+				name = fmt.Sprintf("%s-%s", name, a.GetTool().GetVersion())
+			}
+			sa.Annotator = common.Annotator{Annotator: name, AnnotatorType: protospdx.Tool}
+		}
+		ret = append(ret, sa)
+	}
+	return ret
+}
+
 func buildFiles(bom *sbom.Document) ([]*spdx.File, error) { //nolint:unparam
 	files := []*spdx.File{}
 	for _, node := range bom.NodeList.Nodes {
-		if node.Type == sbom.Node_PACKAGE {
+		if node.Type != sbom.Node_FILE {
 			continue
 		}
 
@@ -162,7 +286,7 @@ func buildFiles(bom *sbom.Document) ([]*spdx.File, error) { //nolint:unparam
 			FileComment:       node.Comment,
 			// FileNotice:           node.File, // Missing?
 			FileAttributionTexts: node.Attribution,
-			Annotations:          []v2_3.Annotation{},
+			Annotations:          buildAnnotations(node.Id, nodeAnnotations(node)),
 		}
 
 		if f.FileCopyrightText == "" {
@@ -187,10 +311,155 @@ func buildFiles(bom *sbom.Document) ([]*spdx.File, error) { //nolint:unparam
 	return files, nil
 }
 
+// reconcilePackageFiles adds the files contained in each package to its file
+// list and computes the package verification code. Files without a SHA1 hash
+// are listed as excluded from the verification code. SPDX 2.3 only allows
+// files in analyzed packages, so when none of the files has a SHA1 hash the
+// package is flagged as not analyzed and its files are not listed.
+func reconcilePackageFiles(bom *sbom.Document, packages []*spdx.Package, files []*spdx.File) {
+	fileIndex := map[string]*spdx.File{}
+	for _, f := range files {
+		fileIndex[string(f.FileSPDXIdentifier)] = f
+	}
+
+	packageFiles := map[string][]*spdx.File{}
+	for _, e := range bom.NodeList.Edges {
+		if e.Type != sbom.Edge_contains {
+			continue
+		}
+		for _, id := range e.To {
+			f, ok := fileIndex[id]
+			if !ok || slices.Contains(packageFiles[e.From], f) {
+				continue
+			}
+			packageFiles[e.From] = append(packageFiles[e.From], f)
+		}
+	}
+
+	for _, p := range packages {
+		p.Files = packageFiles[string(p.PackageSPDXIdentifier)]
+		if len(p.Files) == 0 {
+			continue
+		}
+		p.IsFilesAnalyzedTagPresent = true
+		p.PackageVerificationCode = packageVerificationCode(p.Files)
+		p.FilesAnalyzed = p.PackageVerificationCode != nil
+		if !p.FilesAnalyzed {
+			// TODO(degradation): The contains relationships are still
+			// written but the package does not list the files.
+			p.Files = nil
+		}
+	}
+}
+
+// packageVerificationCode computes the SPDX package verification code from
+// the SHA1 hashes of the package files (SPDX 2.3, clause 7.9). Files without
+// a SHA1 hash are left out of the computation and recorded as excluded files.
+// Returns nil if none of the files has a SHA1 hash.
+func packageVerificationCode(files []*spdx.File) *common.PackageVerificationCode {
+	hashes := []string{}
+	excluded := []string{}
+	for _, f := range files {
+		hash := ""
+		for _, c := range f.Checksums {
+			if c.Algorithm == common.SHA1 {
+				hash = strings.ToLower(c.Value)
+				break
+			}
+		}
+		if hash == "" {
+			name := f.FileName
+			if name == "" {
+				name = string(f.FileSPDXIdentifier)
+			}
+			excluded = append(excluded, name)
+			continue
+		}
+		hashes = append(hashes, hash)
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+	sort.Strings(hashes)
+
+	sum := sha1.Sum([]byte(strings.Join(hashes, ""))) //nolint:gosec // Required by the SPDX spec
+	code := &common.PackageVerificationCode{Value: hex.EncodeToString(sum[:])}
+	if len(excluded) > 0 {
+		code.ExcludedFiles = excluded
+	}
+	return code
+}
+
+// buildSnippets converts the snippet nodes of the document. The snippets are
+// also added to the files that contain them, where the SPDX tag-value writer
+// expects them.
+func buildSnippets(bom *sbom.Document, files []*spdx.File) []spdx.Snippet {
+	fileIndex := map[string]*spdx.File{}
+	for _, f := range files {
+		fileIndex[string(f.FileSPDXIdentifier)] = f
+	}
+
+	snippets := []spdx.Snippet{}
+	for _, node := range bom.NodeList.Nodes {
+		if node.Type != sbom.Node_SNIPPET {
+			continue
+		}
+
+		// TODO(degradation): SPDX 2.3 snippets have no annotations, hashes
+		// or supply chain data, only the licensing and copyright data is kept.
+		sn := spdx.Snippet{
+			SnippetSPDXIdentifier:   common.ElementID(node.Id),
+			SnippetName:             node.Name,
+			Ranges:                  []common.SnippetRange{},
+			SnippetLicenseConcluded: node.LicenseConcluded,
+			LicenseInfoInSnippet:    node.Licenses,
+			SnippetLicenseComments:  node.LicenseComments,
+			SnippetCopyrightText:    strings.TrimSpace(node.Copyright),
+			SnippetComment:          node.Comment,
+			SnippetAttributionTexts: node.Attribution,
+		}
+		if sn.SnippetCopyrightText == "" {
+			sn.SnippetCopyrightText = protospdx.NONE
+		}
+
+		file := bom.NodeList.GetSnippetFile(node.Id)
+		if file != nil {
+			sn.SnippetFromFileSPDXIdentifier = common.ElementID(file.Id)
+		}
+
+		if node.ByteRange != nil {
+			sn.Ranges = append(sn.Ranges, common.SnippetRange{
+				StartPointer: common.SnippetRangePointer{Offset: int(node.ByteRange.Start), FileSPDXIdentifier: sn.SnippetFromFileSPDXIdentifier},
+				EndPointer:   common.SnippetRangePointer{Offset: int(node.ByteRange.End), FileSPDXIdentifier: sn.SnippetFromFileSPDXIdentifier},
+			})
+		}
+		if node.LineRange != nil {
+			sn.Ranges = append(sn.Ranges, common.SnippetRange{
+				StartPointer: common.SnippetRangePointer{LineNumber: int(node.LineRange.Start), FileSPDXIdentifier: sn.SnippetFromFileSPDXIdentifier},
+				EndPointer:   common.SnippetRangePointer{LineNumber: int(node.LineRange.End), FileSPDXIdentifier: sn.SnippetFromFileSPDXIdentifier},
+			})
+		}
+		snippets = append(snippets, sn)
+
+		if file == nil {
+			continue
+		}
+		if f, ok := fileIndex[file.Id]; ok {
+			if f.Snippets == nil {
+				f.Snippets = map[common.ElementID]*spdx.Snippet{}
+			}
+			f.Snippets[sn.SnippetSPDXIdentifier] = &sn
+		}
+	}
+	return snippets
+}
+
 func (s *SPDX23) buildPackages(bom *sbom.Document) ([]*spdx.Package, error) { //nolint:unparam
 	packages := []*spdx.Package{}
 	for _, node := range bom.NodeList.Nodes {
-		if node.Type == sbom.Node_FILE {
+		// TODO(degradation): SPDX 2.3 has no services, they are written as
+		// packages to keep their relationships.
+		if node.Type != sbom.Node_PACKAGE && node.Type != sbom.Node_SERVICE {
 			continue
 		}
 
@@ -202,10 +471,7 @@ func (s *SPDX23) buildPackages(bom *sbom.Document) ([]*spdx.Package, error) { //
 			PackageFileName:       node.FileName,
 			// PackageSupplier:             &common.Supplier{},
 			// PackageOriginator:           &common.Originator{},
-			PackageDownloadLocation: node.UrlDownload,
-			// FilesAnalyzed:               false,
-			// IsFilesAnalyzedTagPresent:   false,
-			// PackageVerificationCode:     &common.PackageVerificationCode{},
+			PackageDownloadLocation:     node.UrlDownload,
 			PackageChecksums:            []common.Checksum{},
 			PackageHomePage:             node.UrlHome,
 			PackageSourceInfo:           node.SourceInfo,
@@ -220,17 +486,10 @@ func (s *SPDX23) buildPackages(bom *sbom.Document) ([]*spdx.Package, error) { //
 			PackageExternalReferences: []*v2_3.PackageExternalReference{},
 			PackageAttributionTexts:   node.Attribution,
 			// PrimaryPackagePurpose:     node.PrimaryPurpose,
-			Annotations: []v2_3.Annotation{},
+			Annotations: buildAnnotations(node.Id, nodeAnnotations(node)),
 
-			// The files field may never be used... Or should it?
-			// We are mirroring the protbom graph in the SPDX relationship
-			// structure so they don't need to be added here and
-			// the resulting document is still valid.
-			//
-			// There may be tools that rely on files added in the list so
-			// at some point we may need to think of supporting this as an
-			// option.
-			// Files:                       []*v2_3.File{},
+			// Files, FilesAnalyzed and the verification code are set
+			// once all files are built, see reconcilePackageFiles.
 		}
 
 		if len(node.PrimaryPurpose) > 0 && (node.PrimaryPurpose[0] != sbom.Purpose_UNKNOWN_PURPOSE) {
@@ -352,7 +611,6 @@ func (s *SPDX23) buildPackages(bom *sbom.Document) ([]*spdx.Package, error) { //
 			}
 		}
 
-		// TODO(puerco): Reconcile file in packages
 		packages = append(packages, &p)
 	}
 	return packages, nil
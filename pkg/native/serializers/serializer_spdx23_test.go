@@ -1,11 +1,17 @@
 package serializers
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/protobom/protobom/pkg/native"
 	"github.com/protobom/protobom/pkg/sbom"
 	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestExtRefCategoryFromProtobomExtRef(t *testing.T) {
@@ -62,3 +68,208 @@ func TestExtRefTypeFromProtobomExtRef(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildAnnotations(t *testing.T) {
+	date := timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	res := buildAnnotations("SPDXRef-Package-a", []*sbom.Annotation{
+		{Type: sbom.Annotation_REVIEW, Date: date, Text: "Looks good", Annotator: &sbom.Person{Name: "Jane Doe", Email: "jane@example.com"}},
+		{Type: sbom.Annotation_OTHER, Text: "Vendored", Annotator: &sbom.Person{Name: "ACME", IsOrg: true}},
+		{Text: "Scanned", Tool: &sbom.Tool{Name: "scanner", Version: "1.0"}},
+	})
+	require.Len(t, res, 3)
+
+	require.Equal(t, "REVIEW", res[0].AnnotationType)
+	require.Equal(t, "2024-01-01T00:00:00Z", res[0].AnnotationDate)
+	require.Equal(t, common.Annotator{Annotator: "Jane Doe (jane@example.com)", AnnotatorType: "Person"}, res[0].Annotator)
+	require.Equal(t, common.MakeDocElementID("", "SPDXRef-Package-a"), res[0].AnnotationSPDXIdentifier)
+	require.Equal(t, "Looks good", res[0].AnnotationComment)
+
+	require.Equal(t, "OTHER", res[1].AnnotationType)
+	require.Equal(t, common.Annotator{Annotator: "ACME", AnnotatorType: "Organization"}, res[1].Annotator)
+
+	require.Equal(t, "OTHER", res[2].AnnotationType)
+	require.Equal(t, common.Annotator{Annotator: "scanner-1.0", AnnotatorType: "Tool"}, res[2].Annotator)
+}
+
+func TestBuildExternalDocumentRefs(t *testing.T) {
+	bom := sbom.NewDocument()
+	bom.Metadata.ExternalDocumentReferences = []*sbom.ExternalDocumentReference{{
+		Id:  "DocumentRef-backend",
+		Uri: "https://example.com/backend",
+		Hashes: map[int32]string{
+			int32(sbom.HashAlgorithm_SHA256): "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			int32(sbom.HashAlgorithm_SHA1):   "da39a3ee5e6b4b0d3255bfef95601890afd80709",
+		},
+	}}
+	bom.NodeList.AddRootNode(&sbom.Node{Id: "Package-a", Name: "a"})
+	bom.NodeList.AddEdge(&sbom.Edge{
+		Type: sbom.Edge_dependsOn, From: "Package-a", To: []string{"DocumentRef-backend:Package-api"},
+	})
+
+	require.Equal(t, spdx.ExternalDocumentRef{
+		DocumentRefID: "DocumentRef-backend",
+		URI:           "https://example.com/backend",
+		Checksum:      common.Checksum{Algorithm: common.SHA1, Value: "da39a3ee5e6b4b0d3255bfef95601890afd80709"},
+	}, buildExternalDocumentRef(bom.Metadata.ExternalDocumentReferences[0]))
+
+	rels, err := buildRelationships(bom)
+	require.NoError(t, err)
+	require.Len(t, rels, 1)
+	require.Equal(t, common.MakeDocElementID("backend", "Package-api"), rels[0].RefB)
+}
+
+func TestPackageVerificationCode(t *testing.T) {
+	sha1File := func(id, hash string) *spdx.File {
+		return &spdx.File{
+			FileSPDXIdentifier: common.ElementID(id),
+			Checksums:          []common.Checksum{{Algorithm: common.SHA1, Value: hash}},
+		}
+	}
+	for _, tc := range []struct {
+		name     string
+		files    []*spdx.File
+		expected string
+		excluded []string
+	}{
+		{
+			name:     "single file",
+			files:    []*spdx.File{sha1File("a", "da39a3ee5e6b4b0d3255bfef95601890afd80709")},
+			expected: "10a34637ad661d98ba3344717656fcc76209c2f8",
+		},
+		{
+			name: "hashes are sorted",
+			files: []*spdx.File{
+				sha1File("b", "fa26be19de6bff93f70bc2308434e4a440bbad02"),
+				sha1File("a", "da39a3ee5e6b4b0d3255bfef95601890afd80709"),
+			},
+			expected: "21f0a33c556e1544e00362337277958e13a642c2",
+		},
+		{
+			name:     "uppercase hash",
+			files:    []*spdx.File{sha1File("a", "DA39A3EE5E6B4B0D3255BFEF95601890AFD80709")},
+			expected: "10a34637ad661d98ba3344717656fcc76209c2f8",
+		},
+		{
+			name: "file without sha1",
+			files: []*spdx.File{
+				sha1File("a", "da39a3ee5e6b4b0d3255bfef95601890afd80709"),
+				{FileSPDXIdentifier: "b", FileName: "./b.txt", Checksums: []common.Checksum{{Algorithm: common.SHA256, Value: "abc"}}},
+			},
+			expected: "10a34637ad661d98ba3344717656fcc76209c2f8",
+			excluded: []string{"./b.txt"},
+		},
+		{
+			name: "no file with sha1",
+			files: []*spdx.File{
+				{FileSPDXIdentifier: "b", Checksums: []common.Checksum{{Algorithm: common.SHA256, Value: "abc"}}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			code := packageVerificationCode(tc.files)
+			if tc.expected == "" {
+				require.Nil(t, code)
+				return
+			}
+			require.NotNil(t, code)
+			require.Equal(t, tc.expected, code.Value)
+			require.Equal(t, tc.excluded, code.ExcludedFiles)
+		})
+	}
+}
+
+func TestSerializePackageFiles(t *testing.T) {
+	bom := sbom.NewDocument()
+	bom.NodeList.AddRootNode(&sbom.Node{Id: "Package-a", Name: "a"})
+	bom.NodeList.AddRootNode(&sbom.Node{Id: "Package-b", Name: "b"})
+	bom.NodeList.AddRootNode(&sbom.Node{Id: "Package-c", Name: "c"})
+	bom.NodeList.AddNode(&sbom.Node{
+		Id: "File-a", Type: sbom.Node_FILE, Name: "a.txt",
+		Hashes: map[int32]string{int32(sbom.HashAlgorithm_SHA1): "da39a3ee5e6b4b0d3255bfef95601890afd80709"},
+	})
+	bom.NodeList.AddNode(&sbom.Node{
+		Id: "File-b", Type: sbom.Node_FILE, Name: "b.txt",
+		Hashes: map[int32]string{int32(sbom.HashAlgorithm_SHA256): "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	})
+	bom.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "Package-a", To: []string{"File-a"}})
+	bom.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "Package-b", To: []string{"File-a", "File-b", "Package-a"}})
+	bom.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "Package-c", To: []string{"File-b"}})
+
+	s := NewSPDX23()
+	doc, err := s.Serialize(bom, nil, nil)
+	require.NoError(t, err)
+	spdxDoc, ok := doc.(*spdx.Document)
+	require.True(t, ok)
+	require.Len(t, spdxDoc.Packages, 3)
+
+	// All files have SHA1 hashes, the package is analyzed
+	require.Len(t, spdxDoc.Packages[0].Files, 1)
+	require.True(t, spdxDoc.Packages[0].FilesAnalyzed)
+	require.Equal(t, "10a34637ad661d98ba3344717656fcc76209c2f8", spdxDoc.Packages[0].PackageVerificationCode.Value)
+	require.Empty(t, spdxDoc.Packages[0].PackageVerificationCode.ExcludedFiles)
+
+	// File-b has no SHA1, it is excluded from the verification code
+	require.Len(t, spdxDoc.Packages[1].Files, 2)
+	require.True(t, spdxDoc.Packages[1].FilesAnalyzed)
+	require.Equal(t, "10a34637ad661d98ba3344717656fcc76209c2f8", spdxDoc.Packages[1].PackageVerificationCode.Value)
+	require.Equal(t, []string{"b.txt"}, spdxDoc.Packages[1].PackageVerificationCode.ExcludedFiles)
+
+	// No file has a SHA1, the package is not analyzed and lists no files
+	require.Empty(t, spdxDoc.Packages[2].Files)
+	require.False(t, spdxDoc.Packages[2].FilesAnalyzed)
+	require.Nil(t, spdxDoc.Packages[2].PackageVerificationCode)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(doc, &buf, &native.RenderOptions{}, nil))
+	rendered := struct {
+		Packages []map[string]any `json:"packages"`
+	}{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &rendered))
+	require.Len(t, rendered.Packages, 3)
+	require.Equal(t, []any{"SPDXRef-File-a"}, rendered.Packages[0]["hasFiles"])
+	require.Equal(t, []any{"SPDXRef-File-a", "SPDXRef-File-b"}, rendered.Packages[1]["hasFiles"])
+	require.Equal(t, []any{"b.txt"}, rendered.Packages[1]["packageVerificationCode"].(map[string]any)["packageVerificationCodeExcludedFiles"]) //nolint:forcetypeassert
+	require.NotContains(t, rendered.Packages[2], "hasFiles")
+	require.Equal(t, false, rendered.Packages[2]["filesAnalyzed"])
+	require.NotContains(t, rendered.Packages[0], "files")
+}
+
+func TestBuildSnippets(t *testing.T) {
+	bom := sbom.NewDocument()
+	bom.NodeList.AddRootNode(&sbom.Node{Id: "File-a", Type: sbom.Node_FILE, Name: "a.c"})
+	bom.NodeList.AddNode(&sbom.Node{
+		Id: "Snippet-a", Type: sbom.Node_SNIPPET, Name: "vendored",
+		LicenseConcluded: "MIT", Licenses: []string{"MIT"},
+		ByteRange: &sbom.SnippetRange{Start: 310, End: 420},
+		LineRange: &sbom.SnippetRange{Start: 5, End: 23},
+	})
+	bom.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "File-a", To: []string{"Snippet-a"}})
+
+	doc, err := NewSPDX23().Serialize(bom, nil, nil)
+	require.NoError(t, err)
+	spdxDoc, ok := doc.(*spdx.Document)
+	require.True(t, ok)
+
+	// Snippets are not rendered as files
+	require.Len(t, spdxDoc.Files, 1)
+	require.Empty(t, spdxDoc.Packages)
+
+	require.Len(t, spdxDoc.Snippets, 1)
+	sn := spdxDoc.Snippets[0]
+	require.Equal(t, common.ElementID("File-a"), sn.SnippetFromFileSPDXIdentifier)
+	require.Equal(t, "MIT", sn.SnippetLicenseConcluded)
+	require.Equal(t, "NONE", sn.SnippetCopyrightText)
+	require.Equal(t, []common.SnippetRange{
+		{
+			StartPointer: common.SnippetRangePointer{Offset: 310, FileSPDXIdentifier: "File-a"},
+			EndPointer:   common.SnippetRangePointer{Offset: 420, FileSPDXIdentifier: "File-a"},
+		},
+		{
+			StartPointer: common.SnippetRangePointer{LineNumber: 5, FileSPDXIdentifier: "File-a"},
+			EndPointer:   common.SnippetRangePointer{LineNumber: 23, FileSPDXIdentifier: "File-a"},
+		},
+	}, sn.Ranges)
+
+	// The tag-value writer reads the snippets from the files
+	require.Contains(t, spdxDoc.Files[0].Snippets, common.ElementID("Snippet-a"))
+}
@@ -13,4 +13,10 @@ type Unserializer interface {
 	Unserialize(io.Reader, *UnserializeOptions, interface{}) (*sbom.Document, error)
 }
 
-type UnserializeOptions struct{}
+type UnserializeOptions struct {
+	// Limits sets the resource limits the unserializer must enforce while
+	// reading the document. If nil, no limits are applied. Unserializers
+	// check the node, edge and depth limits after decoding, MaxInputBytes
+	// is enforced by the reader on the input stream.
+	Limits *Limits
+}
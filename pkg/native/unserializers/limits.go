@@ -0,0 +1,47 @@
+package unserializers
+
+import "github.com/protobom/protobom/pkg/native"
+
+// limitCounter keeps track of the elements created while unserializing a
+// document and fails as soon as one of the configured limits is exceeded.
+type limitCounter struct {
+	limits native.Limits
+	nodes  int
+	edges  int
+}
+
+// newLimitCounter returns a counter configured with the limits in the
+// unserialize options. If none are set, all checks pass.
+func newLimitCounter(opts *native.UnserializeOptions) *limitCounter {
+	lc := &limitCounter{}
+	if opts != nil && opts.Limits != nil {
+		lc.limits = *opts.Limits
+	}
+	return lc
+}
+
+// addNodes registers n new nodes
+func (lc *limitCounter) addNodes(n int) error {
+	lc.nodes += n
+	if lc.limits.MaxNodes > 0 && lc.nodes > lc.limits.MaxNodes {
+		return &native.NodeLimitError{Limit: lc.limits.MaxNodes}
+	}
+	return nil
+}
+
+// addEdges registers n new relationships
+func (lc *limitCounter) addEdges(n int) error {
+	lc.edges += n
+	if lc.limits.MaxEdges > 0 && lc.edges > lc.limits.MaxEdges {
+		return &native.EdgeLimitError{Limit: lc.limits.MaxEdges}
+	}
+	return nil
+}
+
+// checkDepth returns an error if depth is beyond the nesting limit
+func (lc *limitCounter) checkDepth(depth int) error {
+	if lc.limits.MaxDepth > 0 && depth > lc.limits.MaxDepth {
+		return &native.DepthLimitError{Limit: lc.limits.MaxDepth}
+	}
+	return nil
+}
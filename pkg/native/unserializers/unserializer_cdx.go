@@ -3,13 +3,16 @@ package unserializers
 import (
 	"fmt"
 	"io"
+	"path"
 	"strings"
+	"time"
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
 	cdxformats "github.com/protobom/protobom/pkg/formats/cyclonedx"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/protobom/protobom/pkg/license"
 	"github.com/protobom/protobom/pkg/native"
 	"github.com/protobom/protobom/pkg/sbom"
 )
@@ -30,8 +33,9 @@ func NewCDX(version, encoding string) *CDX {
 
 // Unserialize reads datq data from io.Reader r and parses it as a CycloneDX
 // document. If successful returns a protobom Document loaded with the SBOM data.
-func (u *CDX) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interface{}) (*sbom.Document, error) {
+func (u *CDX) Unserialize(r io.Reader, opts *native.UnserializeOptions, _ interface{}) (*sbom.Document, error) {
 	bom := new(cdx.BOM)
+	lc := newLimitCounter(opts)
 
 	encoding, err := cdxformats.ParseEncoding(u.encoding)
 	if err != nil {
@@ -48,6 +52,9 @@ func (u *CDX) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interface
 		Date:    &timestamppb.Timestamp{},
 		Tools:   []*sbom.Tool{},
 		Authors: []*sbom.Person{},
+
+		Properties:  []*sbom.Property{},
+		Annotations: []*sbom.Annotation{},
 	}
 
 	doc := &sbom.Document{
@@ -59,13 +66,13 @@ func (u *CDX) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interface
 
 	if bom.Metadata != nil {
 		if bom.Metadata.Lifecycles != nil {
-			for _, lc := range *bom.Metadata.Lifecycles {
-				lc := lc
-				name := lc.Name
-				desc := lc.Description
-				t := u.phaseToSBOMType(&lc.Phase)
+			for _, lfc := range *bom.Metadata.Lifecycles {
+				lfc := lfc
+				name := lfc.Name
+				desc := lfc.Description
+				t := u.phaseToSBOMType(&lfc.Phase)
 				if name == "" {
-					name = string(lc.Phase)
+					name = string(lfc.Phase)
 				}
 
 				md.DocumentTypes = append(md.DocumentTypes, &sbom.DocumentType{
@@ -75,8 +82,9 @@ func (u *CDX) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interface
 				})
 			}
 		}
+		md.Properties = append(md.Properties, u.propertiesToProtobom(bom.Metadata.Properties)...)
 		if bom.Metadata.Component != nil {
-			nl, err := u.componentToNodeList(bom.Metadata.Component, &cc)
+			nl, err := u.componentToNodeList(bom.Metadata.Component, &cc, lc, 1)
 			if err != nil {
 				return nil, fmt.Errorf("converting main bom component to node: %w", err)
 			}
@@ -90,7 +98,7 @@ func (u *CDX) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interface
 	// Cycle all components and get their graph fragments
 	if bom.Components != nil {
 		for i := range *bom.Components {
-			nl, err := u.componentToNodeList(&(*bom.Components)[i], &cc)
+			nl, err := u.componentToNodeList(&(*bom.Components)[i], &cc, lc, 1)
 			if err != nil {
 				return nil, fmt.Errorf("converting component to node: %w", err)
 			}
@@ -98,6 +106,9 @@ func (u *CDX) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interface
 			if len(doc.NodeList.RootElements) == 0 {
 				doc.NodeList.Add(nl)
 			} else {
+				if err := lc.addEdges(1); err != nil {
+					return nil, err
+				}
 				if err := doc.NodeList.RelateNodeListAtID(nl, doc.NodeList.RootElements[0], sbom.Edge_contains); err != nil {
 					return nil, fmt.Errorf("relating components to root node: %w", err)
 				}
@@ -105,12 +116,352 @@ func (u *CDX) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interface
 		}
 	}
 
+	// Services hang from the metadata component. When the BOM has none, top
+	// level services are added as root elements instead of being related to
+	// the first component or service found.
+	if bom.Services != nil {
+		hasMainComponent := bom.Metadata != nil && bom.Metadata.Component != nil
+		for i := range *bom.Services {
+			nl, err := u.serviceToNodeList(&(*bom.Services)[i], &cc, lc, 1)
+			if err != nil {
+				return nil, fmt.Errorf("converting service to node: %w", err)
+			}
+
+			if !hasMainComponent {
+				doc.NodeList.Add(nl)
+			} else {
+				if err := lc.addEdges(1); err != nil {
+					return nil, err
+				}
+				if err := doc.NodeList.RelateNodeListAtID(nl, doc.NodeList.RootElements[0], sbom.Edge_contains); err != nil {
+					return nil, fmt.Errorf("relating services to root node: %w", err)
+				}
+			}
+		}
+
+		if err := u.serviceDependenciesToEdges(doc.NodeList, bom.Dependencies, lc); err != nil {
+			return nil, err
+		}
+	}
+
+	// TODO(degradation): BOM level properties are merged into the metadata
+	// properties and are written back to the metadata when serializing.
+	md.Properties = append(md.Properties, u.propertiesToProtobom(bom.Properties)...)
+
+	if bom.Annotations != nil {
+		for i := range *bom.Annotations {
+			u.addAnnotation(doc, &(*bom.Annotations)[i])
+		}
+	}
+
+	if bom.Vulnerabilities != nil {
+		for i := range *bom.Vulnerabilities {
+			doc.Vulnerabilities = append(doc.Vulnerabilities, u.vulnerabilityToProtobom(&(*bom.Vulnerabilities)[i]))
+		}
+	}
+
 	return doc, nil
 }
 
+// propertiesToProtobom converts a list of CycloneDX properties
+func (u *CDX) propertiesToProtobom(props *[]cdx.Property) []*sbom.Property {
+	ret := []*sbom.Property{}
+	if props == nil {
+		return ret
+	}
+	for _, p := range *props {
+		ret = append(ret, &sbom.Property{Name: p.Name, Value: p.Value})
+	}
+	return ret
+}
+
+// addAnnotation attaches a CycloneDX annotation to its subjects. Subjects
+// matching the document serial number annotate the document metadata, the
+// rest are looked up in the node list by their bom-ref.
+func (u *CDX) addAnnotation(doc *sbom.Document, ca *cdx.Annotation) {
+	if ca.Subjects == nil {
+		return
+	}
+	for _, subject := range *ca.Subjects {
+		if string(subject) == doc.GetMetadata().GetId() && string(subject) != "" {
+			doc.Metadata.Annotations = append(doc.Metadata.Annotations, u.annotationToProtobom(ca))
+			continue
+		}
+		node := doc.GetNodeList().GetNodeByID(string(subject))
+		if node == nil {
+			// TODO(degradation): Annotations of services and other BOM
+			// elements not represented as nodes are lost.
+			continue
+		}
+		node.Annotations = append(node.Annotations, u.annotationToProtobom(ca))
+	}
+}
+
+// annotationToProtobom converts a CycloneDX annotation. CycloneDX annotations
+// have no type so they are all read as OTHER.
+func (u *CDX) annotationToProtobom(ca *cdx.Annotation) *sbom.Annotation {
+	a := &sbom.Annotation{
+		Type: sbom.Annotation_OTHER,
+		Date: cdxTimestamp(ca.Timestamp),
+		Text: ca.Text,
+	}
+	if ca.Annotator == nil {
+		return a
+	}
+
+	switch {
+	case ca.Annotator.Organization != nil:
+		a.Annotator = &sbom.Person{Name: ca.Annotator.Organization.Name, IsOrg: true}
+		if ca.Annotator.Organization.URL != nil && len(*ca.Annotator.Organization.URL) > 0 {
+			a.Annotator.Url = (*ca.Annotator.Organization.URL)[0]
+		}
+	case ca.Annotator.Individual != nil:
+		a.Annotator = &sbom.Person{
+			Name:  ca.Annotator.Individual.Name,
+			Email: ca.Annotator.Individual.Email,
+			Phone: ca.Annotator.Individual.Phone,
+		}
+	case ca.Annotator.Component != nil:
+		a.Tool = &sbom.Tool{
+			Name:    ca.Annotator.Component.Name,
+			Version: ca.Annotator.Component.Version,
+			Vendor:  ca.Annotator.Component.Publisher,
+		}
+	case ca.Annotator.Service != nil:
+		a.Tool = &sbom.Tool{Name: ca.Annotator.Service.Name, Version: ca.Annotator.Service.Version}
+		if ca.Annotator.Service.Provider != nil {
+			a.Tool.Vendor = ca.Annotator.Service.Provider.Name
+		}
+	}
+	return a
+}
+
+// vulnerabilityToProtobom converts a CycloneDX vulnerability to its protobom
+// equivalent. The affected refs are the bom-refs of the components which are
+// used as the node IDs when unserializing.
+func (u *CDX) vulnerabilityToProtobom(v *cdx.Vulnerability) *sbom.Vulnerability {
+	vuln := &sbom.Vulnerability{
+		Id:             v.ID,
+		Aliases:        []string{},
+		Description:    v.Description,
+		Detail:         v.Detail,
+		Recommendation: v.Recommendation,
+		Ratings:        []*sbom.VulnerabilityRating{},
+		Cwes:           []int32{},
+		Advisories:     []*sbom.ExternalReference{},
+		Published:      cdxTimestamp(v.Published),
+		Updated:        cdxTimestamp(v.Updated),
+		Affects:        []string{},
+	}
+
+	if v.Source != nil {
+		vuln.Source = &sbom.VulnerabilitySource{Name: v.Source.Name, Url: v.Source.URL}
+	}
+
+	if v.References != nil {
+		// TODO(degradation): The source of the vulnerability references is lost
+		for _, ref := range *v.References {
+			vuln.Aliases = append(vuln.Aliases, ref.ID)
+		}
+	}
+
+	if v.Ratings != nil {
+		for _, r := range *v.Ratings {
+			rating := &sbom.VulnerabilityRating{
+				Severity:      u.cdxSeverityToProtobom(r.Severity),
+				Method:        u.cdxScoringMethodToProtobom(r.Method),
+				Vector:        r.Vector,
+				Justification: r.Justification,
+			}
+			if r.Score != nil {
+				rating.Score = *r.Score
+			}
+			if r.Source != nil {
+				rating.Source = &sbom.VulnerabilitySource{Name: r.Source.Name, Url: r.Source.URL}
+			}
+			vuln.Ratings = append(vuln.Ratings, rating)
+		}
+	}
+
+	if v.CWEs != nil {
+		for _, cwe := range *v.CWEs {
+			vuln.Cwes = append(vuln.Cwes, int32(cwe))
+		}
+	}
+
+	if v.Advisories != nil {
+		for _, a := range *v.Advisories {
+			vuln.Advisories = append(vuln.Advisories, &sbom.ExternalReference{
+				Url:     a.URL,
+				Comment: a.Title,
+				Type:    sbom.ExternalReference_SECURITY_ADVISORY,
+			})
+		}
+	}
+
+	if v.Affects != nil {
+		// TODO(degradation): Affected version ranges are not captured
+		for _, a := range *v.Affects {
+			vuln.Affects = append(vuln.Affects, a.Ref)
+		}
+	}
+
+	if v.Analysis != nil {
+		vuln.Analysis = &sbom.VulnerabilityAnalysis{
+			Status:        u.cdxImpactStateToStatus(v.Analysis.State),
+			Justification: u.cdxJustificationToProtobom(v.Analysis.Justification),
+			Responses:     []sbom.VulnerabilityAnalysis_Response{},
+			Detail:        v.Analysis.Detail,
+			FirstIssued:   cdxTimestamp(v.Analysis.FirstIssued),
+			LastUpdated:   cdxTimestamp(v.Analysis.LastUpdated),
+		}
+		if v.Analysis.Response != nil {
+			for _, r := range *v.Analysis.Response {
+				vuln.Analysis.Responses = append(vuln.Analysis.Responses, u.cdxResponseToProtobom(r))
+			}
+		}
+	}
+
+	if v.Workaround != "" {
+		// TODO(degradation): CDX workarounds are folded into the analysis action
+		if vuln.Analysis == nil {
+			vuln.Analysis = &sbom.VulnerabilityAnalysis{}
+		}
+		vuln.Analysis.Action = v.Workaround
+	}
+
+	return vuln
+}
+
+// cdxTimestamp parses a CycloneDX date. Returns nil if the date is empty or
+// cannot be parsed.
+func cdxTimestamp(date string) *timestamppb.Timestamp {
+	if date == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		// TODO(degradation): Invalid dates are dropped
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// cdxSeverityToProtobom converts a CycloneDX rating severity to protobom
+func (u *CDX) cdxSeverityToProtobom(severity cdx.Severity) sbom.VulnerabilityRating_Severity {
+	switch severity {
+	case cdx.SeverityNone:
+		return sbom.VulnerabilityRating_NONE
+	case cdx.SeverityInfo:
+		return sbom.VulnerabilityRating_INFO
+	case cdx.SeverityLow:
+		return sbom.VulnerabilityRating_LOW
+	case cdx.SeverityMedium:
+		return sbom.VulnerabilityRating_MEDIUM
+	case cdx.SeverityHigh:
+		return sbom.VulnerabilityRating_HIGH
+	case cdx.SeverityCritical:
+		return sbom.VulnerabilityRating_CRITICAL
+	default:
+		return sbom.VulnerabilityRating_UNKNOWN_SEVERITY
+	}
+}
+
+// cdxScoringMethodToProtobom converts a CycloneDX scoring method to protobom
+func (u *CDX) cdxScoringMethodToProtobom(method cdx.ScoringMethod) sbom.VulnerabilityRating_Method {
+	switch method {
+	case cdx.ScoringMethodCVSSv2:
+		return sbom.VulnerabilityRating_CVSSV2
+	case cdx.ScoringMethodCVSSv3:
+		return sbom.VulnerabilityRating_CVSSV3
+	case cdx.ScoringMethodCVSSv31:
+		return sbom.VulnerabilityRating_CVSSV31
+	case cdx.ScoringMethodCVSSv4:
+		return sbom.VulnerabilityRating_CVSSV4
+	case cdx.ScoringMethodOWASP:
+		return sbom.VulnerabilityRating_OWASP
+	case cdx.ScoringMethodSSVC:
+		return sbom.VulnerabilityRating_SSVC
+	default:
+		return sbom.VulnerabilityRating_OTHER_METHOD
+	}
+}
+
+// cdxImpactStateToStatus converts a CycloneDX impact analysis state to a
+// protobom VEX status.
+func (u *CDX) cdxImpactStateToStatus(state cdx.ImpactAnalysisState) sbom.VulnerabilityAnalysis_Status {
+	switch state {
+	case cdx.IASExploitable:
+		return sbom.VulnerabilityAnalysis_AFFECTED
+	case cdx.IASNotAffected, cdx.IASFalsePositive:
+		// TODO(degradation): false_positive is captured as not affected
+		return sbom.VulnerabilityAnalysis_NOT_AFFECTED
+	case cdx.IASResolved, cdx.IASResolvedWithPedigree:
+		// TODO(degradation): resolved_with_pedigree is captured as fixed
+		return sbom.VulnerabilityAnalysis_FIXED
+	case cdx.IASInTriage:
+		return sbom.VulnerabilityAnalysis_UNDER_INVESTIGATION
+	default:
+		return sbom.VulnerabilityAnalysis_UNKNOWN_STATUS
+	}
+}
+
+// cdxJustificationToProtobom converts a CycloneDX impact analysis
+// justification to protobom
+func (u *CDX) cdxJustificationToProtobom(j cdx.ImpactAnalysisJustification) sbom.VulnerabilityAnalysis_Justification {
+	switch j {
+	case cdx.IAJCodeNotPresent:
+		return sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_PRESENT
+	case cdx.IAJCodeNotReachable:
+		return sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_IN_EXECUTE_PATH
+	case cdx.IAJRequiresConfiguration:
+		return sbom.VulnerabilityAnalysis_REQUIRES_CONFIGURATION
+	case cdx.IAJRequiresDependency:
+		return sbom.VulnerabilityAnalysis_REQUIRES_DEPENDENCY
+	case cdx.IAJRequiresEnvironment:
+		return sbom.VulnerabilityAnalysis_REQUIRES_ENVIRONMENT
+	case cdx.IAJProtectedByCompiler:
+		return sbom.VulnerabilityAnalysis_PROTECTED_BY_COMPILER
+	case cdx.IAJProtectedAtRuntime:
+		return sbom.VulnerabilityAnalysis_PROTECTED_AT_RUNTIME
+	case cdx.IAJProtectedAtPerimeter:
+		return sbom.VulnerabilityAnalysis_PROTECTED_AT_PERIMETER
+	case cdx.IAJProtectedByMitigatingControl:
+		return sbom.VulnerabilityAnalysis_INLINE_MITIGATIONS_ALREADY_EXIST
+	default:
+		return sbom.VulnerabilityAnalysis_UNKNOWN_JUSTIFICATION
+	}
+}
+
+// cdxResponseToProtobom converts a CycloneDX impact analysis response
+func (u *CDX) cdxResponseToProtobom(r cdx.ImpactAnalysisResponse) sbom.VulnerabilityAnalysis_Response {
+	switch r {
+	case cdx.IARCanNotFix:
+		return sbom.VulnerabilityAnalysis_CAN_NOT_FIX
+	case cdx.IARWillNotFix:
+		return sbom.VulnerabilityAnalysis_WILL_NOT_FIX
+	case cdx.IARUpdate:
+		return sbom.VulnerabilityAnalysis_UPDATE
+	case cdx.IARRollback:
+		return sbom.VulnerabilityAnalysis_ROLLBACK
+	case cdx.IARWorkaroundAvailable:
+		return sbom.VulnerabilityAnalysis_WORKAROUND_AVAILABLE
+	default:
+		return sbom.VulnerabilityAnalysis_UNKNOWN_RESPONSE
+	}
+}
+
 // componentToNodes takes a CycloneDX component and computes its graph fragment,
-// returning a nodelist
-func (u *CDX) componentToNodeList(component *cdx.Component, cc *int) (*sbom.NodeList, error) {
+// returning a nodelist. depth is the nesting level of the component, it is
+// checked against the configured limits before descending any further.
+func (u *CDX) componentToNodeList(component *cdx.Component, cc *int, lc *limitCounter, depth int) (*sbom.NodeList, error) {
+	if err := lc.checkDepth(depth); err != nil {
+		return nil, err
+	}
+	if err := lc.addNodes(1); err != nil {
+		return nil, err
+	}
+
 	node, err := u.componentToNode(component, cc)
 	if err != nil {
 		return nil, fmt.Errorf("converting cdx component to node: %w", err)
@@ -124,19 +475,257 @@ func (u *CDX) componentToNodeList(component *cdx.Component, cc *int) (*sbom.Node
 
 	if component.Components != nil {
 		for i := range *component.Components {
-			subList, err := u.componentToNodeList(&(*component.Components)[i], cc)
+			subList, err := u.componentToNodeList(&(*component.Components)[i], cc, lc, depth+1)
 			if err != nil {
 				return nil, fmt.Errorf("converting subcomponent to nodelist: %w", err)
 			}
+			if err := lc.addEdges(1); err != nil {
+				return nil, err
+			}
 			if err := nl.RelateNodeListAtID(subList, node.Id, sbom.Edge_contains); err != nil {
 				return nil, fmt.Errorf("relating subcomponents to new node: %w", err)
 			}
 		}
 	}
 
+	if component.Pedigree != nil {
+		if err := u.pedigreeToNodeList(nl, node, component.Pedigree, cc, lc, depth); err != nil {
+			return nil, fmt.Errorf("converting component pedigree: %w", err)
+		}
+	}
+
 	return nl, nil
 }
 
+// pedigreeToNodeList adds the components in the pedigree of a component to
+// its nodelist. The component is a descendant of its ancestors, an ancestor
+// of its descendants and a variant of its variants, the pedigree components
+// are related to it with edges of those types. Patches are added as FILE
+// nodes with the PATCH purpose pointing to the component with patch edges.
+func (u *CDX) pedigreeToNodeList(nl *sbom.NodeList, node *sbom.Node, pedigree *cdx.Pedigree, cc *int, lc *limitCounter, depth int) error {
+	for _, rel := range []struct {
+		components *[]cdx.Component
+		edgeType   sbom.Edge_Type
+	}{
+		{pedigree.Ancestors, sbom.Edge_descendant},
+		{pedigree.Descendants, sbom.Edge_ancestor},
+		{pedigree.Variants, sbom.Edge_variant},
+	} {
+		if rel.components == nil {
+			continue
+		}
+		for i := range *rel.components {
+			subList, err := u.componentToNodeList(&(*rel.components)[i], cc, lc, depth+1)
+			if err != nil {
+				return fmt.Errorf("converting %s component to nodelist: %w", rel.edgeType, err)
+			}
+			if err := lc.addEdges(1); err != nil {
+				return err
+			}
+			if err := nl.RelateNodeListAtID(subList, node.Id, rel.edgeType); err != nil {
+				return fmt.Errorf("relating %s components to node: %w", rel.edgeType, err)
+			}
+		}
+	}
+
+	if pedigree.Patches == nil {
+		return nil
+	}
+	for i := range *pedigree.Patches {
+		if err := lc.addNodes(1); err != nil {
+			return err
+		}
+		if err := lc.addEdges(1); err != nil {
+			return err
+		}
+		patch := u.patchToNode(&(*pedigree.Patches)[i], cc)
+		nl.AddNode(patch)
+		nl.AddEdge(&sbom.Edge{Type: sbom.Edge_patch, From: patch.Id, To: []string{node.Id}})
+	}
+	return nil
+}
+
+// patchToNode converts a CycloneDX patch into a FILE node with the PATCH
+// purpose. The diff URL is captured as the download location of the file.
+func (u *CDX) patchToNode(p *cdx.Patch, cc *int) *sbom.Node {
+	(*cc)++
+	node := &sbom.Node{
+		Id:             sbom.NewNodeIdentifier("auto", fmt.Sprintf("%09d", *cc)),
+		Type:           sbom.Node_FILE,
+		Name:           "patch",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_PATCH},
+		Patch: &sbom.Patch{
+			Type:     u.cdxPatchTypeToProtobom(p.Type),
+			Resolves: []*sbom.PatchIssue{},
+		},
+	}
+
+	if p.Diff != nil {
+		node.UrlDownload = p.Diff.URL
+		if p.Diff.URL != "" {
+			node.Name = path.Base(p.Diff.URL)
+		}
+		// TODO(degradation): The content type and encoding of the diff are lost
+		if p.Diff.Text != nil {
+			node.Patch.Diff = p.Diff.Text.Content
+		}
+	}
+
+	if p.Resolves != nil {
+		for _, i := range *p.Resolves {
+			issue := &sbom.PatchIssue{
+				Type:        u.cdxIssueTypeToProtobom(i.Type),
+				Id:          i.ID,
+				Name:        i.Name,
+				Description: i.Description,
+				References:  []string{},
+			}
+			if i.Source != nil {
+				issue.SourceName = i.Source.Name
+				issue.SourceUrl = i.Source.URL
+			}
+			if i.References != nil {
+				issue.References = append(issue.References, *i.References...)
+			}
+			node.Patch.Resolves = append(node.Patch.Resolves, issue)
+		}
+	}
+
+	return node
+}
+
+// commitToProtobom converts a commit in a CycloneDX pedigree
+func (u *CDX) commitToProtobom(c *cdx.Commit) *sbom.Commit {
+	commit := &sbom.Commit{
+		Uid:     c.UID,
+		Url:     c.URL,
+		Message: c.Message,
+	}
+	if c.Author != nil {
+		commit.Author = &sbom.Person{Name: c.Author.Name, Email: c.Author.Email}
+		commit.Authored = cdxTimestamp(c.Author.Timestamp)
+	}
+	if c.Committer != nil {
+		commit.Committer = &sbom.Person{Name: c.Committer.Name, Email: c.Committer.Email}
+		commit.Committed = cdxTimestamp(c.Committer.Timestamp)
+	}
+	return commit
+}
+
+// evidenceToProtobom converts the evidence of a CycloneDX component
+func (u *CDX) evidenceToProtobom(e *cdx.Evidence) *sbom.Evidence {
+	evidence := &sbom.Evidence{
+		Identity:    []*sbom.IdentityEvidence{},
+		Occurrences: []*sbom.Occurrence{},
+		Callstack:   []*sbom.CallstackFrame{},
+		Licenses:    u.licenseChoicesToLicenseList(e.Licenses),
+		Copyright:   []string{},
+	}
+
+	if e.Identity != nil {
+		identity := &sbom.IdentityEvidence{
+			Field:      string(e.Identity.Field),
+			Confidence: e.Identity.Confidence,
+			Methods:    []*sbom.IdentityMethod{},
+			Tools:      []string{},
+		}
+		if e.Identity.Methods != nil {
+			for _, m := range *e.Identity.Methods {
+				identity.Methods = append(identity.Methods, &sbom.IdentityMethod{
+					Technique:  string(m.Technique),
+					Confidence: m.Confidence,
+					Value:      m.Value,
+				})
+			}
+		}
+		if e.Identity.Tools != nil {
+			for _, t := range *e.Identity.Tools {
+				identity.Tools = append(identity.Tools, string(t))
+			}
+		}
+		evidence.Identity = append(evidence.Identity, identity)
+	}
+
+	if e.Occurrences != nil {
+		for _, o := range *e.Occurrences {
+			evidence.Occurrences = append(evidence.Occurrences, &sbom.Occurrence{
+				Id:                o.BOMRef,
+				Location:          o.Location,
+				Line:              intToInt32(o.Line),
+				Offset:            intToInt32(o.Offset),
+				Symbol:            o.Symbol,
+				AdditionalContext: o.AdditionalContext,
+			})
+		}
+	}
+
+	if e.Callstack != nil && e.Callstack.Frames != nil {
+		for _, f := range *e.Callstack.Frames {
+			frame := &sbom.CallstackFrame{
+				Package:      f.Package,
+				Module:       f.Module,
+				Function:     f.Function,
+				Parameters:   []string{},
+				Line:         intToInt32(f.Line),
+				Column:       intToInt32(f.Column),
+				FullFilename: f.FullFilename,
+			}
+			if f.Parameters != nil {
+				frame.Parameters = append(frame.Parameters, *f.Parameters...)
+			}
+			evidence.Callstack = append(evidence.Callstack, frame)
+		}
+	}
+
+	if e.Copyright != nil {
+		for _, c := range *e.Copyright {
+			evidence.Copyright = append(evidence.Copyright, c.Text)
+		}
+	}
+
+	return evidence
+}
+
+// intToInt32 converts an optional int to the int32 used in the protobom
+// evidence fields.
+func intToInt32(i *int) *int32 {
+	if i == nil {
+		return nil
+	}
+	ret := int32(*i) //nolint:gosec // Lines and offsets fit in an int32
+	return &ret
+}
+
+// cdxPatchTypeToProtobom converts a CycloneDX patch type
+func (u *CDX) cdxPatchTypeToProtobom(t cdx.PatchType) sbom.Patch_Type {
+	switch t {
+	case cdx.PatchTypeUnofficial:
+		return sbom.Patch_UNOFFICIAL
+	case cdx.PatchTypeMonkey:
+		return sbom.Patch_MONKEY
+	case cdx.PatchTypeBackport:
+		return sbom.Patch_BACKPORT
+	case cdx.PatchTypeCherryPick:
+		return sbom.Patch_CHERRY_PICK
+	default:
+		return sbom.Patch_UNKNOWN
+	}
+}
+
+// cdxIssueTypeToProtobom converts the type of an issue resolved by a patch
+func (u *CDX) cdxIssueTypeToProtobom(t cdx.IssueType) sbom.PatchIssue_Type {
+	switch t {
+	case cdx.IssueTypeDefect:
+		return sbom.PatchIssue_DEFECT
+	case cdx.IssueTypeEnhancement:
+		return sbom.PatchIssue_ENHANCEMENT
+	case cdx.IssueTypeSecurity:
+		return sbom.PatchIssue_SECURITY
+	default:
+		return sbom.PatchIssue_UNKNOWN
+	}
+}
+
 func (u *CDX) componentToNode(c *cdx.Component, cc *int) (*sbom.Node, error) { //nolint:unparam
 	(*cc)++
 	node := &sbom.Node{
@@ -157,6 +746,8 @@ func (u *CDX) componentToNode(c *cdx.Component, cc *int) (*sbom.Node, error) { /
 		ExternalReferences: []*sbom.ExternalReference{},
 		Identifiers:        map[int32]string{},
 		FileTypes:          []string{},
+		Properties:         u.propertiesToProtobom(c.Properties),
+		Annotations:        []*sbom.Annotation{},
 	}
 
 	node.PrimaryPurpose = []sbom.Purpose{u.componentTypeToPurpose(c.Type)}
@@ -198,6 +789,24 @@ func (u *CDX) componentToNode(c *cdx.Component, cc *int) (*sbom.Node, error) { /
 		}
 	}
 
+	if c.Pedigree != nil {
+		node.PedigreeNotes = c.Pedigree.Notes
+		if c.Pedigree.Commits != nil {
+			for i := range *c.Pedigree.Commits {
+				node.Commits = append(node.Commits, u.commitToProtobom(&(*c.Pedigree.Commits)[i]))
+			}
+		}
+	}
+
+	if c.Evidence != nil {
+		node.Evidence = u.evidenceToProtobom(c.Evidence)
+	}
+
+	node.RestoreProvenance()
+	if node.Type == sbom.Node_FILE {
+		node.RestoreSnippet()
+	}
+
 	// Generate a new ID if none is set
 	if node.Id == "" {
 		node.Id = sbom.NewNodeIdentifier("auto", fmt.Sprintf("%09d", *cc))
@@ -206,6 +815,173 @@ func (u *CDX) componentToNode(c *cdx.Component, cc *int) (*sbom.Node, error) { /
 	return node, nil
 }
 
+// serviceToNodeList takes a CycloneDX service and computes its graph fragment,
+// nested services are related to their parent with contains edges.
+func (u *CDX) serviceToNodeList(service *cdx.Service, cc *int, lc *limitCounter, depth int) (*sbom.NodeList, error) {
+	if err := lc.checkDepth(depth); err != nil {
+		return nil, err
+	}
+	if err := lc.addNodes(1); err != nil {
+		return nil, err
+	}
+
+	node := u.serviceToNode(service, cc)
+	nl := &sbom.NodeList{
+		Nodes:        []*sbom.Node{node},
+		Edges:        []*sbom.Edge{},
+		RootElements: []string{node.Id},
+	}
+
+	if service.Services != nil {
+		for i := range *service.Services {
+			subList, err := u.serviceToNodeList(&(*service.Services)[i], cc, lc, depth+1)
+			if err != nil {
+				return nil, fmt.Errorf("converting nested service to nodelist: %w", err)
+			}
+			if err := lc.addEdges(1); err != nil {
+				return nil, err
+			}
+			if err := nl.RelateNodeListAtID(subList, node.Id, sbom.Edge_contains); err != nil {
+				return nil, fmt.Errorf("relating nested services to new node: %w", err)
+			}
+		}
+	}
+
+	return nl, nil
+}
+
+// serviceToNode converts a CycloneDX service to a protobom node of type SERVICE
+func (u *CDX) serviceToNode(s *cdx.Service, cc *int) *sbom.Node {
+	(*cc)++
+	node := &sbom.Node{
+		Id:                   s.BOMRef,
+		Type:                 sbom.Node_SERVICE,
+		Name:                 s.Name,
+		Version:              s.Version,
+		Description:          s.Description,
+		Licenses:             u.licenseChoicesToLicenseList(s.Licenses),
+		LicenseConcluded:     u.licenseChoicesToLicenseString(s.Licenses),
+		Suppliers:            []*sbom.Person{},
+		ExternalReferences:   u.unserializeExternalReferences(s.ExternalReferences),
+		Properties:           u.propertiesToProtobom(s.Properties),
+		Endpoints:            []string{},
+		Authenticated:        s.Authenticated,
+		CrossesTrustBoundary: s.CrossesTrustBoundary,
+		DataFlows:            []*sbom.DataFlow{},
+	}
+
+	// TODO(degradation): The service group has no equivalent in protobom
+	if s.Provider != nil {
+		node.Suppliers = append(node.Suppliers, u.organizationalEntityToPerson(s.Provider))
+	}
+
+	if s.Endpoints != nil {
+		node.Endpoints = append(node.Endpoints, *s.Endpoints...)
+	}
+
+	if s.Data != nil {
+		for _, d := range *s.Data {
+			node.DataFlows = append(node.DataFlows, &sbom.DataFlow{
+				Flow:           u.cdxDataFlowToProtobom(d.Flow),
+				Classification: d.Classification,
+			})
+		}
+	}
+
+	node.RestoreProvenance()
+
+	// Generate a new ID if none is set
+	if node.Id == "" {
+		node.Id = sbom.NewNodeIdentifier("auto", fmt.Sprintf("%09d", *cc))
+	}
+
+	return node
+}
+
+// organizationalEntityToPerson converts a CycloneDX organization to a protobom
+// person flagged as an organization.
+func (u *CDX) organizationalEntityToPerson(oe *cdx.OrganizationalEntity) *sbom.Person {
+	p := &sbom.Person{
+		Name:     oe.Name,
+		IsOrg:    true,
+		Contacts: []*sbom.Person{},
+	}
+	if oe.URL != nil && len(*oe.URL) > 0 {
+		// TODO(degradation): Only the first organization URL is kept
+		p.Url = (*oe.URL)[0]
+	}
+	if oe.Contact != nil {
+		for _, c := range *oe.Contact {
+			p.Contacts = append(p.Contacts, &sbom.Person{
+				Name:  c.Name,
+				Email: c.Email,
+				Phone: c.Phone,
+			})
+		}
+	}
+	return p
+}
+
+// serviceDependenciesToEdges reads the dependency entries where a service is
+// involved, either as the dependent or one of the dependencies, and adds them
+// to the nodelist as dependsOn edges.
+//
+// TODO(degradation): Dependencies between components are not read, their
+// relationships are only captured from component nesting.
+func (u *CDX) serviceDependenciesToEdges(nl *sbom.NodeList, deps *[]cdx.Dependency, lc *limitCounter) error {
+	if deps == nil {
+		return nil
+	}
+
+	isService := func(id string) bool {
+		n := nl.GetNodeByID(id)
+		return n != nil && n.Type == sbom.Node_SERVICE
+	}
+
+	for _, dep := range *deps {
+		if dep.Dependencies == nil || nl.GetNodeByID(dep.Ref) == nil {
+			continue
+		}
+		to := []string{}
+		for _, id := range *dep.Dependencies {
+			if nl.GetNodeByID(id) == nil {
+				continue
+			}
+			if isService(dep.Ref) || isService(id) {
+				to = append(to, id)
+			}
+		}
+		if len(to) == 0 {
+			continue
+		}
+		if err := lc.addEdges(1); err != nil {
+			return err
+		}
+		if e := nl.GetEdgeByType(dep.Ref, sbom.Edge_dependsOn); e != nil {
+			for _, id := range to {
+				e.AddDestinationById(id)
+			}
+			continue
+		}
+		nl.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: dep.Ref, To: to})
+	}
+	return nil
+}
+
+// cdxDataFlowToProtobom converts a CycloneDX data flow direction
+func (u *CDX) cdxDataFlowToProtobom(flow cdx.DataFlow) sbom.DataFlow_Direction {
+	switch flow {
+	case cdx.DataFlowInbound:
+		return sbom.DataFlow_INBOUND
+	case cdx.DataFlowOutbound:
+		return sbom.DataFlow_OUTBOUND
+	case cdx.DataFlowBidirectional:
+		return sbom.DataFlow_BIDIRECTIONAL
+	default:
+		return sbom.DataFlow_UNKNOWN
+	}
+}
+
 // unserializeExternalReferences reads a slice of cyclonedx references and returns
 // tjeir protobom equivalents.
 func (u *CDX) unserializeExternalReferences(cdxReferences *[]cdx.ExternalReference) []*sbom.ExternalReference {
@@ -236,65 +1012,63 @@ func (u *CDX) unserializeExternalReferences(cdxReferences *[]cdx.ExternalReferen
 }
 
 // licenseChoicesToLicenseList returns a flat list of license strings combining
-// expressions and IDs in one. This function should be part of a license package.
+// expressions and IDs in one.
 func (u *CDX) licenseChoicesToLicenseList(lcs *cdx.Licenses) []string {
 	list := []string{}
 	if lcs == nil {
 		return list
 	}
 	for _, lc := range *lcs {
-		// TODO(license): This should handle licenses without an ID and
-		// create custom licenses or another solution that captures the
-		// full cuistom license text.
-		if lc.Expression == "" && lc.License.ID == "" {
-			continue
-		}
-
-		if lc.Expression != "" {
-			list = append(list, lc.Expression)
-		} else {
-			list = append(list, lc.License.ID)
+		if l := licenseChoiceToString(lc); l != "" {
+			list = append(list, l)
 		}
-		return list
 	}
 
 	return list
 }
 
+// licenseChoiceToString returns the expression or license ID of a license
+// choice.
+func licenseChoiceToString(lc cdx.LicenseChoice) string {
+	// TODO(license): This should handle licenses without an ID and
+	// create custom licenses or another solution that captures the
+	// full cuistom license text.
+	if lc.Expression != "" {
+		return lc.Expression
+	}
+	if lc.License != nil {
+		return lc.License.ID
+	}
+	return ""
+}
+
 // licenseChoicesToLicenseString takes the component license data and computes
-// a license expression with its license entries. It will return the license or
-// expression verbatim if its just a single entry.
-// This function is temporary and probably should be part of a more complete
-// license package.
+// a license expression that requires all (AND) of its license entries, the
+// same way the serializer and Node.LicenseExpression combine them. It will
+// return the license or expression verbatim if its just a single entry.
+// Entries are parsed as SPDX license expressions, if any of them is invalid
+// they are combined as strings.
 func (u *CDX) licenseChoicesToLicenseString(lcs *cdx.Licenses) string {
-	if lcs == nil {
+	entries := u.licenseChoicesToLicenseList(lcs)
+	switch len(entries) {
+	case 0:
 		return ""
+	case 1:
+		return entries[0]
 	}
-	s := ""
-	for _, lc := range *lcs {
-		// TODO(license): This should handle licenses without an ID and
-		// create custom licenses or another solution that captures the
-		// full cuistom license text.
-		if lc.Expression == "" && lc.License.ID == "" {
-			continue
-		}
-		if s != "" {
-			s += fmt.Sprintf("(%s) OR ", s)
-		}
 
-		newLicense := ""
-		if lc.Expression != "" {
-			newLicense = lc.Expression
-		} else {
-			newLicense = lc.License.ID
-		}
-		if s == "" {
-			s = newLicense
-		} else {
-			s += fmt.Sprintf(" (%s)", newLicense)
+	exprs := []license.Expression{}
+	for _, entry := range entries {
+		e, err := license.Parse(entry)
+		if err != nil {
+			for i := range entries {
+				entries[i] = fmt.Sprintf("(%s)", entries[i])
+			}
+			return strings.Join(entries, " AND ")
 		}
+		exprs = append(exprs, e)
 	}
-	return s
+	return license.Join(license.And, exprs...).String()
 }
 
 // phaseToSBOMType converts a CycloneDX lifecycle phase to an SBOM document type
@@ -1,9 +1,12 @@
 package unserializers
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/protobom/protobom/pkg/native"
 	"github.com/protobom/protobom/pkg/sbom"
 	"github.com/stretchr/testify/require"
 )
@@ -122,6 +125,47 @@ func TestCdxExtRefTypeToProtobomType(t *testing.T) {
 	}
 }
 
+func TestLicenseChoicesToLicenseString(t *testing.T) {
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	for name, tc := range map[string]struct {
+		licenses     *cdx.Licenses
+		expectedList []string
+		expected     string
+	}{
+		"nil":  {nil, []string{}, ""},
+		"none": {&cdx.Licenses{}, []string{}, ""},
+		"single": {
+			&cdx.Licenses{{License: &cdx.License{ID: "MIT"}}},
+			[]string{"MIT"}, "MIT",
+		},
+		"several ids": {
+			&cdx.Licenses{{License: &cdx.License{ID: "MIT"}}, {License: &cdx.License{ID: "Apache-2.0"}}},
+			[]string{"MIT", "Apache-2.0"}, "MIT AND Apache-2.0",
+		},
+		"expression": {
+			&cdx.Licenses{{Expression: "MIT AND ISC"}, {License: &cdx.License{ID: "0BSD"}}},
+			[]string{"MIT AND ISC", "0BSD"}, "MIT AND ISC AND 0BSD",
+		},
+		"choice expression": {
+			&cdx.Licenses{{Expression: "MIT OR ISC"}, {License: &cdx.License{ID: "0BSD"}}},
+			[]string{"MIT OR ISC", "0BSD"}, "(MIT OR ISC) AND 0BSD",
+		},
+		"invalid entry": {
+			&cdx.Licenses{{License: &cdx.License{ID: "MIT"}}, {Expression: "MIT AND"}},
+			[]string{"MIT", "MIT AND"}, "(MIT) AND (MIT AND)",
+		},
+		"license without id": {
+			&cdx.Licenses{{License: &cdx.License{Name: "Custom"}}, {}},
+			[]string{}, "",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedList, cdxu.licenseChoicesToLicenseList(tc.licenses))
+			require.Equal(t, tc.expected, cdxu.licenseChoicesToLicenseString(tc.licenses))
+		})
+	}
+}
+
 func TestDeterministicIds(t *testing.T) {
 	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
 	for _, tc := range []struct {
@@ -160,7 +204,7 @@ func TestDeterministicIds(t *testing.T) {
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			cc := 0
-			nodelist, err := cdxu.componentToNodeList(tc.sut, &cc)
+			nodelist, err := cdxu.componentToNodeList(tc.sut, &cc, newLimitCounter(nil), 1)
 			if tc.mustErr {
 				require.Error(t, err)
 				return
@@ -175,3 +219,398 @@ func TestDeterministicIds(t *testing.T) {
 		})
 	}
 }
+
+func TestCDXUnserializeLimits(t *testing.T) {
+	// nested returns a component tree with depth levels
+	nested := func(depth int) string {
+		s := `{"type": "library", "name": "leaf"}`
+		for i := 1; i < depth; i++ {
+			s = fmt.Sprintf(`{"type": "library", "name": "level-%d", "components": [%s]}`, i, s)
+		}
+		return s
+	}
+	doc := func(components ...string) string {
+		return fmt.Sprintf(
+			`{"bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1, "metadata": {"component": {"bom-ref": "root", "type": "application", "name": "root"}}, "components": [%s]}`,
+			strings.Join(components, ","),
+		)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		data   string
+		limits *native.Limits
+		target interface{}
+	}{
+		{
+			name: "no limits",
+			data: doc(nested(10), nested(10)),
+		},
+		{
+			name:   "within limits",
+			data:   doc(nested(3), nested(3)),
+			limits: &native.Limits{MaxNodes: 7, MaxEdges: 6, MaxDepth: 3},
+		},
+		{
+			name:   "too deep",
+			data:   doc(nested(5)),
+			limits: &native.Limits{MaxDepth: 4},
+			target: new(*native.DepthLimitError),
+		},
+		{
+			name:   "too many nodes",
+			data:   doc(nested(3), nested(3)),
+			limits: &native.Limits{MaxNodes: 6},
+			target: new(*native.NodeLimitError),
+		},
+		{
+			name:   "too many edges",
+			data:   doc(nested(3), nested(3)),
+			limits: &native.Limits{MaxEdges: 5},
+			target: new(*native.EdgeLimitError),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+			bom, err := cdxu.Unserialize(
+				strings.NewReader(tc.data), &native.UnserializeOptions{Limits: tc.limits}, nil,
+			)
+			if tc.target != nil {
+				require.ErrorAs(t, err, tc.target)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, bom)
+		})
+	}
+}
+
+func TestCDXUnserializeVulnerabilities(t *testing.T) {
+	data := `{
+  "bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+  "metadata": {"component": {"bom-ref": "app", "type": "application", "name": "app"}},
+  "components": [{"bom-ref": "log4j", "type": "library", "name": "log4j-core", "version": "2.14.1"}],
+  "vulnerabilities": [{
+    "id": "CVE-2021-44228",
+    "source": {"name": "NVD", "url": "https://nvd.nist.gov/vuln/detail/CVE-2021-44228"},
+    "references": [{"id": "GHSA-jfh8-c2jp-5v3q", "source": {"name": "GitHub"}}],
+    "ratings": [{"score": 10.0, "severity": "critical", "method": "CVSSv31", "vector": "AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"}],
+    "cwes": [502, 917],
+    "advisories": [{"title": "Apache Log4j Security Vulnerabilities", "url": "https://logging.apache.org/log4j/2.x/security.html"}],
+    "published": "2021-12-10T10:15:09Z",
+    "analysis": {
+      "state": "false_positive", "justification": "code_not_reachable",
+      "response": ["will_not_fix", "update"], "detail": "JNDI lookups are disabled",
+      "firstIssued": "2021-12-11T00:00:00Z"
+    },
+    "workaround": "Set log4j2.formatMsgNoLookups",
+    "affects": [{"ref": "log4j"}]
+  }]
+}`
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	doc, err := cdxu.Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Len(t, doc.Vulnerabilities, 1)
+
+	v := doc.Vulnerabilities[0]
+	require.Equal(t, "CVE-2021-44228", v.Id)
+	require.Equal(t, "NVD", v.Source.Name)
+	require.Equal(t, []string{"GHSA-jfh8-c2jp-5v3q"}, v.Aliases)
+	require.Equal(t, []int32{502, 917}, v.Cwes)
+	require.Equal(t, []string{"log4j"}, v.Affects)
+	require.Equal(t, int64(1639131309), v.Published.Seconds)
+	require.Nil(t, v.Updated)
+
+	require.Len(t, v.Ratings, 1)
+	require.Equal(t, sbom.VulnerabilityRating_CRITICAL, v.Ratings[0].Severity)
+	require.Equal(t, sbom.VulnerabilityRating_CVSSV31, v.Ratings[0].Method)
+	require.InDelta(t, 10.0, v.Ratings[0].Score, 0.001)
+
+	require.Len(t, v.Advisories, 1)
+	require.Equal(t, sbom.ExternalReference_SECURITY_ADVISORY, v.Advisories[0].Type)
+
+	require.NotNil(t, v.Analysis)
+	require.Equal(t, sbom.VulnerabilityAnalysis_NOT_AFFECTED, v.Analysis.Status)
+	require.Equal(t, sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_IN_EXECUTE_PATH, v.Analysis.Justification)
+	require.Equal(t, []sbom.VulnerabilityAnalysis_Response{
+		sbom.VulnerabilityAnalysis_WILL_NOT_FIX, sbom.VulnerabilityAnalysis_UPDATE,
+	}, v.Analysis.Responses)
+	require.Equal(t, "JNDI lookups are disabled", v.Analysis.Detail)
+	require.Equal(t, "Set log4j2.formatMsgNoLookups", v.Analysis.Action)
+
+	require.Empty(t, doc.Validate().WithSeverity(sbom.SeverityError))
+}
+
+func TestCdxImpactStateToStatus(t *testing.T) {
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	for state, status := range map[cdx.ImpactAnalysisState]sbom.VulnerabilityAnalysis_Status{
+		cdx.IASExploitable:             sbom.VulnerabilityAnalysis_AFFECTED,
+		cdx.IASNotAffected:             sbom.VulnerabilityAnalysis_NOT_AFFECTED,
+		cdx.IASFalsePositive:           sbom.VulnerabilityAnalysis_NOT_AFFECTED,
+		cdx.IASResolved:                sbom.VulnerabilityAnalysis_FIXED,
+		cdx.IASResolvedWithPedigree:    sbom.VulnerabilityAnalysis_FIXED,
+		cdx.IASInTriage:                sbom.VulnerabilityAnalysis_UNDER_INVESTIGATION,
+		cdx.ImpactAnalysisState("bad"): sbom.VulnerabilityAnalysis_UNKNOWN_STATUS,
+	} {
+		require.Equal(t, status, cdxu.cdxImpactStateToStatus(state))
+	}
+}
+
+func TestCDXUnserializePropertiesAndAnnotations(t *testing.T) {
+	data := `{
+  "bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+  "serialNumber": "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+  "metadata": {
+    "component": {"bom-ref": "app", "type": "application", "name": "app"},
+    "properties": [{"name": "build:id", "value": "1234"}]
+  },
+  "components": [{
+    "bom-ref": "log4j", "type": "library", "name": "log4j-core",
+    "properties": [{"name": "syft:package:foundBy", "value": "java-cataloger"}]
+  }],
+  "properties": [{"name": "scope", "value": "release"}],
+  "annotations": [
+    {
+      "subjects": ["log4j", "unknown"], "timestamp": "2024-01-01T00:00:00Z", "text": "Reviewed",
+      "annotator": {"individual": {"name": "Jane Doe", "email": "jane@example.com"}}
+    },
+    {
+      "subjects": ["urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79"], "timestamp": "2024-01-02T00:00:00Z",
+      "text": "Generated in CI", "annotator": {"component": {"type": "application", "name": "scanner", "version": "1.0"}}
+    }
+  ]
+}`
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	doc, err := cdxu.Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, "1234", doc.Metadata.GetPropertyValue("build:id"))
+	require.Equal(t, "release", doc.Metadata.GetPropertyValue("scope"))
+	require.Len(t, doc.Metadata.Annotations, 1)
+	require.Equal(t, "scanner", doc.Metadata.Annotations[0].Tool.Name)
+	require.Equal(t, "Generated in CI", doc.Metadata.Annotations[0].Text)
+
+	log4j := doc.NodeList.GetNodeByID("log4j")
+	require.NotNil(t, log4j)
+	require.Equal(t, "java-cataloger", log4j.GetPropertyValue("syft:package:foundBy"))
+	require.Len(t, log4j.Annotations, 1)
+	require.Equal(t, sbom.Annotation_OTHER, log4j.Annotations[0].Type)
+	require.Equal(t, "Jane Doe", log4j.Annotations[0].Annotator.Name)
+	require.Equal(t, "jane@example.com", log4j.Annotations[0].Annotator.Email)
+	require.Equal(t, int64(1704067200), log4j.Annotations[0].Date.Seconds)
+}
+
+func TestCDXUnserializeSnippets(t *testing.T) {
+	data := `{
+  "bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+  "serialNumber": "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+  "metadata": {"component": {"bom-ref": "app", "type": "application", "name": "app"}},
+  "components": [{
+    "bom-ref": "file", "type": "file", "name": "a.c",
+    "components": [{
+      "bom-ref": "snippet", "type": "file", "name": "vendored",
+      "properties": [
+        {"name": "protobom:snippet:byte_range", "value": "310:420"},
+        {"name": "protobom:snippet:line_range", "value": "5:23"}
+      ]
+    }]
+  }]
+}`
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	doc, err := cdxu.Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, sbom.Node_FILE, doc.NodeList.GetNodeByID("file").Type)
+	snippet := doc.NodeList.GetNodeByID("snippet")
+	require.NotNil(t, snippet)
+	require.Equal(t, sbom.Node_SNIPPET, snippet.Type)
+	require.Equal(t, int64(310), snippet.ByteRange.Start)
+	require.Equal(t, int64(23), snippet.LineRange.End)
+	require.Empty(t, snippet.Properties)
+	require.Equal(t, "file", doc.NodeList.GetSnippetFile("snippet").Id)
+}
+
+func TestCDXUnserializeServices(t *testing.T) {
+	data := `{
+  "bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+  "serialNumber": "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+  "metadata": {"component": {"bom-ref": "app", "type": "application", "name": "app"}},
+  "components": [{"bom-ref": "lib", "type": "library", "name": "lib"}],
+  "services": [{
+    "bom-ref": "api", "name": "api", "version": "2.0",
+    "provider": {"name": "Acme", "url": ["https://acme.example"]},
+    "endpoints": ["https://api.acme.example/v2"],
+    "authenticated": true, "x-trust-boundary": false,
+    "data": [{"flow": "inbound", "classification": "PII"}, {"flow": "bi-directional", "classification": "public"}],
+    "services": [{"bom-ref": "auth", "name": "auth"}]
+  }],
+  "dependencies": [
+    {"ref": "app", "dependsOn": ["lib", "api"]},
+    {"ref": "api", "dependsOn": ["auth", "missing"]},
+    {"ref": "lib", "dependsOn": []}
+  ]
+}`
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	doc, err := cdxu.Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	api := doc.NodeList.GetNodeByID("api")
+	require.NotNil(t, api)
+	require.Equal(t, sbom.Node_SERVICE, api.Type)
+	require.Equal(t, "2.0", api.Version)
+	require.Len(t, api.Suppliers, 1)
+	require.Equal(t, "Acme", api.Suppliers[0].Name)
+	require.True(t, api.Suppliers[0].IsOrg)
+	require.Equal(t, "https://acme.example", api.Suppliers[0].Url)
+	require.Equal(t, []string{"https://api.acme.example/v2"}, api.Endpoints)
+	require.True(t, api.GetAuthenticated())
+	require.NotNil(t, api.CrossesTrustBoundary)
+	require.False(t, api.GetCrossesTrustBoundary())
+	require.Len(t, api.DataFlows, 2)
+	require.Equal(t, sbom.DataFlow_INBOUND, api.DataFlows[0].Flow)
+	require.Equal(t, "PII", api.DataFlows[0].Classification)
+	require.Equal(t, sbom.DataFlow_BIDIRECTIONAL, api.DataFlows[1].Flow)
+
+	auth := doc.NodeList.GetNodeByID("auth")
+	require.NotNil(t, auth)
+	require.Equal(t, sbom.Node_SERVICE, auth.Type)
+
+	contains := doc.NodeList.GetEdgeByType("api", sbom.Edge_contains)
+	require.NotNil(t, contains)
+	require.Equal(t, []string{"auth"}, contains.To)
+
+	// Only the dependencies involving services are read
+	appDeps := doc.NodeList.GetEdgeByType("app", sbom.Edge_dependsOn)
+	require.NotNil(t, appDeps)
+	require.Equal(t, []string{"api"}, appDeps.To)
+	apiDeps := doc.NodeList.GetEdgeByType("api", sbom.Edge_dependsOn)
+	require.NotNil(t, apiDeps)
+	require.Equal(t, []string{"auth"}, apiDeps.To)
+	require.Nil(t, doc.NodeList.GetEdgeByType("lib", sbom.Edge_dependsOn))
+}
+
+func TestCDXUnserializeTopLevelServices(t *testing.T) {
+	// Without a metadata component, services are not related to the first
+	// component found but added as root elements.
+	data := `{
+  "bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+  "components": [{"bom-ref": "lib", "type": "library", "name": "lib"}],
+  "services": [{"bom-ref": "api", "name": "api"}, {"bom-ref": "db", "name": "db"}],
+  "dependencies": [{"ref": "api", "dependsOn": ["lib"]}]
+}`
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	doc, err := cdxu.Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"lib", "api", "db"}, doc.NodeList.RootElements)
+	require.Len(t, doc.NodeList.Edges, 1)
+	require.Equal(t, []string{"lib"}, doc.NodeList.GetEdgeByType("api", sbom.Edge_dependsOn).To)
+}
+
+func TestCDXUnserializePedigree(t *testing.T) {
+	data := `{
+  "bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+  "serialNumber": "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+  "metadata": {"component": {"bom-ref": "app", "type": "application", "name": "app"}},
+  "components": [{
+    "bom-ref": "tomcat", "type": "library", "name": "tomcat-catalina", "version": "9.0.14-acme",
+    "pedigree": {
+      "ancestors": [{"bom-ref": "upstream", "type": "library", "name": "tomcat-catalina", "version": "9.0.14"}],
+      "descendants": [{"bom-ref": "next", "type": "library", "name": "tomcat-catalina", "version": "9.0.15-acme"}],
+      "variants": [{"bom-ref": "variant", "type": "library", "name": "tomcat-catalina-slim", "version": "9.0.14-acme"}],
+      "commits": [{
+        "uid": "7638417db6d59f3c431d3e1f261cc637155684cd", "message": "Fix the thing",
+        "author": {"timestamp": "2018-11-13T20:20:39+00:00", "name": "me", "email": "me@acme.org"}
+      }],
+      "patches": [{
+        "type": "backport",
+        "diff": {"url": "https://acme.example/patches/CVE-2019-0232.diff"},
+        "resolves": [{"type": "security", "id": "CVE-2019-0232", "source": {"name": "NVD", "url": "https://nvd.nist.gov"}}]
+      }],
+      "notes": "Patched by Acme"
+    }
+  }]
+}`
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	doc, err := cdxu.Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	tomcat := doc.NodeList.GetNodeByID("tomcat")
+	require.NotNil(t, tomcat)
+	require.Equal(t, "Patched by Acme", tomcat.PedigreeNotes)
+	require.Len(t, tomcat.Commits, 1)
+	require.Equal(t, "7638417db6d59f3c431d3e1f261cc637155684cd", tomcat.Commits[0].Uid)
+	require.Equal(t, "me@acme.org", tomcat.Commits[0].Author.Email)
+	require.Equal(t, int64(1542140439), tomcat.Commits[0].Authored.Seconds)
+
+	for edgeType, expected := range map[sbom.Edge_Type]string{
+		sbom.Edge_descendant: "upstream",
+		sbom.Edge_ancestor:   "next",
+		sbom.Edge_variant:    "variant",
+	} {
+		e := doc.NodeList.GetEdgeByType("tomcat", edgeType)
+		require.NotNil(t, e, edgeType.String())
+		require.Equal(t, []string{expected}, e.To)
+		require.NotNil(t, doc.NodeList.GetNodeByID(expected))
+	}
+
+	var patch *sbom.Node
+	for _, e := range doc.NodeList.Edges {
+		if e.Type == sbom.Edge_patch {
+			require.Equal(t, []string{"tomcat"}, e.To)
+			patch = doc.NodeList.GetNodeByID(e.From)
+		}
+	}
+	require.NotNil(t, patch)
+	require.Equal(t, sbom.Node_FILE, patch.Type)
+	require.Equal(t, []sbom.Purpose{sbom.Purpose_PATCH}, patch.PrimaryPurpose)
+	require.Equal(t, "CVE-2019-0232.diff", patch.Name)
+	require.Equal(t, "https://acme.example/patches/CVE-2019-0232.diff", patch.UrlDownload)
+	require.Equal(t, sbom.Patch_BACKPORT, patch.Patch.Type)
+	require.Len(t, patch.Patch.Resolves, 1)
+	require.Equal(t, sbom.PatchIssue_SECURITY, patch.Patch.Resolves[0].Type)
+	require.Equal(t, "NVD", patch.Patch.Resolves[0].SourceName)
+}
+
+func TestCDXUnserializeEvidence(t *testing.T) {
+	data := `{
+  "bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+  "serialNumber": "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+  "metadata": {"component": {"bom-ref": "app", "type": "application", "name": "app"}},
+  "components": [{
+    "bom-ref": "lib", "type": "library", "name": "lib",
+    "evidence": {
+      "identity": {
+        "field": "purl", "confidence": 0.8,
+        "methods": [{"technique": "manifest-analysis", "confidence": 0.8, "value": "package.json"}],
+        "tools": ["scanner"]
+      },
+      "occurrences": [{"location": "/app/node_modules/lib/index.js", "line": 12, "symbol": "main"}],
+      "callstack": {"frames": [{"module": "lib", "function": "run", "parameters": ["a"], "line": 3, "column": 7}]},
+      "licenses": [{"license": {"id": "MIT"}}],
+      "copyright": [{"text": "Copyright 2024 Acme"}]
+    }
+  }]
+}`
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	doc, err := cdxu.Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	lib := doc.NodeList.GetNodeByID("lib")
+	require.NotNil(t, lib)
+	evidence := lib.Evidence
+	require.NotNil(t, evidence)
+	require.Len(t, evidence.Identity, 1)
+	require.Equal(t, "purl", evidence.Identity[0].Field)
+	require.InDelta(t, 0.8, evidence.Identity[0].GetConfidence(), 0.001)
+	require.Equal(t, "manifest-analysis", evidence.Identity[0].Methods[0].Technique)
+	require.Equal(t, []string{"scanner"}, evidence.Identity[0].Tools)
+	require.Len(t, evidence.Occurrences, 1)
+	require.Equal(t, int32(12), evidence.Occurrences[0].GetLine())
+	require.Nil(t, evidence.Occurrences[0].Offset)
+	require.Len(t, evidence.Callstack, 1)
+	require.Equal(t, []string{"a"}, evidence.Callstack[0].Parameters)
+	require.Equal(t, int32(7), evidence.Callstack[0].GetColumn())
+	require.Equal(t, []string{"MIT"}, evidence.Licenses)
+	require.Equal(t, []string{"Copyright 2024 Acme"}, evidence.Copyright)
+}
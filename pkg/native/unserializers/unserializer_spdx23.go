@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 
 	spdxjson "github.com/spdx/tools-golang/json"
 	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
 	spdx23 "github.com/spdx/tools-golang/spdx/v2/v2_3"
 )
 
@@ -44,17 +46,23 @@ func buildDocumentIdentifier(doc *spdx23.Document) string {
 }
 
 // ParseStream reads an io.Reader to parse an SPDX 2.3 document from it
-func (u *SPDX23) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interface{}) (*sbom.Document, error) {
+func (u *SPDX23) Unserialize(r io.Reader, opts *native.UnserializeOptions, _ interface{}) (*sbom.Document, error) {
 	spdxDoc, err := spdxjson.Read(r)
 	if err != nil {
 		return nil, fmt.Errorf("parsing SPDX json: %w", err)
 	}
 
+	lc := newLimitCounter(opts)
+
 	bom := sbom.NewDocument()
 	bom.Metadata.Id = buildDocumentIdentifier(spdxDoc)
 	bom.Metadata.Name = spdxDoc.DocumentName
 
-	// TODO(degradation): External document references
+	for _, ref := range spdxDoc.ExternalDocumentReferences {
+		bom.Metadata.ExternalDocumentReferences = append(
+			bom.Metadata.ExternalDocumentReferences, u.externalDocumentRefToProtobom(ref),
+		)
+	}
 
 	// TODO(puerco) Top level elements
 	if spdxDoc.CreationInfo != nil {
@@ -78,15 +86,28 @@ func (u *SPDX23) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interf
 
 	// TODO(degradation): SPDX LicenseVersion
 
+	for i := range spdxDoc.Annotations {
+		bom.Metadata.Annotations = append(bom.Metadata.Annotations, u.annotationToProtobom(spdxDoc.Annotations[i]))
+	}
+
 	for _, p := range spdxDoc.Packages {
+		if err := lc.addNodes(1); err != nil {
+			return nil, err
+		}
 		bom.NodeList.AddNode(u.packageToNode(p))
 	}
 
 	for _, f := range spdxDoc.Files {
+		if err := lc.addNodes(1); err != nil {
+			return nil, err
+		}
 		bom.NodeList.AddNode(u.fileToNode(f))
 	}
 
 	for _, r := range spdxDoc.Relationships {
+		if err := lc.addEdges(1); err != nil {
+			return nil, err
+		}
 		// The SPDX go library surfaces the JSON top-level elements as relationships:
 		if r.RefA.ElementRefID == "DOCUMENT" && strings.EqualFold(r.Relationship, "DESCRIBES") {
 			bom.NodeList.RootElements = append(bom.NodeList.RootElements, string(r.RefB.ElementRefID))
@@ -95,9 +116,137 @@ func (u *SPDX23) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interf
 		}
 	}
 
+	// The files listed in hasFiles are surfaced as relationships by the SPDX
+	// library. Files nested in the packages are reconciled here.
+	for _, p := range spdxDoc.Packages {
+		if err := u.packageFilesToNodeList(bom.NodeList, p, lc); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.snippetsToNodeList(bom.NodeList, spdxDoc, lc); err != nil {
+		return nil, err
+	}
+
 	return bom, nil
 }
 
+// packageFilesToNodeList adds the files nested in an SPDX package to the
+// nodelist and relates them to the package node with a contains edge.
+//
+// TODO(degradation): The package verification code is not kept, it is
+// computed from the file hashes when serializing.
+func (u *SPDX23) packageFilesToNodeList(nl *sbom.NodeList, p *spdx23.Package, lc *limitCounter) error {
+	if len(p.Files) == 0 {
+		return nil
+	}
+
+	ids := []string{}
+	for _, f := range p.Files {
+		id := string(f.FileSPDXIdentifier)
+		if nl.GetNodeByID(id) == nil {
+			if err := lc.addNodes(1); err != nil {
+				return err
+			}
+			nl.AddNode(u.fileToNode(f))
+		}
+		ids = append(ids, id)
+	}
+
+	return addContainsEdge(nl, string(p.PackageSPDXIdentifier), ids, lc)
+}
+
+// addContainsEdge relates the nodes to the from node with a contains edge,
+// reusing the existing contains edge of the node if there is one.
+func addContainsEdge(nl *sbom.NodeList, from string, ids []string, lc *limitCounter) error {
+	if e := nl.GetEdgeByType(from, sbom.Edge_contains); e != nil {
+		e.AddDestinationById(ids...)
+		return nil
+	}
+	if err := lc.addEdges(1); err != nil {
+		return err
+	}
+	nl.AddEdge(&sbom.Edge{
+		Type: sbom.Edge_contains,
+		From: from,
+		To:   ids,
+	})
+	return nil
+}
+
+// snippetsToNodeList adds the snippets of the SPDX document to the nodelist
+// and relates them to their files with a contains edge. The JSON parser lists
+// the snippets in the document while the tag-value parser nests them in the
+// files, both are read.
+func (u *SPDX23) snippetsToNodeList(nl *sbom.NodeList, doc *spdx23.Document, lc *limitCounter) error {
+	snippets := []*spdx23.Snippet{}
+	for i := range doc.Snippets {
+		snippets = append(snippets, &doc.Snippets[i])
+	}
+	files := slices.Clone(doc.Files)
+	for _, p := range doc.Packages {
+		files = append(files, p.Files...)
+	}
+	for _, f := range files {
+		ids := []string{}
+		for id := range f.Snippets {
+			ids = append(ids, string(id))
+		}
+		slices.Sort(ids)
+		for _, id := range ids {
+			snippets = append(snippets, f.Snippets[common.ElementID(id)])
+		}
+	}
+
+	for _, sn := range snippets {
+		if nl.GetNodeByID(string(sn.SnippetSPDXIdentifier)) != nil {
+			continue
+		}
+		if err := lc.addNodes(1); err != nil {
+			return err
+		}
+		nl.AddNode(u.snippetToNode(sn))
+		if sn.SnippetFromFileSPDXIdentifier == "" {
+			continue
+		}
+		if err := addContainsEdge(
+			nl, string(sn.SnippetFromFileSPDXIdentifier), []string{string(sn.SnippetSPDXIdentifier)}, lc,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snippetToNode converts an SPDX snippet into a protobom node
+func (u *SPDX23) snippetToNode(sn *spdx23.Snippet) *sbom.Node {
+	n := &sbom.Node{
+		Id:               string(sn.SnippetSPDXIdentifier),
+		Type:             sbom.Node_SNIPPET,
+		Name:             sn.SnippetName,
+		Licenses:         sn.LicenseInfoInSnippet,
+		LicenseConcluded: sn.SnippetLicenseConcluded,
+		LicenseComments:  sn.SnippetLicenseComments,
+		Copyright:        sn.SnippetCopyrightText,
+		Comment:          sn.SnippetComment,
+		Attribution:      sn.SnippetAttributionTexts,
+		Suppliers:        []*sbom.Person{},
+		Originators:      []*sbom.Person{},
+	}
+
+	// TODO(degradation): SPDX snippets can have more than one range of each
+	// kind, only the first byte and line ranges are kept.
+	for _, r := range sn.Ranges {
+		switch {
+		case r.StartPointer.Offset > 0 && n.ByteRange == nil:
+			n.ByteRange = &sbom.SnippetRange{Start: int64(r.StartPointer.Offset), End: int64(r.EndPointer.Offset)}
+		case r.StartPointer.LineNumber > 0 && n.LineRange == nil:
+			n.LineRange = &sbom.SnippetRange{Start: int64(r.StartPointer.LineNumber), End: int64(r.EndPointer.LineNumber)}
+		}
+	}
+	return n
+}
+
 // packageToNode assigns the data from an SPDX package into a new Node
 func (u *SPDX23) packageToNode(p *spdx23.Package) *sbom.Node {
 	n := &sbom.Node{
@@ -116,6 +265,7 @@ func (u *SPDX23) packageToNode(p *spdx23.Package) *sbom.Node {
 		Description:     p.PackageDescription,
 		Attribution:     p.PackageAttributionTexts,
 		Identifiers:     map[int32]string{},
+		Annotations:     u.annotationsToProtobom(p.Annotations),
 	}
 
 	// SPDX 2.3 PrimaryPackagePurpose types: APPLICATION | FRAMEWORK | LIBRARY | CONTAINER | OPERATING-SYSTEM | DEVICE | FIRMWARE | SOURCE | ARCHIVE | FILE | INSTALL | OTHER
@@ -221,6 +371,8 @@ func (u *SPDX23) packageToNode(p *spdx23.Package) *sbom.Node {
 		}
 	}
 
+	n.RestoreProvenance()
+
 	return n
 }
 
@@ -252,6 +404,7 @@ func (u *SPDX23) fileToNode(f *spdx23.File) *sbom.Node {
 		Suppliers:        []*sbom.Person{},
 		Originators:      []*sbom.Person{},
 		FileTypes:        f.FileTypes,
+		Annotations:      u.annotationsToProtobom(f.Annotations),
 	}
 
 	if len(f.Checksums) > 0 {
@@ -266,17 +419,77 @@ func (u *SPDX23) fileToNode(f *spdx23.File) *sbom.Node {
 		}
 	}
 
+	n.RestoreProvenance()
+
 	return n
 }
 
-// relationshipToEdge converts the SPDX relationship to a protobom Edge
+// annotationsToProtobom converts the annotations of an SPDX element
+func (u *SPDX23) annotationsToProtobom(annotations []spdx23.Annotation) []*sbom.Annotation {
+	ret := []*sbom.Annotation{}
+	for i := range annotations {
+		ret = append(ret, u.annotationToProtobom(&annotations[i]))
+	}
+	return ret
+}
+
+// annotationToProtobom converts an SPDX annotation to a protobom annotation.
+// Tool annotators are read as tools, persons and organizations as persons.
+func (u *SPDX23) annotationToProtobom(a *spdx23.Annotation) *sbom.Annotation {
+	ret := &sbom.Annotation{
+		Type: sbom.Annotation_OTHER,
+		Text: a.AnnotationComment,
+	}
+	if a.AnnotationType == "REVIEW" {
+		ret.Type = sbom.Annotation_REVIEW
+	}
+	if t := u.spdxDateToTime(a.AnnotationDate); t != nil {
+		ret.Date = timestamppb.New(*t)
+	}
+
+	switch a.Annotator.AnnotatorType {
+	case protospdx.Tool:
+		ret.Tool = &sbom.Tool{Name: a.Annotator.Annotator}
+	case protospdx.Person, protospdx.Organization:
+		_, name, email := protospdx.ParseActorString(a.Annotator.Annotator)
+		ret.Annotator = &sbom.Person{
+			Name:  name,
+			Email: email,
+			IsOrg: a.Annotator.AnnotatorType == protospdx.Organization,
+		}
+	}
+	return ret
+}
+
+// externalDocumentRefToProtobom converts an SPDX external document reference.
+// The SPDX parsers don't agree on keeping the DocumentRef- prefix of the ID,
+// protobom references always have it.
+func (*SPDX23) externalDocumentRefToProtobom(ref spdx23.ExternalDocumentRef) *sbom.ExternalDocumentReference {
+	r := &sbom.ExternalDocumentReference{
+		Id:     sbom.ExternalDocumentRefPrefix + strings.TrimPrefix(ref.DocumentRefID, sbom.ExternalDocumentRefPrefix),
+		Uri:    ref.URI,
+		Hashes: map[int32]string{},
+	}
+	if algo := sbom.HashAlgorithmFromSPDX(ref.Checksum.Algorithm); algo != sbom.HashAlgorithm_UNKNOWN {
+		r.Hashes[int32(algo)] = ref.Checksum.Value
+	}
+	return r
+}
+
+// relationshipToEdge converts the SPDX relationship to a protobom Edge.
+// Elements in external documents are targeted as DocumentRef-ID:NodeID.
 func (*SPDX23) relationshipToEdge(r *spdx23.Relationship) *sbom.Edge {
-	// TODO(degradation) How to handle external documents?
+	// TODO(degradation) Relationships from elements in external documents
+	// are attached to the local element with the same ID.
 	// TODO(degradation) How to handle NOASSERTION and NONE targets
+	to := string(r.RefB.ElementRefID)
+	if r.RefB.DocumentRefID != "" {
+		to = sbom.ExternalNodeID(sbom.ExternalDocumentRefPrefix+r.RefB.DocumentRefID, to)
+	}
 	e := &sbom.Edge{
 		Type: sbom.EdgeTypeFromSPDX2(r.Relationship),
 		From: string(r.RefA.ElementRefID),
-		To:   []string{string(r.RefB.ElementRefID)},
+		To:   []string{to},
 	}
 	return e
 }
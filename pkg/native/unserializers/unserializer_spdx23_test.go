@@ -3,10 +3,13 @@ package unserializers
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/protobom/protobom/pkg/native"
 	"github.com/protobom/protobom/pkg/sbom"
 	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
 	spdx23 "github.com/spdx/tools-golang/spdx/v2/v2_3"
 	"github.com/stretchr/testify/require"
 )
@@ -152,3 +155,219 @@ func TestBuildDocumentIdentifier(t *testing.T) {
 		})
 	}
 }
+
+func TestSPDX23UnserializeLimits(t *testing.T) {
+	data := `{
+		"spdxVersion": "SPDX-2.3", "dataLicense": "CC0-1.0", "SPDXID": "SPDXRef-DOCUMENT", "name": "test",
+		"documentNamespace": "https://example.com/test",
+		"creationInfo": {"created": "2023-01-01T00:00:00Z", "creators": ["Tool: test"]},
+		"packages": [
+			{"SPDXID": "SPDXRef-Package-a", "name": "a", "downloadLocation": "NOASSERTION"},
+			{"SPDXID": "SPDXRef-Package-b", "name": "b", "downloadLocation": "NOASSERTION"}
+		],
+		"files": [
+			{"SPDXID": "SPDXRef-File-c", "fileName": "c", "checksums": [{"algorithm": "SHA1", "checksumValue": "da39a3ee5e6b4b0d3255bfef95601890afd80709"}]}
+		],
+		"relationships": [
+			{"spdxElementId": "SPDXRef-DOCUMENT", "relationshipType": "DESCRIBES", "relatedSpdxElement": "SPDXRef-Package-a"},
+			{"spdxElementId": "SPDXRef-Package-a", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "SPDXRef-Package-b"},
+			{"spdxElementId": "SPDXRef-Package-b", "relationshipType": "CONTAINS", "relatedSpdxElement": "SPDXRef-File-c"}
+		]
+	}`
+
+	for _, tc := range []struct {
+		name   string
+		limits *native.Limits
+		target interface{}
+	}{
+		{name: "no limits"},
+		{name: "within limits", limits: &native.Limits{MaxNodes: 3, MaxEdges: 3}},
+		{name: "too many nodes", limits: &native.Limits{MaxNodes: 2}, target: new(*native.NodeLimitError)},
+		{name: "too many edges", limits: &native.Limits{MaxEdges: 2}, target: new(*native.EdgeLimitError)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bom, err := NewSPDX23().Unserialize(
+				strings.NewReader(data), &native.UnserializeOptions{Limits: tc.limits}, nil,
+			)
+			if tc.target != nil {
+				require.ErrorAs(t, err, tc.target)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, bom.NodeList.Nodes, 3)
+		})
+	}
+}
+
+func TestSPDX23UnserializeAnnotations(t *testing.T) {
+	data := `{
+		"spdxVersion": "SPDX-2.3", "dataLicense": "CC0-1.0", "SPDXID": "SPDXRef-DOCUMENT", "name": "test",
+		"documentNamespace": "https://example.com/test",
+		"creationInfo": {"created": "2023-01-01T00:00:00Z", "creators": ["Tool: test"]},
+		"annotations": [
+			{"annotator": "Tool: scanner-1.0", "annotationDate": "2023-01-02T00:00:00Z", "annotationType": "OTHER", "comment": "Generated in CI"}
+		],
+		"packages": [{
+			"SPDXID": "SPDXRef-Package-a", "name": "a", "downloadLocation": "NOASSERTION",
+			"annotations": [
+				{"annotator": "Person: Jane Doe (jane@example.com)", "annotationDate": "2023-01-03T00:00:00Z", "annotationType": "REVIEW", "comment": "Looks good"}
+			]
+		}],
+		"files": [{
+			"SPDXID": "SPDXRef-File-b", "fileName": "b",
+			"checksums": [{"algorithm": "SHA1", "checksumValue": "da39a3ee5e6b4b0d3255bfef95601890afd80709"}],
+			"annotations": [
+				{"annotator": "Organization: ACME", "annotationDate": "2023-01-04T00:00:00Z", "annotationType": "OTHER", "comment": "Vendored"}
+			]
+		}]
+	}`
+
+	bom, err := NewSPDX23().Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	require.Len(t, bom.Metadata.Annotations, 1)
+	require.Equal(t, "scanner-1.0", bom.Metadata.Annotations[0].Tool.Name)
+	require.Equal(t, "Generated in CI", bom.Metadata.Annotations[0].Text)
+
+	pkg := bom.NodeList.GetNodeByID("Package-a")
+	require.Len(t, pkg.Annotations, 1)
+	require.Equal(t, sbom.Annotation_REVIEW, pkg.Annotations[0].Type)
+	require.Equal(t, "Jane Doe", pkg.Annotations[0].Annotator.Name)
+	require.Equal(t, "jane@example.com", pkg.Annotations[0].Annotator.Email)
+	require.False(t, pkg.Annotations[0].Annotator.IsOrg)
+
+	file := bom.NodeList.GetNodeByID("File-b")
+	require.Len(t, file.Annotations, 1)
+	require.Equal(t, sbom.Annotation_OTHER, file.Annotations[0].Type)
+	require.True(t, file.Annotations[0].Annotator.IsOrg)
+	require.Equal(t, int64(1672790400), file.Annotations[0].Date.Seconds)
+}
+
+func TestSPDX23UnserializeExternalDocuments(t *testing.T) {
+	data := `{
+		"spdxVersion": "SPDX-2.3", "dataLicense": "CC0-1.0", "SPDXID": "SPDXRef-DOCUMENT", "name": "frontend",
+		"documentNamespace": "https://example.com/frontend",
+		"creationInfo": {"created": "2023-01-01T00:00:00Z", "creators": ["Tool: test"]},
+		"externalDocumentRefs": [{
+			"externalDocumentId": "DocumentRef-backend", "spdxDocument": "https://example.com/backend",
+			"checksum": {"algorithm": "SHA1", "checksumValue": "da39a3ee5e6b4b0d3255bfef95601890afd80709"}
+		}],
+		"packages": [{"SPDXID": "SPDXRef-Package-a", "name": "a", "downloadLocation": "NOASSERTION"}],
+		"relationships": [
+			{"spdxElementId": "SPDXRef-DOCUMENT", "relationshipType": "DESCRIBES", "relatedSpdxElement": "SPDXRef-Package-a"},
+			{"spdxElementId": "SPDXRef-Package-a", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "DocumentRef-backend:SPDXRef-Package-api"}
+		]
+	}`
+
+	bom, err := NewSPDX23().Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	require.Len(t, bom.Metadata.ExternalDocumentReferences, 1)
+	ref := bom.Metadata.ExternalDocumentReferences[0]
+	require.Equal(t, "DocumentRef-backend", ref.Id)
+	require.Equal(t, "https://example.com/backend", ref.Uri)
+	require.Equal(t, "da39a3ee5e6b4b0d3255bfef95601890afd80709", ref.Hashes[int32(sbom.HashAlgorithm_SHA1)])
+
+	require.Len(t, bom.NodeList.Edges, 1)
+	require.Equal(t, []string{"DocumentRef-backend:Package-api"}, bom.NodeList.Edges[0].To)
+	require.Empty(t, bom.Validate().WithSeverity(sbom.SeverityError))
+}
+
+func TestSPDX23UnserializePackageFiles(t *testing.T) {
+	data := `{
+		"spdxVersion": "SPDX-2.3", "dataLicense": "CC0-1.0", "SPDXID": "SPDXRef-DOCUMENT", "name": "test",
+		"documentNamespace": "https://example.com/test",
+		"creationInfo": {"created": "2023-01-01T00:00:00Z", "creators": ["Tool: test"]},
+		"packages": [
+			{"SPDXID": "SPDXRef-Package-a", "name": "a", "downloadLocation": "NOASSERTION", "hasFiles": ["SPDXRef-File-a"]},
+			{
+				"SPDXID": "SPDXRef-Package-b", "name": "b", "downloadLocation": "NOASSERTION", "hasFiles": ["SPDXRef-File-b"],
+				"files": [
+					{"SPDXID": "SPDXRef-File-b", "fileName": "b.txt", "copyrightText": "NONE"},
+					{"SPDXID": "SPDXRef-File-c", "fileName": "c.txt", "copyrightText": "NONE"}
+				]
+			}
+		],
+		"files": [
+			{"SPDXID": "SPDXRef-File-a", "fileName": "a.txt", "copyrightText": "NONE"},
+			{"SPDXID": "SPDXRef-File-b", "fileName": "b.txt", "copyrightText": "NONE"}
+		]
+	}`
+
+	bom, err := NewSPDX23().Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Len(t, bom.NodeList.Nodes, 5)
+	require.Equal(t, sbom.Node_FILE, bom.NodeList.GetNodeByID("File-c").Type)
+
+	e := bom.NodeList.GetEdgeByType("Package-a", sbom.Edge_contains)
+	require.NotNil(t, e)
+	require.Equal(t, []string{"File-a"}, e.To)
+
+	// Nested files are added to the hasFiles edge without duplicates
+	e = bom.NodeList.GetEdgeByType("Package-b", sbom.Edge_contains)
+	require.NotNil(t, e)
+	require.Equal(t, []string{"File-b", "File-c"}, e.To)
+	require.Len(t, bom.NodeList.Edges, 2)
+}
+
+func TestSPDX23UnserializeSnippets(t *testing.T) {
+	data := `{
+		"spdxVersion": "SPDX-2.3", "dataLicense": "CC0-1.0", "SPDXID": "SPDXRef-DOCUMENT", "name": "test",
+		"documentNamespace": "https://example.com/test",
+		"creationInfo": {"created": "2023-01-01T00:00:00Z", "creators": ["Tool: test"]},
+		"files": [{"SPDXID": "SPDXRef-File-a", "fileName": "a.c", "copyrightText": "NONE"}],
+		"snippets": [{
+			"SPDXID": "SPDXRef-Snippet-a", "snippetFromFile": "SPDXRef-File-a", "name": "vendored",
+			"licenseConcluded": "MIT", "licenseInfoInSnippets": ["MIT"], "copyrightText": "Copyright 2008 Example",
+			"ranges": [
+				{"startPointer": {"offset": 310, "reference": "SPDXRef-File-a"}, "endPointer": {"offset": 420, "reference": "SPDXRef-File-a"}},
+				{"startPointer": {"lineNumber": 5, "reference": "SPDXRef-File-a"}, "endPointer": {"lineNumber": 23, "reference": "SPDXRef-File-a"}}
+			]
+		}],
+		"relationships": [
+			{"spdxElementId": "SPDXRef-DOCUMENT", "relationshipType": "DESCRIBES", "relatedSpdxElement": "SPDXRef-File-a"},
+			{"spdxElementId": "SPDXRef-File-a", "relationshipType": "CONTAINS", "relatedSpdxElement": "SPDXRef-Snippet-a"}
+		]
+	}`
+
+	bom, err := NewSPDX23().Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	n := bom.NodeList.GetNodeByID("Snippet-a")
+	require.NotNil(t, n)
+	require.Equal(t, sbom.Node_SNIPPET, n.Type)
+	require.Equal(t, "vendored", n.Name)
+	require.Equal(t, "MIT", n.LicenseConcluded)
+	require.Equal(t, "Copyright 2008 Example", n.Copyright)
+	require.Equal(t, int64(310), n.ByteRange.Start)
+	require.Equal(t, int64(420), n.ByteRange.End)
+	require.Equal(t, int64(5), n.LineRange.Start)
+	require.Equal(t, int64(23), n.LineRange.End)
+
+	// The snippetFromFile and the CONTAINS relationship share the edge
+	require.Len(t, bom.NodeList.Edges, 1)
+	require.Equal(t, "File-a", bom.NodeList.GetSnippetFile("Snippet-a").Id)
+	require.Empty(t, bom.Validate())
+}
+
+func TestSnippetsToNodeList(t *testing.T) {
+	// The tag-value parser nests the snippets in their files
+	doc := &spdx23.Document{
+		Files: []*spdx23.File{{
+			FileSPDXIdentifier: "File-a",
+			Snippets: map[common.ElementID]*spdx23.Snippet{
+				"Snippet-b": {SnippetSPDXIdentifier: "Snippet-b", SnippetFromFileSPDXIdentifier: "File-a"},
+				"Snippet-a": {SnippetSPDXIdentifier: "Snippet-a", SnippetFromFileSPDXIdentifier: "File-a"},
+			},
+		}},
+		Snippets: []spdx23.Snippet{
+			{SnippetSPDXIdentifier: "Snippet-a", SnippetFromFileSPDXIdentifier: "File-a"},
+		},
+	}
+
+	nl := &sbom.NodeList{}
+	require.NoError(t, NewSPDX23().snippetsToNodeList(nl, doc, newLimitCounter(nil)))
+	require.Len(t, nl.Nodes, 2)
+	require.Len(t, nl.Edges, 1)
+	require.Equal(t, []string{"Snippet-a", "Snippet-b"}, nl.Edges[0].To)
+}
@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/protobom/protobom/pkg/license"
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// incomingEdge is an edge pointing to a node, used to look for the link
+// that brings a component into the software.
+type incomingEdge struct {
+	from     string
+	edgeType sbom.Edge_Type
+}
+
+// graphPaths computes the shortest paths from the document roots to each
+// node of the NodeList following the edges in their natural direction.
+type graphPaths struct {
+	parents  map[string]string
+	incoming map[string][]incomingEdge
+}
+
+// newGraphPaths walks the NodeList breadth first from its root elements
+func newGraphPaths(nl *sbom.NodeList) *graphPaths {
+	g := &graphPaths{
+		parents:  map[string]string{},
+		incoming: map[string][]incomingEdge{},
+	}
+
+	outgoing := map[string][]string{}
+	for _, e := range nl.Edges {
+		for _, to := range e.To {
+			outgoing[e.From] = append(outgoing[e.From], to)
+			g.incoming[to] = append(g.incoming[to], incomingEdge{from: e.From, edgeType: e.Type})
+		}
+	}
+
+	queue := []string{}
+	for _, id := range nl.RootElements {
+		if _, ok := g.parents[id]; ok {
+			continue
+		}
+		g.parents[id] = ""
+		queue = append(queue, id)
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, to := range outgoing[id] {
+			if _, ok := g.parents[to]; ok {
+				continue
+			}
+			g.parents[to] = id
+			queue = append(queue, to)
+		}
+	}
+	return g
+}
+
+// pathTo returns the node IDs from a root element to the node. Nodes not
+// reachable from the roots return a path with only themselves.
+func (g *graphPaths) pathTo(id string) []string {
+	path := []string{id}
+	for {
+		parent := g.parents[id]
+		if parent == "" {
+			break
+		}
+		path = append([]string{parent}, path...)
+		id = parent
+	}
+	return path
+}
+
+// linkedPath returns the shortest path to the node that ends in an edge of
+// any of the linking kinds, along with the kind of link found.
+func (g *graphPaths) linkedPath(id string, linking []Linking) ([]string, Linking, bool) {
+	var best []string
+	var bestLinking Linking
+	for _, in := range g.incoming[id] {
+		for _, l := range linking {
+			if !l.matches(in.edgeType) {
+				continue
+			}
+			path := append(g.pathTo(in.from), id)
+			if best == nil || len(path) < len(best) {
+				best = path
+				bestLinking = l
+			}
+			break
+		}
+	}
+	return best, bestLinking, best != nil
+}
+
+// Evaluate checks the licenses of every node in the document against the
+// policy and returns a report with the verdict of each one. The licenses
+// evaluated are the node's concluded license or, when it has none, all its
+// declared licenses.
+func (p *Policy) Evaluate(doc *sbom.Document) (*Report, error) {
+	if doc == nil {
+		return nil, errors.New("document is nil")
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	report := newReport(p.Name)
+	nl := doc.GetNodeList()
+	if nl == nil {
+		return report, nil
+	}
+
+	paths := newGraphPaths(nl)
+	lists := p.compileLists()
+	for _, n := range nl.Nodes {
+		report.add(p.evaluateNode(n, paths, lists))
+	}
+	return report, nil
+}
+
+// policyLists holds the license lists of the policy parsed once to evaluate
+// all the nodes of a document.
+type policyLists struct {
+	allow    *license.List
+	deny     *license.List
+	review   *license.List // allow and review lists merged
+	copyleft []*license.List
+
+	// exceptions has the allow list merged with the licenses of each
+	// exception, nil for exceptions that apply to any license.
+	exceptions []*license.List
+}
+
+// compileLists parses the license lists of the policy
+func (p *Policy) compileLists() *policyLists {
+	allow := license.NewList(p.Allow)
+	lists := &policyLists{
+		allow:      allow,
+		deny:       license.NewList(p.Deny),
+		review:     allow.Merge(license.NewList(p.Review)),
+		copyleft:   make([]*license.List, len(p.Copyleft)),
+		exceptions: make([]*license.List, len(p.Exceptions)),
+	}
+	for i := range p.Copyleft {
+		lists.copyleft[i] = license.NewList(p.Copyleft[i].Licenses)
+	}
+	for i := range p.Exceptions {
+		if len(p.Exceptions[i].Licenses) > 0 {
+			lists.exceptions[i] = allow.Merge(license.NewList(p.Exceptions[i].Licenses))
+		}
+	}
+	return lists
+}
+
+// evaluateNode computes the verdict of a single node
+func (p *Policy) evaluateNode(n *sbom.Node, paths *graphPaths, lists *policyLists) *Result {
+	res := &Result{
+		NodeID:  n.Id,
+		Name:    n.Name,
+		Version: n.Version,
+		Purl:    string(n.Purl()),
+		Verdict: VerdictAllowed,
+		Reasons: []string{},
+		Path:    paths.pathTo(n.Id),
+	}
+
+	expr, err := nodeLicense(n)
+	switch {
+	case err != nil:
+		res.Verdict = p.unlicensedVerdict()
+		res.Reasons = append(res.Reasons, fmt.Sprintf("licenses cannot be parsed: %s", err))
+		return p.applyExceptions(n, nil, res, lists)
+	case expr == nil:
+		res.Verdict = p.unlicensedVerdict()
+		res.Reasons = append(res.Reasons, "no license information")
+		return p.applyExceptions(n, nil, res, lists)
+	}
+
+	// The expression is reported as found but normalized once to match it
+	// against the lists.
+	res.License = expr.String()
+	expr = expr.Normalize()
+	switch {
+	case lists.deny.Denies(expr):
+		res.Verdict = VerdictDenied
+		res.Reasons = append(res.Reasons, fmt.Sprintf("%s requires a denied license", res.License))
+	case lists.allow.Allows(expr):
+		res.Reasons = append(res.Reasons, fmt.Sprintf("%s is allowed", res.License))
+	case lists.review.Allows(expr):
+		res.Verdict = VerdictReview
+		res.Reasons = append(res.Reasons, fmt.Sprintf("%s requires review", res.License))
+	default:
+		res.Verdict = p.defaultVerdict()
+		res.Reasons = append(res.Reasons, fmt.Sprintf("%s is not in the policy", res.License))
+	}
+
+	for i := range p.Copyleft {
+		rule := &p.Copyleft[i]
+		if !lists.copyleft[i].Denies(expr) {
+			continue
+		}
+		path, linking, ok := paths.linkedPath(n.Id, rule.Linking)
+		if !ok {
+			continue
+		}
+		res.Reasons = append(res.Reasons, fmt.Sprintf(
+			"copyleft license %s linked (%s) from %s", res.License, linking, path[len(path)-2],
+		))
+		if rule.verdict().Worse(res.Verdict) {
+			res.Verdict = rule.verdict()
+			res.Path = path
+		}
+	}
+
+	return p.applyExceptions(n, expr, res, lists)
+}
+
+// applyExceptions exempts the result if the node is covered by a policy
+// exception. Exceptions limited to some licenses only apply to nodes whose
+// licenses are allowed when adding them to the allow list.
+func (p *Policy) applyExceptions(n *sbom.Node, expr license.Expression, res *Result, lists *policyLists) *Result {
+	if !res.Verdict.Worse(VerdictExempted) {
+		return res
+	}
+	for i := range p.Exceptions {
+		e := &p.Exceptions[i]
+		if !e.matches(n) {
+			continue
+		}
+		if lists.exceptions[i] != nil && !lists.exceptions[i].Allows(expr) {
+			continue
+		}
+		res.Verdict = VerdictExempted
+		reason := fmt.Sprintf("exempted by exception for %s", e.Purl)
+		if e.Reason != "" {
+			reason += ": " + e.Reason
+		}
+		res.Reasons = append(res.Reasons, reason)
+		return res
+	}
+	return res
+}
+
+// nodeLicense returns the license expression of the node to evaluate. The
+// concluded license takes precedence over the declared licenses. The
+// special NONE and NOASSERTION values are treated as missing licenses.
+func nodeLicense(n *sbom.Node) (license.Expression, error) {
+	concluded, err := n.LicenseConcludedExpression()
+	if err != nil {
+		return nil, err
+	}
+	if concluded != nil && !isEmptyLicense(concluded) {
+		return concluded, nil
+	}
+
+	exprs, err := n.LicenseExpressions()
+	if err != nil {
+		return nil, err
+	}
+	declared := []license.Expression{}
+	for _, e := range exprs {
+		if !isEmptyLicense(e) {
+			declared = append(declared, e)
+		}
+	}
+	return license.Join(license.And, declared...), nil
+}
+
+// isEmptyLicense returns true if the expression is NONE or NOASSERTION
+func isEmptyLicense(e license.Expression) bool {
+	l, ok := e.(*license.License)
+	return ok && (strings.EqualFold(l.ID, license.None) || strings.EqualFold(l.ID, license.NoAssertion))
+}
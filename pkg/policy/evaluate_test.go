@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// testDocument returns a document with an application linking several
+// libraries:
+//
+//	app -> static -> libstatic -> dependsOn -> libdeep
+//	    -> dynamic -> libdynamic
+//	    -> dependsOn -> libmit, libdual, libnone, libbad, libexempt
+func testDocument() *sbom.Document {
+	nodes := []*sbom.Node{
+		{Id: "app", Name: "app", Licenses: []string{"MIT"}},
+		{Id: "libstatic", Name: "libstatic", Licenses: []string{"LGPL-2.1-only"}},
+		{Id: "libdeep", Name: "libdeep", Licenses: []string{"GPL-2.0-only"}},
+		{Id: "libdynamic", Name: "libdynamic", Licenses: []string{"GPL-2.0"}},
+		{Id: "libmit", Name: "libmit", LicenseConcluded: "MIT", Licenses: []string{"AGPL-3.0-only"}},
+		{Id: "libdual", Name: "libdual", Licenses: []string{"AGPL-3.0-or-later OR MPL-2.0"}},
+		{Id: "libnone", Name: "libnone", LicenseConcluded: "NOASSERTION", Licenses: []string{"NOASSERTION"}},
+		{Id: "libbad", Name: "libbad", Licenses: []string{"Apache License"}},
+		{
+			Id: "libexempt", Name: "libexempt", Version: "1.0.0", Licenses: []string{"WTFPL"},
+			Identifiers: map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "pkg:npm/exempt@1.0.0"},
+		},
+	}
+	return &sbom.Document{
+		Metadata: &sbom.Metadata{},
+		NodeList: &sbom.NodeList{
+			Nodes: nodes,
+			Edges: []*sbom.Edge{
+				{Type: sbom.Edge_staticLink, From: "app", To: []string{"libstatic"}},
+				{Type: sbom.Edge_dynamicLink, From: "app", To: []string{"libdynamic"}},
+				{Type: sbom.Edge_dependsOn, From: "app", To: []string{"libmit", "libdual", "libnone", "libbad", "libexempt"}},
+				{Type: sbom.Edge_dependsOn, From: "libstatic", To: []string{"libdeep"}},
+			},
+			RootElements: []string{"app"},
+		},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		policy   *Policy
+		verdict  Verdict
+		verdicts map[string]Verdict
+		paths    map[string][]string
+	}{
+		"allow list": {
+			policy: &Policy{
+				Allow: []string{"MIT", "LGPL-2.1-only"},
+				Deny:  []string{"AGPL-3.0-or-later", "GPL-2.0-only"},
+			},
+			verdict: VerdictDenied,
+			verdicts: map[string]Verdict{
+				"app": VerdictAllowed, "libstatic": VerdictAllowed, "libdeep": VerdictDenied,
+				"libdynamic": VerdictDenied, "libmit": VerdictAllowed, "libdual": VerdictReview,
+				"libnone": VerdictReview, "libbad": VerdictReview, "libexempt": VerdictReview,
+			},
+			paths: map[string][]string{"libdeep": {"app", "libstatic", "libdeep"}},
+		},
+		"review and defaults": {
+			policy: &Policy{
+				Allow:      []string{"MIT", "LGPL-2.1-only", "GPL-2.0-only", "WTFPL"},
+				Review:     []string{"MPL-2.0"},
+				Default:    VerdictDenied,
+				Unlicensed: VerdictAllowed,
+			},
+			verdict: VerdictReview,
+			verdicts: map[string]Verdict{
+				"libdual": VerdictReview, "libnone": VerdictAllowed, "libbad": VerdictAllowed, "libexempt": VerdictAllowed,
+			},
+		},
+		"copyleft linking": {
+			policy: &Policy{
+				Allow: []string{"MIT", "LGPL-2.1-only", "GPL-2.0-only", "MPL-2.0", "WTFPL"},
+				Copyleft: []CopyleftRule{
+					{Licenses: []string{"GPL-2.0-only"}, Linking: []Linking{LinkingStatic, LinkingDynamic}},
+					{Licenses: []string{"LGPL-2.1-only"}, Linking: []Linking{LinkingStatic}, Verdict: VerdictReview},
+				},
+				Unlicensed: VerdictAllowed,
+			},
+			verdict: VerdictDenied,
+			verdicts: map[string]Verdict{
+				"libstatic": VerdictReview, "libdynamic": VerdictDenied,
+				// libdeep is not linked, just a dependency of libstatic
+				"libdeep": VerdictAllowed,
+			},
+			paths: map[string][]string{"libdynamic": {"app", "libdynamic"}, "libstatic": {"app", "libstatic"}},
+		},
+		"exceptions": {
+			policy: &Policy{
+				Allow:   []string{"MIT"},
+				Deny:    []string{"GPL-2.0-only", "WTFPL"},
+				Default: VerdictDenied,
+				Exceptions: []Exception{
+					{Purl: "pkg:npm/exempt", Versions: "vers:npm/<2.0.0", Reason: "approved"},
+					{Purl: "pkg:npm/other", Licenses: []string{"GPL-2.0-only"}},
+				},
+			},
+			verdict: VerdictDenied,
+			verdicts: map[string]Verdict{
+				"libexempt": VerdictExempted, "libdynamic": VerdictDenied,
+			},
+		},
+		"exception out of range": {
+			policy: &Policy{
+				Allow:      []string{"MIT"},
+				Exceptions: []Exception{{Purl: "pkg:npm/exempt", Versions: "vers:npm/>=2.0.0"}},
+			},
+			verdict:  VerdictReview,
+			verdicts: map[string]Verdict{"libexempt": VerdictReview},
+		},
+		"exception limited to licenses": {
+			policy: &Policy{
+				Exceptions: []Exception{
+					{Purl: "pkg:npm/exempt@1.0.0", Licenses: []string{"MIT"}},
+					{Purl: "pkg:npm/exempt@1.0.0", Licenses: []string{"WTFPL"}},
+				},
+			},
+			verdict:  VerdictReview,
+			verdicts: map[string]Verdict{"libexempt": VerdictExempted},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			report, err := tc.policy.Evaluate(testDocument())
+			require.NoError(t, err)
+			require.Equal(t, tc.verdict, report.Verdict)
+			require.Equal(t, tc.verdict != VerdictDenied, report.Passed())
+			require.Len(t, report.Results, 9)
+
+			results := map[string]*Result{}
+			total := 0
+			for _, res := range report.Results {
+				results[res.NodeID] = res
+				require.NotEmpty(t, res.Reasons)
+			}
+			for _, n := range report.Summary {
+				total += n
+			}
+			require.Equal(t, 9, total)
+
+			for id, verdict := range tc.verdicts {
+				require.Equal(t, verdict, results[id].Verdict, id)
+			}
+			for id, path := range tc.paths {
+				require.Equal(t, path, results[id].Path, id)
+			}
+			for _, res := range report.Violations() {
+				require.True(t, res.Verdict == VerdictReview || res.Verdict == VerdictDenied)
+			}
+		})
+	}
+}
+
+func TestEvaluateLicense(t *testing.T) {
+	report, err := (&Policy{Allow: []string{"MIT"}}).Evaluate(testDocument())
+	require.NoError(t, err)
+	licenses := map[string]string{}
+	for _, res := range report.Results {
+		licenses[res.NodeID] = res.License
+	}
+	require.Equal(t, "MIT", licenses["libmit"])
+	require.Equal(t, "GPL-2.0", licenses["libdynamic"])
+	require.Equal(t, "", licenses["libnone"])
+	require.Equal(t, "", licenses["libbad"])
+}
+
+func TestEvaluateLongExpression(t *testing.T) {
+	// Evaluating the expression must not expand its 2^40 choices
+	clauses := []string{}
+	for i := 0; i < 40; i++ {
+		clauses = append(clauses, "(MIT OR GPL-3.0)")
+	}
+	doc := &sbom.Document{NodeList: &sbom.NodeList{
+		Nodes: []*sbom.Node{{Id: "lib", Licenses: []string{strings.Join(clauses, " AND ")}}},
+	}}
+
+	report, err := (&Policy{Allow: []string{"MIT"}, Deny: []string{"GPL-3.0-only"}}).Evaluate(doc)
+	require.NoError(t, err)
+	require.Equal(t, VerdictAllowed, report.Results[0].Verdict)
+
+	report, err = (&Policy{Deny: []string{"GPL-3.0-only", "MIT"}}).Evaluate(doc)
+	require.NoError(t, err)
+	require.Equal(t, VerdictDenied, report.Results[0].Verdict)
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	_, err := (&Policy{}).Evaluate(nil)
+	require.Error(t, err)
+
+	_, err = (&Policy{Default: "maybe"}).Evaluate(testDocument())
+	require.ErrorIs(t, err, ErrInvalidPolicy)
+
+	report, err := (&Policy{}).Evaluate(&sbom.Document{})
+	require.NoError(t, err)
+	require.Empty(t, report.Results)
+	require.True(t, report.Passed())
+}
+
+func TestReportWrite(t *testing.T) {
+	report, err := (&Policy{Name: "test", Allow: []string{"MIT"}}).Evaluate(testDocument())
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.Write(&buf))
+
+	parsed := &Report{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), parsed))
+	require.Equal(t, report, parsed)
+}
@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package policy evaluates the licenses of the components in an SBOM
+// against a declarative license policy. Policies are written in YAML or
+// JSON, for example:
+//
+//	name: release
+//	allow: [MIT, Apache-2.0, BSD-3-Clause]
+//	review: [MPL-2.0]
+//	deny: [AGPL-3.0-or-later]
+//	default: review
+//	copyleft:
+//	  - licenses: [GPL-2.0-only, GPL-3.0-only]
+//	    linking: [static, dynamic]
+//	  - licenses: [LGPL-2.1-only]
+//	    linking: [static]
+//	exceptions:
+//	  - purl: pkg:npm/left-pad
+//	    licenses: [WTFPL]
+//	    reason: approved by legal
+//
+// Evaluating a policy produces a report with a verdict for every node of
+// the document and the path from the document roots that brings each
+// violation into the SBOM.
+package policy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/protobom/protobom/pkg/license"
+	"github.com/protobom/protobom/pkg/sbom"
+	"github.com/protobom/protobom/pkg/vers"
+)
+
+// ErrInvalidPolicy is returned when a policy cannot be read or has invalid
+// values.
+var ErrInvalidPolicy = errors.New("invalid policy")
+
+// Verdict is the result of evaluating a node against the policy
+type Verdict string
+
+const (
+	// VerdictAllowed means the node licenses comply with the policy
+	VerdictAllowed Verdict = "allow"
+
+	// VerdictExempted means the node licenses violate the policy but the
+	// node is covered by one of its exceptions.
+	VerdictExempted Verdict = "exempt"
+
+	// VerdictReview means the node licenses need to be reviewed by a human
+	VerdictReview Verdict = "review"
+
+	// VerdictDenied means the node licenses are not allowed by the policy
+	VerdictDenied Verdict = "deny"
+)
+
+// severity orders the verdicts from best to worst
+var severity = map[Verdict]int{
+	VerdictAllowed:  0,
+	VerdictExempted: 1,
+	VerdictReview:   2,
+	VerdictDenied:   3,
+}
+
+// Worse returns true if the verdict is more severe than v2
+func (v Verdict) Worse(v2 Verdict) bool {
+	return severity[v] > severity[v2]
+}
+
+// valid returns true if the verdict is one that can be set in a policy
+func (v Verdict) valid() bool {
+	return v == VerdictAllowed || v == VerdictReview || v == VerdictDenied
+}
+
+// Linking is the way a component is linked into its dependent component,
+// determined by the type of the edge that relates them.
+type Linking string
+
+const (
+	// LinkingStatic matches components included with staticLink edges
+	LinkingStatic Linking = "static"
+
+	// LinkingDynamic matches components included with dynamicLink edges
+	LinkingDynamic Linking = "dynamic"
+
+	// LinkingAny matches components included with any kind of edge
+	LinkingAny Linking = "any"
+)
+
+// matches returns true if the linking kind covers the edge type
+func (l Linking) matches(t sbom.Edge_Type) bool {
+	switch l {
+	case LinkingStatic:
+		return t == sbom.Edge_staticLink
+	case LinkingDynamic:
+		return t == sbom.Edge_dynamicLink
+	case LinkingAny:
+		return true
+	default:
+		return false
+	}
+}
+
+// Policy is a declarative license policy
+type Policy struct {
+	// Name identifies the policy in the reports
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Allow lists the licenses that can be used without restrictions
+	Allow []string `json:"allow,omitempty" yaml:"allow,omitempty"`
+
+	// Review lists the licenses that require a human review
+	Review []string `json:"review,omitempty" yaml:"review,omitempty"`
+
+	// Deny lists the licenses that cannot be used
+	Deny []string `json:"deny,omitempty" yaml:"deny,omitempty"`
+
+	// Default is the verdict of licenses not found in any of the lists.
+	// Defaults to review.
+	Default Verdict `json:"default,omitempty" yaml:"default,omitempty"`
+
+	// Unlicensed is the verdict of nodes without license information or
+	// with licenses that cannot be parsed. Defaults to review.
+	Unlicensed Verdict `json:"unlicensed,omitempty" yaml:"unlicensed,omitempty"`
+
+	// Copyleft restricts licenses depending on how the components using
+	// them are linked.
+	Copyleft []CopyleftRule `json:"copyleft,omitempty" yaml:"copyleft,omitempty"`
+
+	// Exceptions exempt specific packages from the policy
+	Exceptions []Exception `json:"exceptions,omitempty" yaml:"exceptions,omitempty"`
+}
+
+// CopyleftRule flags components with copyleft licenses when they are linked
+// into the software in the specified ways. A component matches the rule
+// when all the choices offered by its license expression include one of
+// the rule licenses.
+type CopyleftRule struct {
+	// Licenses the rule applies to
+	Licenses []string `json:"licenses" yaml:"licenses"`
+
+	// Linking lists the kinds of link that trigger the rule
+	Linking []Linking `json:"linking" yaml:"linking"`
+
+	// Verdict of the matching nodes. Defaults to deny.
+	Verdict Verdict `json:"verdict,omitempty" yaml:"verdict,omitempty"`
+}
+
+// Exception exempts the packages matching a purl from the policy
+type Exception struct {
+	// Purl of the exempted packages. If it has no version, it matches all
+	// versions of the package.
+	Purl sbom.PackageURL `json:"purl" yaml:"purl"`
+
+	// Versions optionally limits the exception to a range of versions
+	// written in the vers syntax, such as vers:npm/<2.0.0
+	Versions string `json:"versions,omitempty" yaml:"versions,omitempty"`
+
+	// Licenses limits the exception to the listed licenses. If empty, the
+	// package is exempted regardless of its licenses.
+	Licenses []string `json:"licenses,omitempty" yaml:"licenses,omitempty"`
+
+	// Reason documents why the exception was granted
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
+
+// Parse reads a policy in YAML or JSON format and validates it
+func Parse(data []byte) (*Policy, error) {
+	p := &Policy{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(p); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: decoding policy: %w", ErrInvalidPolicy, err)
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Read parses a policy from a reader
+func Read(r io.Reader) (*Policy, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading policy: %w", err)
+	}
+	return Parse(data)
+}
+
+// ReadFile parses a policy file
+func ReadFile(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading policy file: %w", err)
+	}
+	return Parse(data)
+}
+
+// Validate checks the policy values. All the errors found are returned.
+func (p *Policy) Validate() error {
+	errs := []error{}
+	invalid := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%w: %s", ErrInvalidPolicy, fmt.Sprintf(format, args...)))
+	}
+	checkLicenses := func(field string, list []string) {
+		for _, l := range list {
+			if _, err := license.Parse(l); err != nil {
+				invalid("%s: %s", field, err)
+			}
+		}
+	}
+
+	checkLicenses("allow", p.Allow)
+	checkLicenses("review", p.Review)
+	checkLicenses("deny", p.Deny)
+
+	if p.Default != "" && !p.Default.valid() {
+		invalid("default: unknown verdict %q", p.Default)
+	}
+	if p.Unlicensed != "" && !p.Unlicensed.valid() {
+		invalid("unlicensed: unknown verdict %q", p.Unlicensed)
+	}
+
+	for i, rule := range p.Copyleft {
+		if len(rule.Licenses) == 0 {
+			invalid("copyleft rule #%d has no licenses", i)
+		}
+		checkLicenses(fmt.Sprintf("copyleft rule #%d", i), rule.Licenses)
+		if len(rule.Linking) == 0 {
+			invalid("copyleft rule #%d has no linking kinds", i)
+		}
+		for _, l := range rule.Linking {
+			if l != LinkingStatic && l != LinkingDynamic && l != LinkingAny {
+				invalid("copyleft rule #%d: unknown linking %q", i, l)
+			}
+		}
+		if rule.Verdict != "" && !rule.Verdict.valid() {
+			invalid("copyleft rule #%d: unknown verdict %q", i, rule.Verdict)
+		}
+	}
+
+	for i, e := range p.Exceptions {
+		if _, err := e.Purl.Parse(); err != nil {
+			invalid("exception #%d: %s", i, err)
+		}
+		if e.Versions != "" {
+			if _, err := vers.Parse(e.Versions); err != nil {
+				invalid("exception #%d: %s", i, err)
+			}
+		}
+		checkLicenses(fmt.Sprintf("exception #%d", i), e.Licenses)
+	}
+
+	return errors.Join(errs...)
+}
+
+// defaultVerdict returns the verdict for licenses not in the lists
+func (p *Policy) defaultVerdict() Verdict {
+	if p.Default == "" {
+		return VerdictReview
+	}
+	return p.Default
+}
+
+// unlicensedVerdict returns the verdict for nodes without usable licenses
+func (p *Policy) unlicensedVerdict() Verdict {
+	if p.Unlicensed == "" {
+		return VerdictReview
+	}
+	return p.Unlicensed
+}
+
+// verdict returns the verdict of the copyleft rule
+func (rule *CopyleftRule) verdict() Verdict {
+	if rule.Verdict == "" {
+		return VerdictDenied
+	}
+	return rule.Verdict
+}
+
+// matches returns true if the exception covers the node
+func (e *Exception) matches(n *sbom.Node) bool {
+	purl := n.Purl()
+	if purl == "" {
+		return false
+	}
+	mode := sbom.PurlMatchQualifierSubset
+	if e.Purl.Version() == "" {
+		mode |= sbom.PurlMatchIgnoreVersion
+	}
+	if !e.Purl.Matches(purl, mode) {
+		return false
+	}
+	if e.Versions == "" {
+		return true
+	}
+
+	version := purl.Version()
+	if version == "" {
+		version = n.Version
+	}
+	ok, err := vers.Contains(e.Versions, version)
+	return err == nil && ok
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for name, data := range map[string]string{
+		"yaml": `
+name: release
+allow: [MIT, Apache-2.0]
+review: [MPL-2.0]
+deny:
+  - AGPL-3.0-or-later
+default: deny
+copyleft:
+  - licenses: [LGPL-2.1-only]
+    linking: [static]
+    verdict: review
+exceptions:
+  - purl: pkg:npm/left-pad
+    versions: vers:npm/<2.0.0
+    licenses: [WTFPL]
+    reason: approved
+`,
+		"json": `{
+  "name": "release",
+  "allow": ["MIT", "Apache-2.0"],
+  "review": ["MPL-2.0"],
+  "deny": ["AGPL-3.0-or-later"],
+  "default": "deny",
+  "copyleft": [{"licenses": ["LGPL-2.1-only"], "linking": ["static"], "verdict": "review"}],
+  "exceptions": [{"purl": "pkg:npm/left-pad", "versions": "vers:npm/<2.0.0", "licenses": ["WTFPL"], "reason": "approved"}]
+}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			p, err := Parse([]byte(data))
+			require.NoError(t, err)
+			require.Equal(t, &Policy{
+				Name:    "release",
+				Allow:   []string{"MIT", "Apache-2.0"},
+				Review:  []string{"MPL-2.0"},
+				Deny:    []string{"AGPL-3.0-or-later"},
+				Default: VerdictDenied,
+				Copyleft: []CopyleftRule{
+					{Licenses: []string{"LGPL-2.1-only"}, Linking: []Linking{LinkingStatic}, Verdict: VerdictReview},
+				},
+				Exceptions: []Exception{
+					{Purl: "pkg:npm/left-pad", Versions: "vers:npm/<2.0.0", Licenses: []string{"WTFPL"}, Reason: "approved"},
+				},
+			}, p)
+		})
+	}
+
+	p, err := Read(strings.NewReader(""))
+	require.NoError(t, err)
+	require.Equal(t, &Policy{}, p)
+}
+
+func TestParseErrors(t *testing.T) {
+	for name, data := range map[string]string{
+		"malformed":         "allow: [MIT",
+		"unknown field":     "allowed: [MIT]",
+		"invalid license":   "allow: [MIT AND]",
+		"invalid default":   "default: maybe",
+		"exempt as default": "unlicensed: exempt",
+		"empty rule":        "copyleft: [{linking: [static]}]",
+		"no linking":        "copyleft: [{licenses: [GPL-2.0-only]}]",
+		"invalid linking":   "copyleft: [{licenses: [GPL-2.0-only], linking: [sideways]}]",
+		"invalid purl":      "exceptions: [{purl: left-pad}]",
+		"invalid versions":  "exceptions: [{purl: pkg:npm/left-pad, versions: '<2.0.0'}]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(data))
+			require.Error(t, err)
+			require.ErrorIs(t, err, ErrInvalidPolicy)
+		})
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	_, err := ReadFile("testdata/does-not-exist.yaml")
+	require.Error(t, err)
+}
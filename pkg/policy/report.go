@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Report is the result of evaluating a document against a policy
+type Report struct {
+	// Policy is the name of the evaluated policy
+	Policy string `json:"policy,omitempty"`
+
+	// Verdict is the most severe verdict of all the nodes
+	Verdict Verdict `json:"verdict"`
+
+	// Summary counts the nodes with each verdict
+	Summary map[Verdict]int `json:"summary"`
+
+	// Results has the verdict of each node in document order
+	Results []*Result `json:"results"`
+}
+
+// Result is the verdict of a single node
+type Result struct {
+	NodeID  string `json:"node"`
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+	Purl    string `json:"purl,omitempty"`
+
+	// License is the evaluated license expression
+	License string `json:"license,omitempty"`
+
+	Verdict Verdict  `json:"verdict"`
+	Reasons []string `json:"reasons,omitempty"`
+
+	// Path lists the node IDs from a document root to the node. For
+	// copyleft violations, it is the path that links the component.
+	Path []string `json:"path,omitempty"`
+}
+
+// newReport creates an empty report
+func newReport(name string) *Report {
+	return &Report{
+		Policy:  name,
+		Verdict: VerdictAllowed,
+		Summary: map[Verdict]int{},
+		Results: []*Result{},
+	}
+}
+
+// add records a node result in the report
+func (r *Report) add(res *Result) {
+	r.Results = append(r.Results, res)
+	r.Summary[res.Verdict]++
+	if res.Verdict.Worse(r.Verdict) {
+		r.Verdict = res.Verdict
+	}
+}
+
+// Passed returns true if no node was denied by the policy
+func (r *Report) Passed() bool {
+	return r.Verdict != VerdictDenied
+}
+
+// Violations returns the results of the nodes that were denied or need to
+// be reviewed.
+func (r *Report) Violations() []*Result {
+	ret := []*Result{}
+	for _, res := range r.Results {
+		if res.Verdict.Worse(VerdictExempted) {
+			ret = append(ret, res)
+		}
+	}
+	return ret
+}
+
+// Write renders the report as JSON
+func (r *Report) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(r); err != nil {
+		return fmt.Errorf("encoding report: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quality
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/protobom/protobom/pkg/license"
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// maxListedNodes limits the node IDs listed in explanations
+const maxListedNodes = 5
+
+// weakHashes are the algorithms considered broken or not cryptographic
+var weakHashes = map[sbom.HashAlgorithm]struct{}{
+	sbom.HashAlgorithm_UNKNOWN: {},
+	sbom.HashAlgorithm_ADLER32: {},
+	sbom.HashAlgorithm_MD2:     {},
+	sbom.HashAlgorithm_MD4:     {},
+	sbom.HashAlgorithm_MD5:     {},
+	sbom.HashAlgorithm_SHA1:    {},
+}
+
+// scoreContext holds the document data used by the category scorers
+type scoreContext struct {
+	doc      *sbom.Document
+	packages []*sbom.Node
+}
+
+// newScoreContext collects the package nodes of the document
+func newScoreContext(doc *sbom.Document) *scoreContext {
+	ctx := &scoreContext{doc: doc, packages: []*sbom.Node{}}
+	for _, n := range doc.GetNodeList().GetNodes() {
+		if n.GetType() == sbom.Node_PACKAGE {
+			ctx.packages = append(ctx.packages, n)
+		}
+	}
+	return ctx
+}
+
+// scorePackages rates each package with a value from 0 to 1 and returns the
+// average as a category score. Documents without packages score zero.
+func (ctx *scoreContext) scorePackages(rate func(*sbom.Node) float64) *CategoryScore {
+	cs := &CategoryScore{Explanations: []string{}}
+	if len(ctx.packages) == 0 {
+		cs.Explanations = append(cs.Explanations, "the document has no packages")
+		return cs
+	}
+	sum := 0.0
+	for _, n := range ctx.packages {
+		sum += rate(n)
+	}
+	cs.Score = MaxScore * sum / float64(len(ctx.packages))
+	return cs
+}
+
+// explain adds an explanation with the number of packages that meet a
+// condition, if any.
+func (ctx *scoreContext) explain(cs *CategoryScore, count int, what string) {
+	if count == 0 {
+		return
+	}
+	cs.Explanations = append(cs.Explanations, fmt.Sprintf("%d of %d packages %s", count, len(ctx.packages), what))
+}
+
+// scoreIdentifiers rates packages with a valid purl or CPE with 1 and those
+// with only other identifiers or invalid ones with 0.5.
+func scoreIdentifiers(ctx *scoreContext) *CategoryScore {
+	var purls, cpes, other, none int
+	cs := ctx.scorePackages(func(n *sbom.Node) float64 {
+		hasPurl := n.Purl() != "" && n.Purl().Valid()
+		hasCPE := false
+		hasOther := false
+		for t, v := range n.GetIdentifiers() {
+			if v == "" {
+				continue
+			}
+			switch sbom.SoftwareIdentifierType(t) {
+			case sbom.SoftwareIdentifierType_CPE22, sbom.SoftwareIdentifierType_CPE23:
+				if _, err := sbom.ParseCPE(v); err == nil {
+					hasCPE = true
+					continue
+				}
+			case sbom.SoftwareIdentifierType_PURL:
+				if hasPurl {
+					continue
+				}
+			}
+			hasOther = true
+		}
+
+		if hasPurl {
+			purls++
+		}
+		if hasCPE {
+			cpes++
+		}
+		switch {
+		case hasPurl || hasCPE:
+			return 1
+		case hasOther:
+			other++
+			return 0.5
+		default:
+			none++
+			return 0
+		}
+	})
+	ctx.explain(cs, purls, "have a valid purl")
+	ctx.explain(cs, cpes, "have a valid CPE")
+	ctx.explain(cs, other, "only have other or invalid identifiers")
+	ctx.explain(cs, none, "have no identifiers")
+	return cs
+}
+
+// scoreHashes rates packages with a strong hash with 1 and those with only
+// weak hashes (MD5, SHA-1 and others) with 0.5.
+func scoreHashes(ctx *scoreContext) *CategoryScore {
+	var strong, weak, none int
+	cs := ctx.scorePackages(func(n *sbom.Node) float64 {
+		hasWeak := false
+		for algo, v := range n.GetHashes() {
+			if v == "" {
+				continue
+			}
+			if _, ok := weakHashes[sbom.HashAlgorithm(algo)]; !ok {
+				strong++
+				return 1
+			}
+			hasWeak = true
+		}
+		if hasWeak {
+			weak++
+			return 0.5
+		}
+		none++
+		return 0
+	})
+	ctx.explain(cs, strong, "have strong hashes")
+	ctx.explain(cs, weak, "only have weak hashes (MD5, SHA-1 or weaker)")
+	ctx.explain(cs, none, "have no hashes")
+	return cs
+}
+
+// scoreLicenses rates packages whose licenses are valid SPDX expressions
+// with 1 and those with licenses that cannot be parsed or reference unknown
+// identifiers with 0.5.
+func scoreLicenses(ctx *scoreContext) *CategoryScore {
+	var valid, invalid, none int
+	cs := ctx.scorePackages(func(n *sbom.Node) float64 {
+		exprs := []string{}
+		if c := n.GetLicenseConcluded(); c != "" && !strings.EqualFold(c, license.NoAssertion) {
+			exprs = append(exprs, c)
+		}
+		for _, l := range n.GetLicenses() {
+			if l != "" && !strings.EqualFold(l, license.NoAssertion) {
+				exprs = append(exprs, l)
+			}
+		}
+		if len(exprs) == 0 {
+			none++
+			return 0
+		}
+		for _, s := range exprs {
+			e, err := license.Parse(s)
+			if err != nil || e.Validate() != nil {
+				invalid++
+				return 0.5
+			}
+		}
+		valid++
+		return 1
+	})
+	ctx.explain(cs, valid, "have valid SPDX license expressions")
+	ctx.explain(cs, invalid, "have invalid licenses or unknown license identifiers")
+	ctx.explain(cs, none, "have no license information")
+	return cs
+}
+
+// scoreSuppliers rates packages with a named supplier with 0.5 and adds
+// another 0.5 if the supplier has an email address or URL.
+func scoreSuppliers(ctx *scoreContext) *CategoryScore {
+	var complete, nameOnly, none int
+	cs := ctx.scorePackages(func(n *sbom.Node) float64 {
+		score := 0.0
+		for _, s := range n.GetSuppliers() {
+			if s.GetName() == "" {
+				continue
+			}
+			if s.GetEmail() != "" || s.GetUrl() != "" {
+				score = 1
+				break
+			}
+			score = 0.5
+		}
+		switch score {
+		case 1:
+			complete++
+		case 0.5:
+			nameOnly++
+		default:
+			none++
+		}
+		return score
+	})
+	ctx.explain(cs, complete, "have a supplier with contact data")
+	ctx.explain(cs, nameOnly, "have a supplier without email or URL")
+	ctx.explain(cs, none, "have no named supplier")
+	return cs
+}
+
+// scoreDependencies rates the packages reachable from the document root
+// elements. Packages not in any edge or not reachable from the roots would
+// be reconnected to the top of the graph when the NodeList is processed.
+func scoreDependencies(ctx *scoreContext) *CategoryScore {
+	nl := ctx.doc.GetNodeList()
+	related := map[string]struct{}{}
+	outgoing := map[string][]string{}
+	for _, e := range nl.GetEdges() {
+		for _, to := range e.GetTo() {
+			related[e.GetFrom()] = struct{}{}
+			related[to] = struct{}{}
+			outgoing[e.GetFrom()] = append(outgoing[e.GetFrom()], to)
+		}
+	}
+
+	reachable := map[string]struct{}{}
+	queue := append([]string{}, nl.GetRootElements()...)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if _, ok := reachable[id]; ok {
+			continue
+		}
+		reachable[id] = struct{}{}
+		queue = append(queue, outgoing[id]...)
+	}
+
+	unrelated := []string{}
+	unreachable := []string{}
+	cs := ctx.scorePackages(func(n *sbom.Node) float64 {
+		_, isRelated := related[n.GetId()]
+		if !isRelated && len(ctx.packages) > 1 {
+			unrelated = append(unrelated, n.GetId())
+		}
+		if _, ok := reachable[n.GetId()]; !ok {
+			unreachable = append(unreachable, n.GetId())
+			return 0
+		}
+		return 1
+	})
+
+	if len(nl.GetRootElements()) == 0 {
+		cs.Explanations = append(cs.Explanations, "the document declares no root elements")
+	}
+	ctx.explain(cs, len(ctx.packages)-len(unreachable), "are reachable from the root elements")
+	ctx.explain(cs, len(unrelated), "are not related to any other node: "+listNodes(unrelated))
+	ctx.explain(cs, len(unreachable), "are not reachable from the root elements: "+listNodes(unreachable))
+	return cs
+}
+
+// scoreMetadata rates the document metadata fields
+func scoreMetadata(ctx *scoreContext) *CategoryScore {
+	md := ctx.doc.GetMetadata()
+	date := md.GetDate()
+	checks := []struct {
+		field string
+		ok    bool
+	}{
+		{"Metadata.Id", md.GetId() != ""},
+		{"Metadata.Name", md.GetName() != ""},
+		{"Metadata.Date", date != nil && date.IsValid() && date.AsTime().Unix() > 0},
+		{"Metadata.Authors", len(md.GetAuthors()) > 0},
+		{"Metadata.Tools", len(md.GetTools()) > 0},
+		{"Metadata.DocumentTypes", len(md.GetDocumentTypes()) > 0},
+	}
+
+	cs := &CategoryScore{Explanations: []string{}}
+	missing := []string{}
+	for _, c := range checks {
+		if !c.ok {
+			missing = append(missing, c.field)
+		}
+	}
+	cs.Score = MaxScore * float64(len(checks)-len(missing)) / float64(len(checks))
+	if len(missing) == 0 {
+		cs.Explanations = append(cs.Explanations, "all the metadata fields are set")
+	} else {
+		cs.Explanations = append(cs.Explanations, "missing "+strings.Join(missing, ", "))
+	}
+	return cs
+}
+
+// listNodes returns the first node IDs of the list for explanations
+func listNodes(ids []string) string {
+	if len(ids) <= maxListedNodes {
+		return strings.Join(ids, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(ids[:maxListedNodes], ", "), len(ids)-maxListedNodes)
+}
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package quality rates how complete and useful the data in an SBOM is. The
+// document is scored from 0 to 10 in several categories (identifiers,
+// hashes, licenses, suppliers, dependencies and metadata) which are then
+// combined in a single weighted score. Each category score comes with the
+// explanations of what lowered it.
+package quality
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// MaxScore is the score of a perfect SBOM
+const MaxScore = 10.0
+
+// Category is an aspect of the SBOM rated by the scorer
+type Category string
+
+const (
+	// CategoryIdentifiers rates the packages with purls and CPEs
+	CategoryIdentifiers Category = "identifiers"
+
+	// CategoryHashes rates the packages with hashes and their strength
+	CategoryHashes Category = "hashes"
+
+	// CategoryLicenses rates the packages with valid license expressions
+	CategoryLicenses Category = "licenses"
+
+	// CategorySuppliers rates the packages with supplier data
+	CategorySuppliers Category = "suppliers"
+
+	// CategoryDependencies rates the completeness of the dependency graph
+	CategoryDependencies Category = "dependencies"
+
+	// CategoryMetadata rates the document metadata
+	CategoryMetadata Category = "metadata"
+)
+
+// Categories lists all the categories in the order they are reported
+var Categories = []Category{
+	CategoryIdentifiers, CategoryHashes, CategoryLicenses,
+	CategorySuppliers, CategoryDependencies, CategoryMetadata,
+}
+
+// DefaultWeights are the weights of the categories when computing the
+// overall score.
+var DefaultWeights = map[Category]float64{
+	CategoryIdentifiers:  20,
+	CategoryHashes:       15,
+	CategoryLicenses:     20,
+	CategorySuppliers:    15,
+	CategoryDependencies: 15,
+	CategoryMetadata:     15,
+}
+
+// Options control how the score is computed
+type Options struct {
+	// Weights of the categories. Categories missing from the map or with a
+	// weight of zero are not rated.
+	Weights map[Category]float64
+}
+
+// Option modifies the scoring options
+type Option func(*Options)
+
+// WithWeights replaces the default category weights
+func WithWeights(weights map[Category]float64) Option {
+	return func(o *Options) {
+		o.Weights = weights
+	}
+}
+
+// CategoryScore is the rating of a single category
+type CategoryScore struct {
+	Category Category `json:"category"`
+
+	// Score goes from 0 to MaxScore
+	Score float64 `json:"score"`
+
+	// Weight of the category in the overall score
+	Weight float64 `json:"weight"`
+
+	// Explanations describe the data found and what lowered the score
+	Explanations []string `json:"explanations"`
+}
+
+// Report is the quality score of a document
+type Report struct {
+	// Score is the weighted average of the category scores, from 0 to
+	// MaxScore.
+	Score float64 `json:"score"`
+
+	// Grade summarizes the score with a letter from A to F
+	Grade string `json:"grade"`
+
+	// Packages is the number of package nodes rated
+	Packages int `json:"packages"`
+
+	// Categories has the score of each category
+	Categories []*CategoryScore `json:"categories"`
+}
+
+// Category returns the score of a category or nil if it was not rated
+func (r *Report) Category(c Category) *CategoryScore {
+	for _, cs := range r.Categories {
+		if cs.Category == c {
+			return cs
+		}
+	}
+	return nil
+}
+
+// Write renders the report as JSON
+func (r *Report) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(r); err != nil {
+		return fmt.Errorf("encoding report: %w", err)
+	}
+	return nil
+}
+
+// scorers compute the score of each category
+var scorers = map[Category]func(*scoreContext) *CategoryScore{
+	CategoryIdentifiers:  scoreIdentifiers,
+	CategoryHashes:       scoreHashes,
+	CategoryLicenses:     scoreLicenses,
+	CategorySuppliers:    scoreSuppliers,
+	CategoryDependencies: scoreDependencies,
+	CategoryMetadata:     scoreMetadata,
+}
+
+// Score rates the quality of the document. Package nodes are rated in all
+// categories except metadata, file nodes are ignored.
+func Score(doc *sbom.Document, opts ...Option) (*Report, error) {
+	if doc == nil {
+		return nil, errors.New("document is nil")
+	}
+
+	options := &Options{Weights: DefaultWeights}
+	for _, o := range opts {
+		o(options)
+	}
+
+	total := 0.0
+	for c, w := range options.Weights {
+		if _, ok := scorers[c]; !ok {
+			return nil, fmt.Errorf("unknown quality category %q", c)
+		}
+		if w < 0 {
+			return nil, fmt.Errorf("weight of category %q cannot be negative", c)
+		}
+		total += w
+	}
+	if total == 0 {
+		return nil, errors.New("at least one category must have a weight")
+	}
+
+	ctx := newScoreContext(doc)
+	report := &Report{
+		Packages:   len(ctx.packages),
+		Categories: []*CategoryScore{},
+	}
+	for _, c := range Categories {
+		w := options.Weights[c]
+		if w == 0 {
+			continue
+		}
+		cs := scorers[c](ctx)
+		cs.Category = c
+		cs.Weight = w
+		report.Categories = append(report.Categories, cs)
+		report.Score += cs.Score * w / total
+	}
+	report.Grade = grade(report.Score)
+	return report, nil
+}
+
+// grade converts a score to a letter
+func grade(score float64) string {
+	switch {
+	case score >= 9:
+		return "A"
+	case score >= 8:
+		return "B"
+	case score >= 7:
+		return "C"
+	case score >= 5:
+		return "D"
+	default:
+		return "F"
+	}
+}
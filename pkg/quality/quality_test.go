@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quality
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// perfectDocument returns a document with full marks in all categories
+func perfectDocument() *sbom.Document {
+	nodes := []*sbom.Node{}
+	for _, name := range []string{"app", "lib1", "lib2", "lib3"} {
+		nodes = append(nodes, &sbom.Node{
+			Id: name, Name: name, Version: "1.0.0",
+			Identifiers: map[int32]string{
+				int32(sbom.SoftwareIdentifierType_PURL): fmt.Sprintf("pkg:generic/%s@1.0.0", name),
+			},
+			Hashes:           map[int32]string{int32(sbom.HashAlgorithm_SHA256): "abc"},
+			LicenseConcluded: "MIT",
+			Licenses:         []string{"MIT OR Apache-2.0"},
+			Suppliers:        []*sbom.Person{{Name: "ACME", Email: "acme@example.com"}},
+		})
+	}
+	return &sbom.Document{
+		Metadata: &sbom.Metadata{
+			Id:            "urn:uuid:1234",
+			Name:          "app",
+			Date:          timestamppb.New(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)),
+			Authors:       []*sbom.Person{{Name: "ACME"}},
+			Tools:         []*sbom.Tool{{Name: "protobom"}},
+			DocumentTypes: []*sbom.DocumentType{{Type: sbom.DocumentType_BUILD.Enum()}},
+		},
+		NodeList: &sbom.NodeList{
+			Nodes: append(nodes, &sbom.Node{Id: "file", Type: sbom.Node_FILE, Name: "README"}),
+			Edges: []*sbom.Edge{
+				{Type: sbom.Edge_dependsOn, From: "app", To: []string{"lib1", "lib2"}},
+				{Type: sbom.Edge_dependsOn, From: "lib2", To: []string{"lib3"}},
+			},
+			RootElements: []string{"app"},
+		},
+	}
+}
+
+func TestScore(t *testing.T) {
+	for name, tc := range map[string]struct {
+		prepare func(*sbom.Document)
+		scores  map[Category]float64
+		expl    map[Category][]string
+	}{
+		"perfect": {
+			prepare: func(*sbom.Document) {},
+			scores: map[Category]float64{
+				CategoryIdentifiers: 10, CategoryHashes: 10, CategoryLicenses: 10,
+				CategorySuppliers: 10, CategoryDependencies: 10, CategoryMetadata: 10,
+			},
+			expl: map[Category][]string{
+				CategoryIdentifiers:  {"4 of 4 packages have a valid purl"},
+				CategoryDependencies: {"4 of 4 packages are reachable from the root elements"},
+				CategoryMetadata:     {"all the metadata fields are set"},
+			},
+		},
+		"identifiers": {
+			prepare: func(doc *sbom.Document) {
+				nodes := doc.NodeList.Nodes
+				nodes[0].Identifiers = map[int32]string{int32(sbom.SoftwareIdentifierType_CPE23): "cpe:2.3:a:acme:app:1.0.0:*:*:*:*:*:*:*"}
+				nodes[1].Identifiers = map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "not a purl"}
+				nodes[2].Identifiers = map[int32]string{int32(sbom.SoftwareIdentifierType_GITOID): "gitoid:blob:sha1:abc"}
+				nodes[3].Identifiers = nil
+			},
+			scores: map[Category]float64{CategoryIdentifiers: 5},
+			expl: map[Category][]string{CategoryIdentifiers: {
+				"1 of 4 packages have a valid CPE",
+				"2 of 4 packages only have other or invalid identifiers",
+				"1 of 4 packages have no identifiers",
+			}},
+		},
+		"hashes": {
+			prepare: func(doc *sbom.Document) {
+				nodes := doc.NodeList.Nodes
+				nodes[0].Hashes = map[int32]string{int32(sbom.HashAlgorithm_MD5): "abc", int32(sbom.HashAlgorithm_BLAKE3): "def"}
+				nodes[1].Hashes = map[int32]string{int32(sbom.HashAlgorithm_SHA1): "abc"}
+				nodes[2].Hashes = map[int32]string{int32(sbom.HashAlgorithm_SHA512): ""}
+			},
+			scores: map[Category]float64{CategoryHashes: 6.25},
+			expl: map[Category][]string{CategoryHashes: {
+				"2 of 4 packages have strong hashes",
+				"1 of 4 packages only have weak hashes (MD5, SHA-1 or weaker)",
+				"1 of 4 packages have no hashes",
+			}},
+		},
+		"licenses": {
+			prepare: func(doc *sbom.Document) {
+				nodes := doc.NodeList.Nodes
+				nodes[0].Licenses = []string{"Apache License"}
+				nodes[1].LicenseConcluded = "NOASSERTION"
+				nodes[1].Licenses = []string{"Foo-1.0"}
+				nodes[2].LicenseConcluded = "NOASSERTION"
+				nodes[2].Licenses = nil
+			},
+			scores: map[Category]float64{CategoryLicenses: 5},
+			expl: map[Category][]string{CategoryLicenses: {
+				"1 of 4 packages have valid SPDX license expressions",
+				"2 of 4 packages have invalid licenses or unknown license identifiers",
+				"1 of 4 packages have no license information",
+			}},
+		},
+		"suppliers": {
+			prepare: func(doc *sbom.Document) {
+				nodes := doc.NodeList.Nodes
+				nodes[0].Suppliers = []*sbom.Person{{Name: "ACME"}}
+				nodes[1].Suppliers = []*sbom.Person{{Email: "anon@example.com"}}
+				nodes[2].Suppliers = []*sbom.Person{{Name: "ACME"}, {Name: "ACME Corp", Url: "https://acme.example.com"}}
+			},
+			scores: map[Category]float64{CategorySuppliers: 6.25},
+			expl: map[Category][]string{CategorySuppliers: {
+				"2 of 4 packages have a supplier with contact data",
+				"1 of 4 packages have a supplier without email or URL",
+				"1 of 4 packages have no named supplier",
+			}},
+		},
+		"dependencies": {
+			prepare: func(doc *sbom.Document) {
+				doc.NodeList.Edges = doc.NodeList.Edges[:1]
+			},
+			scores: map[Category]float64{CategoryDependencies: 7.5},
+			expl: map[Category][]string{CategoryDependencies: {
+				"3 of 4 packages are reachable from the root elements",
+				"1 of 4 packages are not related to any other node: lib3",
+				"1 of 4 packages are not reachable from the root elements: lib3",
+			}},
+		},
+		"no roots": {
+			prepare: func(doc *sbom.Document) {
+				doc.NodeList.RootElements = nil
+			},
+			scores: map[Category]float64{CategoryDependencies: 0},
+			expl: map[Category][]string{CategoryDependencies: {
+				"the document declares no root elements",
+				"4 of 4 packages are not reachable from the root elements: app, lib1, lib2, lib3",
+			}},
+		},
+		"metadata": {
+			prepare: func(doc *sbom.Document) {
+				doc.Metadata.Tools = nil
+				doc.Metadata.Date = nil
+				doc.Metadata.Id = ""
+			},
+			scores: map[Category]float64{CategoryMetadata: 5},
+			expl:   map[Category][]string{CategoryMetadata: {"missing Metadata.Id, Metadata.Date, Metadata.Tools"}},
+		},
+		"empty": {
+			prepare: func(doc *sbom.Document) {
+				doc.Metadata = nil
+				doc.NodeList = nil
+			},
+			scores: map[Category]float64{
+				CategoryIdentifiers: 0, CategoryHashes: 0, CategoryLicenses: 0,
+				CategorySuppliers: 0, CategoryDependencies: 0, CategoryMetadata: 0,
+			},
+			expl: map[Category][]string{CategoryHashes: {"the document has no packages"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			doc := perfectDocument()
+			tc.prepare(doc)
+			report, err := Score(doc)
+			require.NoError(t, err)
+			require.Len(t, report.Categories, len(Categories))
+
+			expected := 0.0
+			for _, c := range Categories {
+				cs := report.Category(c)
+				require.NotNil(t, cs)
+				require.Equal(t, DefaultWeights[c], cs.Weight)
+				require.NotEmpty(t, cs.Explanations)
+				score, ok := tc.scores[c]
+				if !ok {
+					score = MaxScore
+				}
+				require.InDelta(t, score, cs.Score, 0.001, c)
+				if expl, ok := tc.expl[c]; ok {
+					require.Equal(t, expl, cs.Explanations, c)
+				}
+				expected += score * DefaultWeights[c] / 100
+			}
+			require.InDelta(t, expected, report.Score, 0.001)
+			require.Equal(t, grade(report.Score), report.Grade)
+		})
+	}
+}
+
+func TestScoreWeights(t *testing.T) {
+	doc := perfectDocument()
+	doc.Metadata = nil
+
+	report, err := Score(doc, WithWeights(map[Category]float64{CategoryHashes: 1, CategoryMetadata: 3}))
+	require.NoError(t, err)
+	require.Len(t, report.Categories, 2)
+	require.Nil(t, report.Category(CategoryLicenses))
+	require.InDelta(t, 2.5, report.Score, 0.001)
+	require.Equal(t, "F", report.Grade)
+	require.Equal(t, 4, report.Packages)
+
+	for _, weights := range []map[Category]float64{
+		{},
+		{CategoryHashes: 0},
+		{CategoryHashes: -1, CategoryMetadata: 2},
+		{Category("vibes"): 1},
+	} {
+		_, err := Score(doc, WithWeights(weights))
+		require.Error(t, err)
+	}
+
+	_, err = Score(nil)
+	require.Error(t, err)
+}
+
+func TestGrade(t *testing.T) {
+	for score, expected := range map[float64]string{
+		10: "A", 9: "A", 8.5: "B", 7: "C", 6.9: "D", 5: "D", 4.99: "F", 0: "F",
+	} {
+		require.Equal(t, expected, grade(score), score)
+	}
+}
+
+func TestListNodes(t *testing.T) {
+	require.Equal(t, "a, b", listNodes([]string{"a", "b"}))
+	require.Equal(t, "a, b, c, d, e and 2 more", listNodes([]string{"a", "b", "c", "d", "e", "f", "g"}))
+}
+
+func TestReportWrite(t *testing.T) {
+	report, err := Score(perfectDocument())
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.Write(&buf))
+
+	parsed := &Report{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), parsed))
+	require.Equal(t, report, parsed)
+}
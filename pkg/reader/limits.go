@@ -0,0 +1,77 @@
+package reader
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/protobom/protobom/pkg/native"
+)
+
+// limitedReadSeeker wraps an io.ReadSeeker and fails any read that goes past
+// the configured number of bytes from where the stream started.
+type limitedReadSeeker struct {
+	rs    io.ReadSeeker
+	base  int64
+	pos   int64
+	limit int64
+}
+
+// newLimitedReadSeeker wraps rs to read at most limit bytes. If the size of
+// the stream can be determined upfront and is already over the limit, it
+// returns an *native.InputSizeLimitError without reading any data.
+func newLimitedReadSeeker(rs io.ReadSeeker, limit int64) (*limitedReadSeeker, error) {
+	base, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, fmt.Errorf("getting stream position: %w", err)
+	}
+
+	end, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("getting stream size: %w", err)
+	}
+
+	if _, err := rs.Seek(base, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("rewinding stream: %w", err)
+	}
+
+	if end-base > limit {
+		return nil, &native.InputSizeLimitError{Limit: limit}
+	}
+
+	return &limitedReadSeeker{rs: rs, base: base, limit: limit}, nil
+}
+
+// Read reads from the underlying stream, returning an error as soon as the
+// data read goes over the limit.
+func (l *limitedReadSeeker) Read(p []byte) (int, error) {
+	if l.pos > l.limit {
+		return 0, &native.InputSizeLimitError{Limit: l.limit}
+	}
+
+	// Allow reading one byte past the limit to detect streams that
+	// are larger than allowed.
+	if remaining := l.limit - l.pos + 1; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+
+	n, err := l.rs.Read(p)
+	l.pos += int64(n)
+	if l.pos > l.limit {
+		return n, &native.InputSizeLimitError{Limit: l.limit}
+	}
+	return n, err
+}
+
+// Seek moves the underlying stream, offsets are relative to the position
+// the stream had when it was wrapped.
+func (l *limitedReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	if whence == io.SeekStart {
+		offset += l.base
+	}
+	abs, err := l.rs.Seek(offset, whence)
+	if err != nil {
+		return 0, err
+	}
+	l.pos = abs - l.base
+	return l.pos, nil
+}
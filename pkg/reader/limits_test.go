@@ -0,0 +1,64 @@
+package reader
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/protobom/protobom/pkg/native"
+)
+
+// unsizedReadSeeker hides the size of the stream, simulating inputs whose
+// length is not known in advance.
+type unsizedReadSeeker struct {
+	*strings.Reader
+}
+
+func (u *unsizedReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	if whence == io.SeekEnd {
+		return u.Reader.Seek(0, io.SeekCurrent)
+	}
+	return u.Reader.Seek(offset, whence)
+}
+
+func TestLimitedReadSeeker(t *testing.T) {
+	data := "0123456789"
+	for _, tc := range []struct {
+		name      string
+		rs        io.ReadSeeker
+		limit     int64
+		mustError bool
+	}{
+		{"under limit", strings.NewReader(data), 20, false},
+		{"at limit", strings.NewReader(data), 10, false},
+		{"over limit", strings.NewReader(data), 9, true},
+		{"unsized under limit", &unsizedReadSeeker{strings.NewReader(data)}, 10, false},
+		{"unsized over limit", &unsizedReadSeeker{strings.NewReader(data)}, 5, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var target *native.InputSizeLimitError
+			lrs, err := newLimitedReadSeeker(tc.rs, tc.limit)
+			if err == nil {
+				var res []byte
+				res, err = io.ReadAll(lrs)
+				if err == nil {
+					require.Equal(t, data, string(res))
+
+					// Rewinding must allow reading the data again
+					_, err = lrs.Seek(0, io.SeekStart)
+					require.NoError(t, err)
+					res, err = io.ReadAll(lrs)
+					require.NoError(t, err)
+					require.Equal(t, data, string(res))
+				}
+			}
+			if tc.mustError {
+				require.ErrorAs(t, err, &target)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
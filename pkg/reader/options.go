@@ -12,7 +12,21 @@ type Options struct {
 	Format             formats.Format
 	UnserializeOptions *native.UnserializeOptions
 	RetrieveOptions    *storage.RetrieveOptions
-	formatOptions      map[string]interface{}
+
+	// ValidateSchema makes the reader check the input against the bundled
+	// JSON schema of its format before unserializing it.
+	ValidateSchema bool
+
+	// ValidateGraph makes the reader check the integrity of the parsed
+	// document graph. Parsing fails if errors are found.
+	ValidateGraph bool
+
+	// Limits caps the resources used when parsing a document. Set them when
+	// reading untrusted input. If nil, no limits are enforced. Only
+	// MaxInputBytes is checked before the input is decoded, the node, edge
+	// and depth limits apply to the already decoded document.
+	Limits        *native.Limits
+	formatOptions map[string]interface{}
 }
 
 // argToOptsKeyVal returns a key value to access the options dictionary by using
@@ -84,3 +98,31 @@ func WithRetrieveOptions(ro *storage.RetrieveOptions) ReaderOption {
 		}
 	}
 }
+
+// WithSchemaValidation turns on validation of the input against the official
+// JSON schema of its format before it is unserialized.
+func WithSchemaValidation(validate bool) ReaderOption {
+	return func(r *Reader) {
+		r.Options.ValidateSchema = validate
+	}
+}
+
+// WithLimits sets the resource limits enforced when parsing documents.
+// MaxInputBytes is the only limit that bounds the memory used to decode the
+// input: the node, edge and depth limits are checked once the native document
+// has been decoded, so always set it when reading untrusted input.
+func WithLimits(l *native.Limits) ReaderOption {
+	return func(r *Reader) {
+		if l != nil {
+			r.Options.Limits = l
+		}
+	}
+}
+
+// WithGraphValidation turns on the integrity checks of the document graph
+// after it is parsed.
+func WithGraphValidation(validate bool) ReaderOption {
+	return func(r *Reader) {
+		r.Options.ValidateGraph = validate
+	}
+}
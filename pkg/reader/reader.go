@@ -12,6 +12,7 @@ import (
 	"sync"
 
 	"github.com/protobom/protobom/pkg/formats"
+	"github.com/protobom/protobom/pkg/formats/schema"
 	"github.com/protobom/protobom/pkg/native"
 	drivers "github.com/protobom/protobom/pkg/native/unserializers"
 	"github.com/protobom/protobom/pkg/sbom"
@@ -117,6 +118,14 @@ func (r *Reader) ParseStreamWithOptions(f io.ReadSeeker, o *Options) (*sbom.Docu
 		return nil, fmt.Errorf("options cannot be nil")
 	}
 
+	if o.Limits != nil && o.Limits.MaxInputBytes > 0 {
+		lf, err := newLimitedReadSeeker(f, o.Limits.MaxInputBytes)
+		if err != nil {
+			return nil, fmt.Errorf("checking input size: %w", err)
+		}
+		f = lf
+	}
+
 	format := o.Format
 	if o.Format == "" {
 		f, err := r.detectFormat(f)
@@ -131,13 +140,35 @@ func (r *Reader) ParseStreamWithOptions(f io.ReadSeeker, o *Options) (*sbom.Docu
 		return nil, fmt.Errorf("getting format parser: %w", err)
 	}
 
+	if o.ValidateSchema {
+		if err := validateSchema(f, format); err != nil {
+			return nil, err
+		}
+	}
+
+	uo := o.UnserializeOptions
+	if o.Limits != nil {
+		// Copy the options to avoid modifying the caller's (or the default) set
+		uo = &native.UnserializeOptions{}
+		if o.UnserializeOptions != nil {
+			*uo = *o.UnserializeOptions
+		}
+		uo.Limits = o.Limits
+	}
+
 	doc, err := unserializer.Unserialize(
-		f, o.UnserializeOptions, r.Options.GetFormatOptions(unserializer),
+		f, uo, r.Options.GetFormatOptions(unserializer),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unserializing: %w", err)
 	}
 
+	if o.ValidateGraph {
+		if err := doc.Validate().Err(); err != nil {
+			return nil, fmt.Errorf("validating document graph: %w", err)
+		}
+	}
+
 	return doc, err
 }
 
@@ -146,6 +177,24 @@ func (r *Reader) ParseStream(f io.ReadSeeker) (*sbom.Document, error) {
 	return r.ParseStreamWithOptions(f, r.Options)
 }
 
+// validateSchema checks the document in rs against the schema of format and
+// rewinds the stream to where it was so it can be unserialized.
+func validateSchema(rs io.ReadSeeker, format formats.Format) error {
+	pos, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("getting stream position: %w", err)
+	}
+
+	if err := schema.Validate(format, rs); err != nil {
+		return fmt.Errorf("validating document schema: %w", err)
+	}
+
+	if _, err := rs.Seek(pos, io.SeekStart); err != nil {
+		return fmt.Errorf("rewinding stream: %w", err)
+	}
+	return nil
+}
+
 func (r *Reader) detectFormat(rs io.ReadSeeker) (formats.Format, error) {
 	format, err := r.sniffer.SniffReader(rs)
 	if err != nil {
@@ -9,8 +9,10 @@ import (
 	"testing"
 
 	"github.com/protobom/protobom/pkg/formats"
+	"github.com/protobom/protobom/pkg/formats/schema"
 	"github.com/protobom/protobom/pkg/native"
 	"github.com/protobom/protobom/pkg/native/nativefakes"
+	"github.com/protobom/protobom/pkg/native/unserializers"
 	"github.com/protobom/protobom/pkg/reader"
 	"github.com/protobom/protobom/pkg/reader/readerfakes"
 	"github.com/protobom/protobom/pkg/sbom"
@@ -462,3 +464,150 @@ func TestRetrieve(t *testing.T) {
 		})
 	}
 }
+
+func TestReader_SchemaValidation(t *testing.T) {
+	reader.RegisterUnserializer(formats.CDX15JSON, unserializers.NewCDX("1.5", formats.JSON))
+	for _, tc := range []struct {
+		name      string
+		data      string
+		validate  bool
+		mustError bool
+	}{
+		{
+			name:     "valid document",
+			data:     `{"bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1, "metadata": {"component": {"bom-ref": "root", "type": "application", "name": "test"}}}`,
+			validate: true,
+		},
+		{
+			name:      "invalid document",
+			data:      `{"bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1, "components": [{"type": "spaceship", "name": "test"}]}`,
+			validate:  true,
+			mustError: true,
+		},
+		{
+			name: "invalid document not validated",
+			data: `{"bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1, "components": [{"type": "spaceship", "name": "test"}]}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rdr := reader.New()
+			opts := &reader.Options{
+				Format:             formats.CDX15JSON,
+				UnserializeOptions: &native.UnserializeOptions{},
+				ValidateSchema:     tc.validate,
+			}
+			doc, err := rdr.ParseStreamWithOptions(bytes.NewReader([]byte(tc.data)), opts)
+			if tc.mustError {
+				require.Error(t, err)
+				verr := &schema.ValidationError{}
+				require.True(t, errors.As(err, &verr))
+				require.NotEmpty(t, verr.Violations)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, doc)
+		})
+	}
+}
+
+func TestReader_Limits(t *testing.T) {
+	reader.RegisterUnserializer(formats.CDX15JSON, unserializers.NewCDX("1.5", formats.JSON))
+	data := `{"bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1, "metadata": {"component": {"bom-ref": "root", "type": "application", "name": "root"}}, "components": [{"type": "library", "name": "a"}, {"type": "library", "name": "b"}]}`
+	for _, tc := range []struct {
+		name   string
+		limits *native.Limits
+		format formats.Format
+		target interface{}
+	}{
+		{
+			name:   "within limits",
+			limits: &native.Limits{MaxInputBytes: int64(len(data)), MaxNodes: 3},
+		},
+		{
+			name:   "input too large",
+			limits: &native.Limits{MaxInputBytes: 10},
+			target: new(*native.InputSizeLimitError),
+		},
+		{
+			name:   "input too large with known format",
+			limits: &native.Limits{MaxInputBytes: 10},
+			format: formats.CDX15JSON,
+			target: new(*native.InputSizeLimitError),
+		},
+		{
+			name:   "too many nodes",
+			limits: &native.Limits{MaxNodes: 2},
+			target: new(*native.NodeLimitError),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			uo := &native.UnserializeOptions{}
+			rdr := reader.New()
+			doc, err := rdr.ParseStreamWithOptions(
+				bytes.NewReader([]byte(data)),
+				&reader.Options{Format: tc.format, UnserializeOptions: uo, Limits: tc.limits},
+			)
+			require.Nil(t, uo.Limits, "reader must not modify the unserialize options")
+			if tc.target != nil {
+				require.ErrorAs(t, err, tc.target)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, doc.NodeList.Nodes, 3)
+		})
+	}
+}
+
+func TestReader_GraphValidation(t *testing.T) {
+	fake := &nativefakes.FakeUnserializer{}
+	reader.RegisterUnserializer(formats.SPDX23JSON, fake)
+	for _, tc := range []struct {
+		name      string
+		doc       *sbom.Document
+		validate  bool
+		mustError bool
+	}{
+		{
+			name: "valid graph",
+			doc: &sbom.Document{
+				Metadata: &sbom.Metadata{Id: "doc"},
+				NodeList: &sbom.NodeList{Nodes: []*sbom.Node{{Id: "node1"}}, RootElements: []string{"node1"}},
+			},
+			validate: true,
+		},
+		{
+			name: "broken graph",
+			doc: &sbom.Document{
+				Metadata: &sbom.Metadata{Id: "doc"},
+				NodeList: &sbom.NodeList{Nodes: []*sbom.Node{{Id: "node1"}}, RootElements: []string{"node2"}},
+			},
+			validate:  true,
+			mustError: true,
+		},
+		{
+			name: "broken graph not validated",
+			doc: &sbom.Document{
+				Metadata: &sbom.Metadata{Id: "doc"},
+				NodeList: &sbom.NodeList{Nodes: []*sbom.Node{{Id: "node1"}}, RootElements: []string{"node2"}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fake.UnserializeReturns(tc.doc, nil)
+			rdr := reader.New()
+			doc, err := rdr.ParseStreamWithOptions(&fakeReadSeeker{}, &reader.Options{
+				Format:             formats.SPDX23JSON,
+				UnserializeOptions: &native.UnserializeOptions{},
+				ValidateGraph:      tc.validate,
+			})
+			if tc.mustError {
+				ierr := &sbom.IntegrityError{}
+				require.ErrorAs(t, err, &ierr)
+				require.Equal(t, sbom.IssueMissingRootElement, ierr.Issues[0].Code)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.doc, doc)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package sbom
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// DocumentBuilder wraps a Document to make it safe to modify and read from
+// several goroutines. All changes to the document are serialized through
+// the builder and readers get consistent views of the SBOM graph.
+//
+// Nodes and edges handed to the builder become part of the document and
+// must not be modified by the caller afterwards. Data returned by the
+// builder is always a copy, so it can be used freely.
+//
+// The builder keeps an index of the document's NodeList to avoid scanning
+// it on every lookup, the index is rebuilt after each call to Update.
+type DocumentBuilder struct {
+	mtx   sync.RWMutex
+	doc   *Document
+	index *IndexedNodeList
+}
+
+// NewDocumentBuilder returns a builder that modifies doc. If doc is nil a new
+// empty document is created. Once wrapped, the document should only be
+// accessed through the builder.
+func NewDocumentBuilder(doc *Document) *DocumentBuilder {
+	if doc == nil {
+		doc = NewDocument()
+	}
+	if doc.NodeList == nil {
+		doc.NodeList = NewNodeList()
+	}
+	return &DocumentBuilder{doc: doc, index: NewIndexedNodeList(doc.NodeList)}
+}
+
+// AddNode adds a node to the document
+func (b *DocumentBuilder) AddNode(n *Node) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.index.AddNode(n)
+}
+
+// AddRootNode adds a node to the document and registers it as one of its
+// root elements.
+func (b *DocumentBuilder) AddRootNode(n *Node) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.index.AddRootNode(n)
+}
+
+// AddEdge adds an edge to the document
+func (b *DocumentBuilder) AddEdge(e *Edge) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.index.AddEdge(e)
+}
+
+// RelateNodeAtID adds node n to the document and relates it to the node
+// identified by nodeID with an edge of type edgeType. See
+// NodeList.RelateNodeAtID for details.
+func (b *DocumentBuilder) RelateNodeAtID(n *Node, nodeID string, edgeType Edge_Type) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.index.RelateNodeAtID(n, nodeID, edgeType)
+}
+
+// RelateNodeListAtID adds the nodes and edges of nl to the document and
+// relates its root elements to the node identified by nodeID. See
+// NodeList.RelateNodeListAtID for details.
+func (b *DocumentBuilder) RelateNodeListAtID(nl *NodeList, nodeID string, edgeType Edge_Type) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.index.RelateNodeListAtID(nl, nodeID, edgeType)
+}
+
+// RemoveNodes removes the nodes with the specified IDs and any edges
+// pointing to them from the document.
+func (b *DocumentBuilder) RemoveNodes(ids []string) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.index.RemoveNodes(ids)
+}
+
+// Update runs fn with exclusive access to the document. It is useful to
+// perform several changes atomically. The document must not be retained
+// after fn returns.
+func (b *DocumentBuilder) Update(fn func(*Document) error) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	// fn may modify or replace the NodeList, so the index is rebuilt
+	defer func() {
+		if b.doc.NodeList == nil {
+			b.doc.NodeList = NewNodeList()
+		}
+		b.index = NewIndexedNodeList(b.doc.NodeList)
+	}()
+	return fn(b.doc)
+}
+
+// View runs fn with read access to the document. Other readers may run
+// concurrently but no changes are made to the document while fn runs. fn
+// must not modify the document or retain it after returning.
+func (b *DocumentBuilder) View(fn func(*Document) error) error {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+	return fn(b.doc)
+}
+
+// GetNodeByID returns a copy of the node with the specified ID or nil if it
+// is not in the document.
+func (b *DocumentBuilder) GetNodeByID(id string) *Node {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+	n := b.index.GetNodeByID(id)
+	if n == nil {
+		return nil
+	}
+	return n.Copy()
+}
+
+// Snapshot returns a deep copy of the document as it is at the time of
+// the call. The snapshot is not affected by later changes to the builder.
+func (b *DocumentBuilder) Snapshot() *Document {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	return proto.Clone(b.doc).(*Document) //nolint:forcetypeassert
+}
@@ -0,0 +1,175 @@
+package sbom
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewDocumentBuilder(t *testing.T) {
+	for name, tc := range map[string]struct {
+		doc *Document
+	}{
+		"nil document":      {doc: nil},
+		"document":          {doc: NewDocument()},
+		"document nil list": {doc: &Document{Metadata: &Metadata{Id: "test"}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b := NewDocumentBuilder(tc.doc)
+			b.AddRootNode(&Node{Id: "root"})
+			require.NotNil(t, b.GetNodeByID("root"))
+			require.Nil(t, b.GetNodeByID("nope"))
+		})
+	}
+}
+
+func TestDocumentBuilderOperations(t *testing.T) {
+	b := NewDocumentBuilder(nil)
+	b.AddRootNode(&Node{Id: "root"})
+	b.AddNode(&Node{Id: "a"})
+	b.AddEdge(&Edge{Type: Edge_contains, From: "root", To: []string{"a"}})
+	require.NoError(t, b.RelateNodeAtID(&Node{Id: "b"}, "root", Edge_contains))
+	require.Error(t, b.RelateNodeAtID(&Node{Id: "c"}, "nope", Edge_contains))
+	require.NoError(t, b.RelateNodeListAtID(&NodeList{
+		Nodes:        []*Node{{Id: "c"}, {Id: "d"}},
+		Edges:        []*Edge{{Type: Edge_dependsOn, From: "c", To: []string{"d"}}},
+		RootElements: []string{"c"},
+	}, "a", Edge_dependsOn))
+
+	doc := b.Snapshot()
+	require.Len(t, doc.NodeList.Nodes, 5)
+	require.Equal(t, []string{"a", "b"}, doc.NodeList.GetEdgeByType("root", Edge_contains).To)
+	require.Equal(t, []string{"c"}, doc.NodeList.GetEdgeByType("a", Edge_dependsOn).To)
+
+	b.RemoveNodes([]string{"b"})
+	require.Nil(t, b.GetNodeByID("b"))
+	require.NoError(t, b.View(func(d *Document) error {
+		require.Equal(t, []string{"a"}, d.NodeList.GetEdgeByType("root", Edge_contains).To)
+		return nil
+	}))
+
+	// The snapshot is not affected by the removal
+	require.NotNil(t, doc.NodeList.GetNodeByID("b"))
+	require.Equal(t, []string{"a", "b"}, doc.NodeList.GetEdgeByType("root", Edge_contains).To)
+
+	// Nor are the returned nodes linked to the document
+	n := b.GetNodeByID("a")
+	n.Name = "changed"
+	require.Empty(t, b.GetNodeByID("a").Name)
+
+	// Changes made in update functions are seen by later lookups
+	require.NoError(t, b.Update(func(d *Document) error {
+		d.NodeList.Nodes[1] = &Node{Id: "replaced"}
+		return nil
+	}))
+	require.Nil(t, b.GetNodeByID("a"))
+	require.NotNil(t, b.GetNodeByID("replaced"))
+
+	// Errors from update functions are returned
+	require.Error(t, b.Update(func(d *Document) error {
+		return fmt.Errorf("synthetic error")
+	}))
+}
+
+func TestDocumentBuilderSnapshotMetadata(t *testing.T) {
+	doc := NewDocument()
+	doc.Metadata.Id = "urn:test"
+	b := NewDocumentBuilder(doc)
+	snap := b.Snapshot()
+	require.Equal(t, "urn:test", snap.Metadata.Id)
+	require.NoError(t, b.View(func(d *Document) error {
+		require.True(t, proto.Equal(d, snap))
+		return nil
+	}))
+
+	require.NoError(t, b.Update(func(d *Document) error {
+		d.Metadata.Id = "urn:changed"
+		return nil
+	}))
+	require.Equal(t, "urn:test", snap.Metadata.Id)
+	require.Equal(t, "urn:changed", b.Snapshot().Metadata.Id)
+}
+
+func TestDocumentBuilderSnapshotVulnerabilities(t *testing.T) {
+	doc := NewDocument()
+	doc.AddVulnerability(&Vulnerability{Id: "CVE-2021-44228", Affects: []string{"log4j"}})
+	b := NewDocumentBuilder(doc)
+	snap := b.Snapshot()
+	require.Len(t, snap.Vulnerabilities, 1)
+
+	require.NoError(t, b.Update(func(d *Document) error {
+		d.Vulnerabilities[0].Affects = append(d.Vulnerabilities[0].Affects, "app")
+		return nil
+	}))
+	require.Equal(t, []string{"log4j"}, snap.Vulnerabilities[0].Affects)
+}
+
+// TestDocumentBuilderConcurrency adds nodes and edges from several goroutines
+// while others read snapshots. It is meant to be run with the race detector.
+func TestDocumentBuilderConcurrency(t *testing.T) {
+	const workers = 8
+	const perWorker = 200
+
+	b := NewDocumentBuilder(nil)
+	b.AddRootNode(&Node{Id: "root"})
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			parent := fmt.Sprintf("worker-%d", w)
+			require.NoError(t, b.RelateNodeAtID(&Node{Id: parent}, "root", Edge_contains))
+			for i := 0; i < perWorker; i++ {
+				id := fmt.Sprintf("%s-node-%d", parent, i)
+				if i%2 == 0 {
+					require.NoError(t, b.RelateNodeAtID(&Node{Id: id}, parent, Edge_dependsOn))
+					continue
+				}
+				b.AddNode(&Node{Id: id})
+				b.AddEdge(&Edge{Type: Edge_dependsOn, From: parent, To: []string{id}})
+			}
+		}(w)
+	}
+
+	// Readers check that every snapshot is consistent
+	done := make(chan struct{})
+	var readers sync.WaitGroup
+	for r := 0; r < 4; r++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				snap := b.Snapshot()
+				for _, e := range snap.NodeList.Edges {
+					for _, to := range e.To {
+						require.NotNil(t, snap.NodeList.GetNodeByID(to))
+					}
+				}
+				require.NoError(t, b.View(func(d *Document) error {
+					d.NodeList.GetNodeByID("root")
+					return nil
+				}))
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(done)
+	readers.Wait()
+
+	doc := b.Snapshot()
+	require.Len(t, doc.NodeList.Nodes, 1+workers+workers*perWorker)
+	require.Empty(t, doc.NodeList.Validate().WithSeverity(SeverityWarning))
+	for w := 0; w < workers; w++ {
+		require.Len(t, doc.NodeList.NodeSiblings(fmt.Sprintf("worker-%d", w)).Nodes, perWorker+1)
+	}
+}
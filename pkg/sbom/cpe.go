@@ -0,0 +1,615 @@
+package sbom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This file implements parsing, binding and matching of CPE names according
+// to the NIST specifications: CPE Naming (NISTIR 7695) and CPE Name Matching
+// (NISTIR 7696). CPE names are parsed into their well-formed name (WFN) form
+// which can then be bound to the CPE 2.2 URI form or the CPE 2.3 formatted
+// string.
+
+// ErrInvalidCPE is returned when a string cannot be parsed as a CPE name
+var ErrInvalidCPE = fmt.Errorf("invalid cpe name")
+
+const (
+	// CPEAny is the logical value ANY of a CPE attribute
+	CPEAny = "*"
+
+	// CPENA is the logical value NA (not applicable) of a CPE attribute
+	CPENA = "-"
+)
+
+// CPE is a CPE name in its well-formed name (WFN) form. Attribute values are
+// either one of the logical values CPEAny or CPENA or a WFN string where
+// special characters are quoted with a backslash and unquoted asterisks and
+// question marks are wildcards.
+type CPE struct {
+	Part      string
+	Vendor    string
+	Product   string
+	Version   string
+	Update    string
+	Edition   string
+	Language  string
+	SwEdition string
+	TargetSw  string
+	TargetHw  string
+	Other     string
+}
+
+// attributes returns pointers to the CPE attributes in WFN order
+func (c *CPE) attributes() []*string {
+	return []*string{
+		&c.Part, &c.Vendor, &c.Product, &c.Version, &c.Update, &c.Edition,
+		&c.Language, &c.SwEdition, &c.TargetSw, &c.TargetHw, &c.Other,
+	}
+}
+
+// ParseCPE parses a CPE name in either the 2.3 formatted string binding
+// (cpe:2.3:...) or the 2.2 URI binding (cpe:/...).
+func ParseCPE(s string) (*CPE, error) {
+	s = strings.TrimSpace(s)
+	lower := strings.ToLower(s)
+	switch {
+	case strings.HasPrefix(lower, "cpe:2.3:"):
+		return parseCPEFormattedString(s)
+	case strings.HasPrefix(lower, "cpe:/"):
+		return parseCPEURI(s)
+	default:
+		return nil, fmt.Errorf("%w: %q is not a cpe 2.2 or 2.3 name", ErrInvalidCPE, s)
+	}
+}
+
+// newCPE returns a CPE with all attributes set to ANY
+func newCPE() *CPE {
+	c := &CPE{}
+	for _, a := range c.attributes() {
+		*a = CPEAny
+	}
+	return c
+}
+
+// parseCPEFormattedString unbinds a CPE 2.3 formatted string
+func parseCPEFormattedString(s string) (*CPE, error) {
+	// Split on unquoted colons
+	parts := []string{}
+	current := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			current.WriteByte(s[i])
+			if i+1 < len(s) {
+				i++
+				current.WriteByte(s[i])
+			}
+		case ':':
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(s[i])
+		}
+	}
+	parts = append(parts, current.String())
+
+	if len(parts) != 13 {
+		return nil, fmt.Errorf("%w: formatted string must have 11 attributes, found %d", ErrInvalidCPE, len(parts)-2)
+	}
+
+	c := &CPE{}
+	for i, a := range c.attributes() {
+		v, err := unbindCPEFormattedValue(parts[i+2])
+		if err != nil {
+			return nil, fmt.Errorf("%w: parsing attribute %d: %w", ErrInvalidCPE, i+1, err)
+		}
+		*a = v
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// unbindCPEFormattedValue converts a value of the formatted string binding to
+// its WFN form.
+func unbindCPEFormattedValue(s string) (string, error) {
+	switch s {
+	case "*":
+		return CPEAny, nil
+	case "-":
+		return CPENA, nil
+	case "":
+		return "", fmt.Errorf("empty value")
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case isCPEAlnum(c):
+			b.WriteByte(c)
+		case c == '\\':
+			if i+1 == len(s) {
+				return "", fmt.Errorf("unterminated escape in %q", s)
+			}
+			i++
+			b.WriteByte('\\')
+			b.WriteByte(s[i])
+		case c == '*', c == '?':
+			b.WriteByte(c)
+		default:
+			// Other punctuation must be quoted in the WFN
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), nil
+}
+
+// parseCPEURI unbinds a CPE 2.2 URI
+func parseCPEURI(s string) (*CPE, error) {
+	parts := strings.Split(s[len("cpe:/"):], ":")
+	if len(parts) > 7 {
+		return nil, fmt.Errorf("%w: uri has more than 7 components", ErrInvalidCPE)
+	}
+
+	c := newCPE()
+	uriAttrs := c.attributes()[:7]
+	for i, p := range parts {
+		// The edition may have the extended attributes packed
+		if i == 5 && strings.HasPrefix(p, "~") {
+			packed := strings.Split(p, "~")
+			if len(packed) != 6 {
+				return nil, fmt.Errorf("%w: malformed packed edition %q", ErrInvalidCPE, p)
+			}
+			for j, a := range []*string{&c.Edition, &c.SwEdition, &c.TargetSw, &c.TargetHw, &c.Other} {
+				v, err := unbindCPEURIValue(packed[j+1])
+				if err != nil {
+					return nil, fmt.Errorf("%w: parsing edition: %w", ErrInvalidCPE, err)
+				}
+				*a = v
+			}
+			continue
+		}
+
+		v, err := unbindCPEURIValue(p)
+		if err != nil {
+			return nil, fmt.Errorf("%w: parsing component %d: %w", ErrInvalidCPE, i+1, err)
+		}
+		*uriAttrs[i] = v
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// unbindCPEURIValue decodes a component of the URI binding into its WFN form
+func unbindCPEURIValue(s string) (string, error) {
+	switch s {
+	case "":
+		return CPEAny, nil
+	case "-":
+		return CPENA, nil
+	}
+
+	s = strings.ToLower(s)
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case isCPEAlnum(c):
+			b.WriteByte(c)
+		case c == '%':
+			if i+2 >= len(s) {
+				return "", fmt.Errorf("truncated percent encoding in %q", s)
+			}
+			code := s[i : i+3]
+			i += 2
+			switch code {
+			case "%01":
+				b.WriteByte('?')
+				continue
+			case "%02":
+				b.WriteByte('*')
+				continue
+			}
+			var ch byte
+			if _, err := fmt.Sscanf(code[1:], "%02x", &ch); err != nil {
+				return "", fmt.Errorf("invalid percent encoding %q", code)
+			}
+			if isCPEAlnum(ch) {
+				b.WriteByte(ch)
+				continue
+			}
+			b.WriteByte('\\')
+			b.WriteByte(ch)
+		default:
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), nil
+}
+
+// isCPEAlnum returns true for the characters that never need quoting
+func isCPEAlnum(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_'
+}
+
+// validate checks the CPE is a well-formed name
+func (c *CPE) validate() error {
+	switch strings.ToLower(c.Part) {
+	case "a", "o", "h", CPEAny, CPENA:
+	default:
+		return fmt.Errorf("%w: invalid part %q", ErrInvalidCPE, c.Part)
+	}
+
+	for _, a := range c.attributes() {
+		if *a == CPEAny || *a == CPENA {
+			continue
+		}
+		if err := validateCPEWildcards(*a); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidCPE, err)
+		}
+	}
+	return nil
+}
+
+// validateCPEWildcards checks that unquoted asterisks and question marks only
+// appear at the beginning or end of a value.
+func validateCPEWildcards(v string) error {
+	body := v
+	if strings.HasPrefix(body, "*") {
+		body = body[1:]
+	} else {
+		body = strings.TrimLeft(body, "?")
+	}
+	if body == "" {
+		return nil
+	}
+	body = body[:trimCPETrailingQuestionMarks(body)]
+	if body != "" && body[len(body)-1] == '*' && !isCPEQuoted(body, len(body)-1) {
+		body = body[:len(body)-1]
+	}
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '\\':
+			i++
+		case '*', '?':
+			return fmt.Errorf("embedded wildcard in %q", v)
+		}
+	}
+	return nil
+}
+
+// String returns the CPE 2.3 formatted string binding of the name
+func (c *CPE) String() string {
+	return c.FormattedString()
+}
+
+// FormattedString binds the name to a CPE 2.3 formatted string
+func (c *CPE) FormattedString() string {
+	values := []string{}
+	for _, a := range c.attributes() {
+		values = append(values, bindCPEFormattedValue(*a))
+	}
+	return "cpe:2.3:" + strings.Join(values, ":")
+}
+
+// bindCPEFormattedValue converts a WFN value for the formatted string binding
+func bindCPEFormattedValue(v string) string {
+	if v == CPEAny || v == CPENA {
+		return v
+	}
+	var b strings.Builder
+	for i := 0; i < len(v); i++ {
+		if v[i] != '\\' || i+1 == len(v) {
+			b.WriteByte(v[i])
+			continue
+		}
+		i++
+		switch v[i] {
+		case '.', '-', '_':
+			b.WriteByte(v[i])
+		default:
+			b.WriteByte('\\')
+			b.WriteByte(v[i])
+		}
+	}
+	return b.String()
+}
+
+// URI binds the name to a CPE 2.2 URI. Extended attributes are packed into
+// the edition component when any of them is set.
+func (c *CPE) URI() string {
+	edition := bindCPEURIValue(c.Edition)
+	if c.SwEdition != CPEAny || c.TargetSw != CPEAny || c.TargetHw != CPEAny || c.Other != CPEAny {
+		edition = "~" + strings.Join([]string{
+			edition, bindCPEURIValue(c.SwEdition), bindCPEURIValue(c.TargetSw),
+			bindCPEURIValue(c.TargetHw), bindCPEURIValue(c.Other),
+		}, "~")
+	}
+	uri := "cpe:/" + strings.Join([]string{
+		bindCPEURIValue(c.Part), bindCPEURIValue(c.Vendor), bindCPEURIValue(c.Product),
+		bindCPEURIValue(c.Version), bindCPEURIValue(c.Update), edition,
+		bindCPEURIValue(c.Language),
+	}, ":")
+	return strings.TrimRight(uri, ":")
+}
+
+// bindCPEURIValue converts a WFN value for the URI binding
+func bindCPEURIValue(v string) string {
+	switch v {
+	case CPEAny:
+		return ""
+	case CPENA:
+		return "-"
+	}
+	var b strings.Builder
+	for i := 0; i < len(v); i++ {
+		ch := v[i]
+		switch {
+		case isCPEAlnum(ch):
+			b.WriteByte(ch)
+		case ch == '?':
+			b.WriteString("%01")
+		case ch == '*':
+			b.WriteString("%02")
+		case ch == '\\' && i+1 < len(v):
+			i++
+			switch v[i] {
+			case '-', '.':
+				b.WriteByte(v[i])
+			default:
+				fmt.Fprintf(&b, "%%%02x", v[i])
+			}
+		default:
+			fmt.Fprintf(&b, "%%%02x", ch)
+		}
+	}
+	return strings.ToLower(b.String())
+}
+
+// CPE22ToCPE23 converts a CPE 2.2 URI to a 2.3 formatted string
+func CPE22ToCPE23(uri string) (string, error) {
+	c, err := ParseCPE(uri)
+	if err != nil {
+		return "", err
+	}
+	return c.FormattedString(), nil
+}
+
+// CPE23ToCPE22 converts a CPE 2.3 formatted string to a 2.2 URI. Note that
+// the URI binding cannot express all 2.3 names: embedded wildcards in
+// attribute values are converted to their percent-encoded form.
+func CPE23ToCPE22(fs string) (string, error) {
+	c, err := ParseCPE(fs)
+	if err != nil {
+		return "", err
+	}
+	return c.URI(), nil
+}
+
+// CPERelation is the set relation between two CPE names or attribute values
+// as defined in the CPE Name Matching specification.
+type CPERelation int
+
+const (
+	// CPEDisjoint means the names or values have nothing in common
+	CPEDisjoint CPERelation = iota
+
+	// CPESubset means the source is a subset of the target
+	CPESubset
+
+	// CPESuperset means the source is a superset of the target
+	CPESuperset
+
+	// CPEEqual means the source and target are equal
+	CPEEqual
+
+	// CPEUndefined is returned when the target has wildcards and the
+	// relation cannot be determined.
+	CPEUndefined
+)
+
+// String returns the name of the relation
+func (r CPERelation) String() string {
+	switch r {
+	case CPEDisjoint:
+		return "DISJOINT"
+	case CPESubset:
+		return "SUBSET"
+	case CPESuperset:
+		return "SUPERSET"
+	case CPEEqual:
+		return "EQUAL"
+	case CPEUndefined:
+		return "UNDEFINED"
+	default:
+		return fmt.Sprintf("CPERelation(%d)", int(r))
+	}
+}
+
+// Compare returns the name level relation of the CPE (the source) with the
+// target. The result is CPEDisjoint if any attribute is disjoint, CPEEqual if
+// all attributes are equal, CPESuperset or CPESubset if all attributes are
+// supersets or subsets (or equal), and CPEUndefined otherwise.
+func (c *CPE) Compare(target *CPE) CPERelation {
+	relations := []CPERelation{}
+	ta := target.attributes()
+	for i, a := range c.attributes() {
+		relations = append(relations, compareCPEValues(*a, *ta[i]))
+	}
+
+	all := func(allowed ...CPERelation) bool {
+		for _, r := range relations {
+			ok := false
+			for _, a := range allowed {
+				if r == a {
+					ok = true
+					break
+				}
+			}
+			if !ok {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch {
+	case !all(CPESubset, CPESuperset, CPEEqual, CPEUndefined):
+		return CPEDisjoint
+	case all(CPEEqual):
+		return CPEEqual
+	case all(CPESuperset, CPEEqual):
+		return CPESuperset
+	case all(CPESubset, CPEEqual):
+		return CPESubset
+	default:
+		return CPEUndefined
+	}
+}
+
+// Matches returns true if the CPE, used as a pattern, matches the target
+// name. This is the CPE name match of the specification: the pattern must be
+// a superset of or equal to the target.
+func (c *CPE) Matches(target *CPE) bool {
+	r := c.Compare(target)
+	return r == CPESuperset || r == CPEEqual
+}
+
+// compareCPEValues computes the relation between two attribute values
+// following table 6-2 of the CPE Name Matching specification.
+func compareCPEValues(source, target string) CPERelation {
+	if hasCPEWildcards(target) && target != CPEAny {
+		return CPEUndefined
+	}
+
+	switch source {
+	case CPEAny:
+		if target == CPEAny {
+			return CPEEqual
+		}
+		return CPESuperset
+	case CPENA:
+		switch target {
+		case CPEAny:
+			return CPESubset
+		case CPENA:
+			return CPEEqual
+		default:
+			return CPEDisjoint
+		}
+	}
+
+	switch target {
+	case CPEAny:
+		return CPESubset
+	case CPENA:
+		return CPEDisjoint
+	}
+
+	if !hasCPEWildcards(source) {
+		if strings.EqualFold(unquoteCPEValue(source), unquoteCPEValue(target)) {
+			return CPEEqual
+		}
+		return CPEDisjoint
+	}
+
+	if matchCPEWildcards(source, unquoteCPEValue(target)) {
+		return CPESuperset
+	}
+	return CPEDisjoint
+}
+
+// isCPEQuoted returns true if the character at position i of the WFN value
+// is quoted, that is, preceded by an odd number of backslashes.
+func isCPEQuoted(v string, i int) bool {
+	n := 0
+	for j := i - 1; j >= 0 && v[j] == '\\'; j-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+// trimCPETrailingQuestionMarks returns the position where the trailing
+// unquoted question marks of the value start.
+func trimCPETrailingQuestionMarks(v string) int {
+	end := len(v)
+	for end > 0 && v[end-1] == '?' && !isCPEQuoted(v, end-1) {
+		end--
+	}
+	return end
+}
+
+// hasCPEWildcards returns true if the value has unquoted wildcards
+func hasCPEWildcards(v string) bool {
+	if v == CPENA {
+		return false
+	}
+	for i := 0; i < len(v); i++ {
+		switch v[i] {
+		case '\\':
+			i++
+		case '*', '?':
+			return true
+		}
+	}
+	return false
+}
+
+// unquoteCPEValue removes the quoting backslashes from a WFN value
+func unquoteCPEValue(v string) string {
+	var b strings.Builder
+	for i := 0; i < len(v); i++ {
+		if v[i] == '\\' && i+1 < len(v) {
+			i++
+		}
+		b.WriteByte(v[i])
+	}
+	return b.String()
+}
+
+// matchCPEWildcards returns true if the unquoted target value matches the
+// WFN value with wildcards, ignoring case. A leading or trailing asterisk
+// matches any number of characters and each question mark matches at most
+// one character.
+func matchCPEWildcards(pattern, target string) bool {
+	maxPrefix, maxSuffix := 0, 0
+	switch {
+	case strings.HasPrefix(pattern, "*"):
+		maxPrefix, pattern = -1, pattern[1:]
+	case strings.HasPrefix(pattern, "?"):
+		n := len(pattern) - len(strings.TrimLeft(pattern, "?"))
+		maxPrefix, pattern = n, pattern[n:]
+	}
+
+	// Trailing wildcards, unless they are quoted
+	end := trimCPETrailingQuestionMarks(pattern)
+	if n := len(pattern) - end; n > 0 {
+		maxSuffix, pattern = n, pattern[:end]
+	} else if end > 0 && pattern[end-1] == '*' && !isCPEQuoted(pattern, end-1) {
+		maxSuffix, pattern = -1, pattern[:end-1]
+	}
+
+	literal := []rune(unquoteCPEValue(pattern))
+	runes := []rune(target)
+	last := len(runes) - len(literal)
+	if maxPrefix >= 0 && maxPrefix < last {
+		last = maxPrefix
+	}
+	for i := 0; i <= last; i++ {
+		rest := len(runes) - i - len(literal)
+		if maxSuffix >= 0 && rest > maxSuffix {
+			continue
+		}
+		if strings.EqualFold(string(runes[i:i+len(literal)]), string(literal)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,265 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCPE(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cpe      string
+		expected *CPE
+		fs       string
+		uri      string
+		mustErr  bool
+	}{
+		"uri": {
+			cpe: "cpe:/a:microsoft:internet_explorer:8.0.6001:beta",
+			expected: &CPE{
+				Part: "a", Vendor: "microsoft", Product: "internet_explorer", Version: "8\\.0\\.6001", Update: "beta",
+				Edition: CPEAny, Language: CPEAny, SwEdition: CPEAny, TargetSw: CPEAny, TargetHw: CPEAny, Other: CPEAny,
+			},
+			fs:  "cpe:2.3:a:microsoft:internet_explorer:8.0.6001:beta:*:*:*:*:*:*",
+			uri: "cpe:/a:microsoft:internet_explorer:8.0.6001:beta",
+		},
+		"uri packed edition": {
+			cpe: "cpe:/a:hp:insight_diagnostics:7.4.0.1570:-:~~online~win2003~x64~",
+			expected: &CPE{
+				Part: "a", Vendor: "hp", Product: "insight_diagnostics", Version: "7\\.4\\.0\\.1570", Update: CPENA,
+				Edition: CPEAny, Language: CPEAny, SwEdition: "online", TargetSw: "win2003", TargetHw: "x64", Other: CPEAny,
+			},
+			fs:  "cpe:2.3:a:hp:insight_diagnostics:7.4.0.1570:-:*:*:online:win2003:x64:*",
+			uri: "cpe:/a:hp:insight_diagnostics:7.4.0.1570:-:~~online~win2003~x64~",
+		},
+		"uri percent encoding": {
+			cpe: "cpe:/a:foo%5cbar:big%24money_2010%21:%01%01",
+			expected: &CPE{
+				Part: "a", Vendor: "foo\\\\bar", Product: "big\\$money_2010\\!", Version: "??", Update: CPEAny,
+				Edition: CPEAny, Language: CPEAny, SwEdition: CPEAny, TargetSw: CPEAny, TargetHw: CPEAny, Other: CPEAny,
+			},
+			fs:  "cpe:2.3:a:foo\\\\bar:big\\$money_2010\\!:??:*:*:*:*:*:*:*",
+			uri: "cpe:/a:foo%5cbar:big%24money_2010%21:%01%01",
+		},
+		"uri case": {
+			cpe: "CPE:/O:Microsoft:Windows_XP",
+			expected: &CPE{
+				Part: "o", Vendor: "microsoft", Product: "windows_xp", Version: CPEAny, Update: CPEAny,
+				Edition: CPEAny, Language: CPEAny, SwEdition: CPEAny, TargetSw: CPEAny, TargetHw: CPEAny, Other: CPEAny,
+			},
+			fs:  "cpe:2.3:o:microsoft:windows_xp:*:*:*:*:*:*:*:*",
+			uri: "cpe:/o:microsoft:windows_xp",
+		},
+		"formatted string": {
+			cpe: "cpe:2.3:a:foo\\\\bar:big\\$money:2010:*:*:*:special:ipod_touch:80gb:*",
+			expected: &CPE{
+				Part: "a", Vendor: "foo\\\\bar", Product: "big\\$money", Version: "2010", Update: CPEAny,
+				Edition: CPEAny, Language: CPEAny, SwEdition: "special", TargetSw: "ipod_touch", TargetHw: "80gb", Other: CPEAny,
+			},
+			fs:  "cpe:2.3:a:foo\\\\bar:big\\$money:2010:*:*:*:special:ipod_touch:80gb:*",
+			uri: "cpe:/a:foo%5cbar:big%24money:2010::~~special~ipod_touch~80gb~",
+		},
+		"formatted string quoted colon": {
+			cpe: "cpe:2.3:a:example:app\\:server:1.0-rc1:*:*:*:*:*:*:*",
+			expected: &CPE{
+				Part: "a", Vendor: "example", Product: "app\\:server", Version: "1\\.0\\-rc1", Update: CPEAny,
+				Edition: CPEAny, Language: CPEAny, SwEdition: CPEAny, TargetSw: CPEAny, TargetHw: CPEAny, Other: CPEAny,
+			},
+			fs:  "cpe:2.3:a:example:app\\:server:1.0-rc1:*:*:*:*:*:*:*",
+			uri: "cpe:/a:example:app%3aserver:1.0-rc1",
+		},
+		"formatted string wildcards": {
+			cpe: "cpe:2.3:a:apache:log4j:2.*:*:*:*:*:*:*:*",
+			expected: &CPE{
+				Part: "a", Vendor: "apache", Product: "log4j", Version: "2\\.*", Update: CPEAny,
+				Edition: CPEAny, Language: CPEAny, SwEdition: CPEAny, TargetSw: CPEAny, TargetHw: CPEAny, Other: CPEAny,
+			},
+			fs:  "cpe:2.3:a:apache:log4j:2.*:*:*:*:*:*:*:*",
+			uri: "cpe:/a:apache:log4j:2.%02",
+		},
+		"not a cpe":          {cpe: "pkg:npm/lodash", mustErr: true},
+		"too few attributes": {cpe: "cpe:2.3:a:apache:log4j", mustErr: true},
+		"too many components": {
+			cpe:     "cpe:/a:b:c:d:e:f:g:h",
+			mustErr: true,
+		},
+		"invalid part":       {cpe: "cpe:2.3:x:apache:log4j:*:*:*:*:*:*:*:*", mustErr: true},
+		"embedded wildcard":  {cpe: "cpe:2.3:a:apa*che:log4j:*:*:*:*:*:*:*:*", mustErr: true},
+		"empty attribute":    {cpe: "cpe:2.3:a::log4j:*:*:*:*:*:*:*:*", mustErr: true},
+		"bad packed edition": {cpe: "cpe:/a:hp:diag:1:-:~online~win", mustErr: true},
+		"bad percent":        {cpe: "cpe:/a:hp:diag%zz", mustErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c, err := ParseCPE(tc.cpe)
+			if tc.mustErr {
+				require.Error(t, err)
+				require.ErrorIs(t, err, ErrInvalidCPE)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, c)
+			require.Equal(t, tc.fs, c.FormattedString())
+			require.Equal(t, tc.fs, c.String())
+			require.Equal(t, tc.uri, c.URI())
+
+			// Both bindings must parse back to the same name
+			fromFS, err := ParseCPE(c.FormattedString())
+			require.NoError(t, err)
+			require.Equal(t, c, fromFS)
+			fromURI, err := ParseCPE(c.URI())
+			require.NoError(t, err)
+			require.Equal(t, c, fromURI)
+		})
+	}
+}
+
+func TestCPEConversion(t *testing.T) {
+	fs, err := CPE22ToCPE23("cpe:/a:openbsd:openssh:7.4:p1")
+	require.NoError(t, err)
+	require.Equal(t, "cpe:2.3:a:openbsd:openssh:7.4:p1:*:*:*:*:*:*", fs)
+
+	uri, err := CPE23ToCPE22("cpe:2.3:a:openbsd:openssh:7.4:p1:*:*:*:*:*:*")
+	require.NoError(t, err)
+	require.Equal(t, "cpe:/a:openbsd:openssh:7.4:p1", uri)
+
+	_, err = CPE22ToCPE23("nope")
+	require.Error(t, err)
+	_, err = CPE23ToCPE22("nope")
+	require.Error(t, err)
+}
+
+func TestCPECompare(t *testing.T) {
+	for name, tc := range map[string]struct {
+		source, target string
+		expected       CPERelation
+	}{
+		"equal": {
+			"cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", CPEEqual,
+		},
+		"equal across bindings": {
+			"cpe:/a:apache:log4j:2.14.1", "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", CPEEqual,
+		},
+		"case insensitive": {
+			"cpe:2.3:a:Apache:Log4j:2.14.1:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", CPEEqual,
+		},
+		"any version": {
+			"cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", CPESuperset,
+		},
+		"any is superset of na": {
+			"cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:2.14.1:-:*:*:*:*:*:*", CPESuperset,
+		},
+		"wildcard version": {
+			"cpe:2.3:a:apache:log4j:2.*:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", CPESuperset,
+		},
+		"wildcard version disjoint": {
+			"cpe:2.3:a:apache:log4j:2.*:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:1.2.17:*:*:*:*:*:*:*", CPEDisjoint,
+		},
+		"leading wildcard": {
+			"cpe:2.3:a:*soft:*:*:*:*:*:*:*:*:*", "cpe:2.3:a:microsoft:office:*:*:*:*:*:*:*:*", CPESuperset,
+		},
+		"question marks": {
+			"cpe:2.3:a:apache:log4j:2.1?:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:2.14:*:*:*:*:*:*:*", CPESuperset,
+		},
+		"question marks too long": {
+			"cpe:2.3:a:apache:log4j:2.1?:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", CPEDisjoint,
+		},
+		"question marks match fewer": {
+			"cpe:2.3:a:apache:log4j:??2.0:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:2.0:*:*:*:*:*:*:*", CPESuperset,
+		},
+		"quoted wildcard is literal": {
+			"cpe:2.3:a:apache:log4j:2\\*:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:2.0:*:*:*:*:*:*:*", CPEDisjoint,
+		},
+		"subset": {
+			"cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", CPESubset,
+		},
+		"different product": {
+			"cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", "cpe:2.3:a:apache:tomcat:9.0:*:*:*:*:*:*:*", CPEDisjoint,
+		},
+		"na vs value": {
+			"cpe:2.3:a:apache:log4j:2.14.1:-:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:2.14.1:rc1:*:*:*:*:*:*", CPEDisjoint,
+		},
+		"mixed superset and subset": {
+			"cpe:2.3:a:apache:*:2.14.1:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", CPEUndefined,
+		},
+		"target with wildcards": {
+			"cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", "cpe:2.3:a:apache:log4j:2.*:*:*:*:*:*:*:*", CPEUndefined,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			source, err := ParseCPE(tc.source)
+			require.NoError(t, err)
+			target, err := ParseCPE(tc.target)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.String(), source.Compare(target).String())
+			require.Equal(t, tc.expected == CPEEqual || tc.expected == CPESuperset, source.Matches(target))
+		})
+	}
+}
+
+func TestMatchCPEWildcards(t *testing.T) {
+	for _, tc := range []struct {
+		pattern, target string
+		expected        bool
+	}{
+		{"2.*", "2.14.1", true},
+		{"2.*", "2", false},
+		{"*soft", "Microsoft", true},
+		{"*soft", "software", false},
+		{"*sql*", "mysql-server", true},
+		{"*sql*", "sql", true},
+		{"?.0", "2.0", true},
+		{"?.0", ".0", true},
+		{"?.0", "12.0", false},
+		{"2.1??", "2.1", true},
+		{"2.1??", "2.141", true},
+		{"2.1??", "2.1412", false},
+		{"??x??", "axb", true},
+		{"??x??", "aaxbbb", false},
+		{"2\\*", "2*", true},
+		{"2\\*", "2.0", false},
+		{"2\\?", "2?", true},
+		{"2\\?", "2", false},
+		{"Ünï*", "ünïcode", true},
+		{"?ü", "xü", true},
+	} {
+		require.Equal(t, tc.expected, matchCPEWildcards(tc.pattern, tc.target), "%s %s", tc.pattern, tc.target)
+	}
+}
+
+func TestGetNodesByCPE(t *testing.T) {
+	nl := &NodeList{
+		Nodes: []*Node{
+			{Id: "log4j-2.14", Identifiers: map[int32]string{int32(SoftwareIdentifierType_CPE23): "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*"}},
+			{Id: "log4j-1.2", Identifiers: map[int32]string{int32(SoftwareIdentifierType_CPE22): "cpe:/a:apache:log4j:1.2.17"}},
+			{Id: "tomcat", Identifiers: map[int32]string{int32(SoftwareIdentifierType_CPE23): "cpe:2.3:a:apache:tomcat:9.0.1:*:*:*:*:*:*:*"}},
+			{Id: "invalid", Identifiers: map[int32]string{int32(SoftwareIdentifierType_CPE23): "cpe:2.3:nope"}},
+			{Id: "nocpe"},
+		},
+	}
+	for name, tc := range map[string]struct {
+		pattern  string
+		expected []string
+		mustErr  bool
+	}{
+		"all log4j":       {pattern: "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", expected: []string{"log4j-2.14", "log4j-1.2"}},
+		"log4j 2":         {pattern: "cpe:2.3:a:apache:log4j:2.*:*:*:*:*:*:*:*", expected: []string{"log4j-2.14"}},
+		"uri pattern":     {pattern: "cpe:/a:apache:log4j:1.2.17", expected: []string{"log4j-1.2"}},
+		"all apache":      {pattern: "cpe:/a:apache", expected: []string{"log4j-2.14", "log4j-1.2", "tomcat"}},
+		"no matches":      {pattern: "cpe:2.3:a:microsoft:*:*:*:*:*:*:*:*:*", expected: []string{}},
+		"invalid pattern": {pattern: "apache log4j", mustErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := nl.GetNodesByCPE(tc.pattern)
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			ids := []string{}
+			for _, n := range res {
+				ids = append(ids, n.Id)
+			}
+			require.Equal(t, tc.expected, ids)
+		})
+	}
+}
@@ -140,6 +140,16 @@ func (n *Node) Diff(n2 *Node) *NodeDiff {
 	nd.Removed.ExternalReferences = removedER
 	nd.DiffCount += count
 
+	addedPr, removedPr, count := diffList(n.Properties, n2.Properties)
+	nd.Added.Properties = addedPr
+	nd.Removed.Properties = removedPr
+	nd.DiffCount += count
+
+	addedA, removedA, count := diffList(n.Annotations, n2.Annotations)
+	nd.Added.Annotations = addedA
+	nd.Removed.Annotations = removedA
+	nd.DiffCount += count
+
 	addedM, removedM, count := diffMap(n.Identifiers, n2.Identifiers)
 	nd.Added.Identifiers = addedM
 	nd.Removed.Identifiers = removedM
@@ -150,6 +160,56 @@ func (n *Node) Diff(n2 *Node) *NodeDiff {
 	nd.Removed.Hashes = removedM
 	nd.DiffCount += count
 
+	addedR, removedR, count := diffRanges(n.ByteRange, n2.ByteRange)
+	nd.Added.ByteRange = addedR
+	nd.Removed.ByteRange = removedR
+	nd.DiffCount += count
+
+	addedR, removedR, count = diffRanges(n.LineRange, n2.LineRange)
+	nd.Added.LineRange = addedR
+	nd.Removed.LineRange = removedR
+	nd.DiffCount += count
+
+	added, removed, count = diffSlice(n.Endpoints, n2.Endpoints)
+	nd.Added.Endpoints = added
+	nd.Removed.Endpoints = removed
+	nd.DiffCount += count
+
+	addedF, removedF, count := diffFlags(n.Authenticated, n2.Authenticated)
+	nd.Added.Authenticated = addedF
+	nd.Removed.Authenticated = removedF
+	nd.DiffCount += count
+
+	addedF, removedF, count = diffFlags(n.CrossesTrustBoundary, n2.CrossesTrustBoundary)
+	nd.Added.CrossesTrustBoundary = addedF
+	nd.Removed.CrossesTrustBoundary = removedF
+	nd.DiffCount += count
+
+	addedDF, removedDF, count := diffList(n.DataFlows, n2.DataFlows)
+	nd.Added.DataFlows = addedDF
+	nd.Removed.DataFlows = removedDF
+	nd.DiffCount += count
+
+	addedC, removedC, count := diffList(n.Commits, n2.Commits)
+	nd.Added.Commits = addedC
+	nd.Removed.Commits = removedC
+	nd.DiffCount += count
+
+	a, r, c = diff(n.PedigreeNotes, n2.PedigreeNotes)
+	nd.Added.PedigreeNotes = a
+	nd.Removed.PedigreeNotes = r
+	nd.DiffCount += c
+
+	addedE, removedE, count := diffMessages(n.Evidence, n2.Evidence)
+	nd.Added.Evidence = addedE
+	nd.Removed.Evidence = removedE
+	nd.DiffCount += count
+
+	addedPt, removedPt, count := diffMessages(n.Patch, n2.Patch)
+	nd.Added.Patch = addedPt
+	nd.Removed.Patch = removedPt
+	nd.DiffCount += count
+
 	if nd.DiffCount > 0 {
 		return &nd
 	}
@@ -18,6 +18,8 @@
 // serialzers.
 package sbom
 
+import "fmt"
+
 // NewDocument Creates a new empty document.
 func NewDocument() *Document {
 	return &Document{
@@ -42,3 +44,54 @@ func NewDocument() *Document {
 func (d *Document) GetRootNodes() []*Node {
 	return d.NodeList.GetRootNodes()
 }
+
+// Validate checks the document for integrity problems. It verifies that the
+// document has metadata and an identifier, runs all the NodeList checks
+// on its graph and checks that vulnerabilities only affect known nodes and
+// that edges only point to external documents declared in the metadata.
+func (d *Document) Validate() Issues {
+	issues := Issues{}
+	if d.Metadata == nil {
+		issues = append(issues, &Issue{
+			Severity: SeverityError, Code: IssueMissingMetadata, Message: "document has no metadata",
+		})
+	} else if d.Metadata.Id == "" {
+		issues = append(issues, &Issue{
+			Severity: SeverityWarning, Code: IssueMissingDocumentID, Message: "document has no identifier",
+		})
+	}
+
+	if d.NodeList == nil {
+		issues = append(issues, &Issue{
+			Severity: SeverityError, Code: IssueMissingNodeList, Message: "document has no nodelist",
+		})
+		return issues
+	}
+
+	issues = append(issues, d.NodeList.Validate()...)
+
+	for _, to := range d.NodeList.GetExternalEdgeTargets() {
+		docRef, _, _ := ParseExternalNodeID(to)
+		if d.GetMetadata().GetExternalDocumentReference(docRef) == nil {
+			issues = append(issues, &Issue{
+				Severity: SeverityError, Code: IssueUnknownExternalDocument,
+				Message:  fmt.Sprintf("edge target %q points to undeclared external document %q", to, docRef),
+				Elements: []string{to},
+			})
+		}
+	}
+
+	index := d.NodeList.indexNodes()
+	for _, v := range d.Vulnerabilities {
+		for _, id := range v.Affects {
+			if _, ok := index[id]; !ok {
+				issues = append(issues, &Issue{
+					Severity: SeverityError, Code: IssueDanglingVulnerabilityRef,
+					Message:  fmt.Sprintf("vulnerability %q affects unknown node %q", v.Id, id),
+					Elements: []string{id},
+				})
+			}
+		}
+	}
+	return issues
+}
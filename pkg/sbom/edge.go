@@ -14,11 +14,18 @@ func NewEdge() *Edge {
 
 // Copy returns a duplicate of the edge, including all connected graph edges.
 func (e *Edge) Copy() *Edge {
-	return &Edge{
+	ne := &Edge{
 		Type: e.Type,
 		From: e.From,
 		To:   e.To,
 	}
+	if len(e.Properties) > 0 {
+		ne.Properties = []*Property{}
+		for _, p := range e.Properties {
+			ne.Properties = append(ne.Properties, p.Copy())
+		}
+	}
+	return ne
 }
 
 // PointsTo returns true if the edge is directed towards a specific node.
@@ -246,7 +253,22 @@ func (e *Edge) Equal(e2 *Edge) bool {
 func (e *Edge) flatString() string {
 	tos := e.To
 	sort.Strings(tos)
-	return e.From + ":" + e.Type.String() + ":" + strings.Join(tos, "+")
+	ret := e.From + ":" + e.Type.String() + ":" + strings.Join(tos, "+")
+	if len(e.Properties) > 0 {
+		ret += ":" + e.propertiesKey()
+	}
+	return ret
+}
+
+// propertiesKey returns a string representing the properties of the edge,
+// regardless of their order. Edges without properties have an empty key.
+func (e *Edge) propertiesKey() string {
+	props := []string{}
+	for _, p := range e.Properties {
+		props = append(props, p.flatString())
+	}
+	sort.Strings(props)
+	return strings.Join(props, "+")
 }
 
 // AddDestinationById adds identifiers to the destination list of the edge. The
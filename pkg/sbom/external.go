@@ -0,0 +1,69 @@
+package sbom
+
+import (
+	"strings"
+)
+
+// ExternalDocumentRefPrefix is the prefix of the IDs of external document
+// references.
+const ExternalDocumentRefPrefix = "DocumentRef-"
+
+// ExternalNodeID returns the ID used in edges to point to the node nodeID of
+// the external document referenced as docRefID.
+func ExternalNodeID(docRefID, nodeID string) string {
+	return docRefID + ":" + nodeID
+}
+
+// ParseExternalNodeID splits an edge target pointing to a node in an external
+// document into the ID of the document reference and the node ID. The last
+// return value is false if the ID points to a node in the same document.
+func ParseExternalNodeID(id string) (docRefID, nodeID string, ok bool) {
+	if !strings.HasPrefix(id, ExternalDocumentRefPrefix) {
+		return "", "", false
+	}
+	docRefID, nodeID, ok = strings.Cut(id, ":")
+	if !ok || nodeID == "" {
+		return "", "", false
+	}
+	return docRefID, nodeID, true
+}
+
+// isExternalNodeID returns true if the ID points to a node in another document
+func isExternalNodeID(id string) bool {
+	_, _, ok := ParseExternalNodeID(id)
+	return ok
+}
+
+// GetExternalDocumentReference returns the external document reference with
+// the specified ID or nil if the document does not declare it.
+func (md *Metadata) GetExternalDocumentReference(id string) *ExternalDocumentReference {
+	for _, r := range md.GetExternalDocumentReferences() {
+		if r.GetId() == id {
+			return r
+		}
+	}
+	return nil
+}
+
+// GetExternalEdgeTargets returns the edge targets of the nodelist that point
+// to nodes in external documents. Targets with the syntax of external node IDs
+// that match a node in the list, such as those of resolved documents, are
+// considered local.
+func (nl *NodeList) GetExternalEdgeTargets() []string {
+	ret := []string{}
+	seen := map[string]struct{}{}
+	index := nl.indexNodes()
+	for _, e := range nl.GetEdges() {
+		for _, to := range e.GetTo() {
+			if _, ok := seen[to]; ok || !isExternalNodeID(to) {
+				continue
+			}
+			if _, ok := index[to]; ok {
+				continue
+			}
+			seen[to] = struct{}{}
+			ret = append(ret, to)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,51 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExternalNodeID(t *testing.T) {
+	for _, tc := range []struct {
+		id       string
+		docRefID string
+		nodeID   string
+		ok       bool
+	}{
+		{id: "DocumentRef-lib:Package-a", docRefID: "DocumentRef-lib", nodeID: "Package-a", ok: true},
+		{id: "DocumentRef-lib:pkg:npm/a@1.0.0", docRefID: "DocumentRef-lib", nodeID: "pkg:npm/a@1.0.0", ok: true},
+		{id: "pkg:npm/a@1.0.0"},
+		{id: "Package-a"},
+		{id: "DocumentRef-lib"},
+		{id: "DocumentRef-lib:"},
+	} {
+		t.Run(tc.id, func(t *testing.T) {
+			docRefID, nodeID, ok := ParseExternalNodeID(tc.id)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.docRefID, docRefID)
+			require.Equal(t, tc.nodeID, nodeID)
+			if ok {
+				require.Equal(t, tc.id, ExternalNodeID(docRefID, nodeID))
+			}
+		})
+	}
+}
+
+func TestExternalEdgeTargets(t *testing.T) {
+	nl := &NodeList{
+		Nodes: []*Node{{Id: "a"}, {Id: "b"}, {Id: "DocumentRef-resolved:c"}},
+		Edges: []*Edge{
+			{Type: Edge_dependsOn, From: "a", To: []string{"b", "DocumentRef-lib:x", "DocumentRef-resolved:c"}},
+			{Type: Edge_contains, From: "b", To: []string{"DocumentRef-lib:x", "missing"}},
+		},
+		RootElements: []string{"a"},
+	}
+	require.Equal(t, []string{"DocumentRef-lib:x"}, nl.GetExternalEdgeTargets())
+
+	// Cleaning the edges keeps the targets in external documents
+	nl.cleanEdges()
+	require.True(t, nl.GetEdgeByType("a", Edge_dependsOn).PointsTo("DocumentRef-lib:x"))
+	require.True(t, nl.GetEdgeByType("b", Edge_contains).PointsTo("DocumentRef-lib:x"))
+	require.False(t, nl.GetEdgeByType("b", Edge_contains).PointsTo("missing"))
+}
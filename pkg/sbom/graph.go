@@ -0,0 +1,301 @@
+package sbom
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// This file implements graph algorithms over the NodeList. All of them
+// follow edges in their natural direction, from the From node to its
+// destinations, and accept an optional list of edge types to consider. If
+// no edge types are specified, all edges are used. Edges pointing to nodes
+// not in the NodeList are ignored.
+
+// CycleError is returned when an operation requires an acyclic graph but
+// the NodeList has cycles. It includes one representative cycle for each
+// group of nodes that depend on each other.
+type CycleError struct {
+	Cycles [][]*Node
+}
+
+// Error implements the error interface
+func (e *CycleError) Error() string {
+	cycles := []string{}
+	for _, c := range e.Cycles {
+		ids := []string{}
+		for _, n := range c {
+			ids = append(ids, n.Id)
+		}
+		if len(ids) > 0 {
+			ids = append(ids, ids[0])
+		}
+		cycles = append(cycles, strings.Join(ids, " -> "))
+	}
+	return fmt.Sprintf("graph has %d cycles: %s", len(e.Cycles), strings.Join(cycles, ", "))
+}
+
+// adjacencyGraph is a compact representation of the NodeList graph where
+// nodes are referenced by their position in the nodes slice.
+type adjacencyGraph struct {
+	nodes []*Node
+	edges [][]int
+}
+
+// buildAdjacencyGraph computes the adjacency lists of the NodeList graph
+// using only the edges of the specified types. Nodes appear in the same
+// order as in the NodeList, duplicate IDs are only considered once.
+func (nl *NodeList) buildAdjacencyGraph(edgeTypes []Edge_Type) *adjacencyGraph {
+	g := &adjacencyGraph{
+		nodes: []*Node{},
+		edges: [][]int{},
+	}
+	positions := map[string]int{}
+	for _, n := range nl.Nodes {
+		if _, ok := positions[n.Id]; ok {
+			continue
+		}
+		positions[n.Id] = len(g.nodes)
+		g.nodes = append(g.nodes, n)
+		g.edges = append(g.edges, []int{})
+	}
+
+	types := edgeTypeSet(edgeTypes)
+	for _, e := range nl.Edges {
+		if _, ok := types[e.Type]; types != nil && !ok {
+			continue
+		}
+		from, ok := positions[e.From]
+		if !ok {
+			continue
+		}
+		for _, to := range e.To {
+			if pos, ok := positions[to]; ok {
+				g.edges[from] = append(g.edges[from], pos)
+			}
+		}
+	}
+	return g
+}
+
+// stronglyConnectedComponents runs Tarjan's algorithm on the graph and
+// returns the components as slices of node positions. The components are
+// returned in reverse topological order: a component is always listed
+// before any other component that has edges pointing to it. The algorithm
+// is implemented iteratively to support very deep graphs.
+func (g *adjacencyGraph) stronglyConnectedComponents() [][]int {
+	const unvisited = -1
+
+	index := make([]int, len(g.nodes))
+	lowlink := make([]int, len(g.nodes))
+	onStack := make([]bool, len(g.nodes))
+	for i := range index {
+		index[i] = unvisited
+	}
+
+	type frame struct {
+		node int
+		next int
+	}
+
+	counter := 0
+	stack := []int{}
+	components := [][]int{}
+
+	for start := range g.nodes {
+		if index[start] != unvisited {
+			continue
+		}
+
+		index[start], lowlink[start] = counter, counter
+		counter++
+		stack = append(stack, start)
+		onStack[start] = true
+		callStack := []frame{{node: start}}
+
+		for len(callStack) > 0 {
+			f := &callStack[len(callStack)-1]
+			v := f.node
+
+			// Visit the next neighbor of v
+			if f.next < len(g.edges[v]) {
+				w := g.edges[v][f.next]
+				f.next++
+				switch {
+				case index[w] == unvisited:
+					index[w], lowlink[w] = counter, counter
+					counter++
+					stack = append(stack, w)
+					onStack[w] = true
+					callStack = append(callStack, frame{node: w})
+				case onStack[w] && index[w] < lowlink[v]:
+					lowlink[v] = index[w]
+				}
+				continue
+			}
+
+			// All neighbors visited. If v is the root of a component, pop it.
+			if lowlink[v] == index[v] {
+				component := []int{}
+				for {
+					w := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[w] = false
+					component = append(component, w)
+					if w == v {
+						break
+					}
+				}
+				sort.Ints(component)
+				components = append(components, component)
+			}
+
+			callStack = callStack[:len(callStack)-1]
+			if len(callStack) > 0 {
+				parent := callStack[len(callStack)-1].node
+				if lowlink[v] < lowlink[parent] {
+					lowlink[parent] = lowlink[v]
+				}
+			}
+		}
+	}
+	return components
+}
+
+// isCyclic returns true if the component forms a cycle, that is if it has
+// more than one node or its single node points to itself.
+func (g *adjacencyGraph) isCyclic(component []int) bool {
+	if len(component) > 1 {
+		return true
+	}
+	for _, w := range g.edges[component[0]] {
+		if w == component[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// cycleInComponent returns a cycle that starts and ends at the first node of
+// a cyclic component. The path is computed with a breadth first search, so
+// it is the shortest cycle through that node.
+func (g *adjacencyGraph) cycleInComponent(component []int) []int {
+	members := map[int]struct{}{}
+	for _, v := range component {
+		members[v] = struct{}{}
+	}
+
+	start := component[0]
+	parents := map[int]int{start: start}
+	queue := []int{start}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, w := range g.edges[v] {
+			if w == start {
+				// Found the way back, rebuild the path
+				path := []int{}
+				for u := v; u != start; u = parents[u] {
+					path = append(path, u)
+				}
+				path = append(path, start)
+				for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+					path[i], path[j] = path[j], path[i]
+				}
+				return path
+			}
+			if _, ok := members[w]; !ok {
+				continue
+			}
+			if _, ok := parents[w]; ok {
+				continue
+			}
+			parents[w] = v
+			queue = append(queue, w)
+		}
+	}
+	return nil
+}
+
+// toNodes converts a list of positions to their nodes
+func (g *adjacencyGraph) toNodes(positions []int) []*Node {
+	ret := make([]*Node, 0, len(positions))
+	for _, p := range positions {
+		ret = append(ret, g.nodes[p])
+	}
+	return ret
+}
+
+// StronglyConnectedComponents returns the strongly connected components of
+// the NodeList graph. Each component is a group of nodes where every node
+// can be reached from any other one. Nodes not part of a cycle form a
+// component by themselves.
+//
+// Components are returned in dependency order: a component is always listed
+// before the components that have edges pointing to it. Nodes inside each
+// component keep their order in the NodeList.
+func (nl *NodeList) StronglyConnectedComponents(edgeTypes ...Edge_Type) [][]*Node {
+	g := nl.buildAdjacencyGraph(edgeTypes)
+	ret := [][]*Node{}
+	for _, c := range g.stronglyConnectedComponents() {
+		ret = append(ret, g.toNodes(c))
+	}
+	return ret
+}
+
+// FindCycles looks for cycles in the NodeList graph. It returns one cycle
+// for each group of nodes that depend on each other (see
+// StronglyConnectedComponents). Each cycle is a path of nodes where every
+// node has an edge to the next one and the last one points back to the first.
+// A node with an edge to itself is returned as a cycle of length one.
+func (nl *NodeList) FindCycles(edgeTypes ...Edge_Type) [][]*Node {
+	g := nl.buildAdjacencyGraph(edgeTypes)
+	return g.findCycles(g.stronglyConnectedComponents())
+}
+
+// findCycles returns a cycle for each of the cyclic components
+func (g *adjacencyGraph) findCycles(components [][]int) [][]*Node {
+	ret := [][]*Node{}
+	for _, c := range components {
+		if !g.isCyclic(c) {
+			continue
+		}
+		ret = append(ret, g.toNodes(g.cycleInComponent(c)))
+	}
+	return ret
+}
+
+// HasCycles returns true if the NodeList graph has at least one cycle
+func (nl *NodeList) HasCycles(edgeTypes ...Edge_Type) bool {
+	g := nl.buildAdjacencyGraph(edgeTypes)
+	for _, c := range g.stronglyConnectedComponents() {
+		if g.isCyclic(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// TopologicalSort returns the nodes of the NodeList ordered so that every
+// node comes after all the nodes it has edges to. When following dependency
+// edges this is a build order: dependencies are listed before the
+// components that use them.
+//
+// If the graph has cycles, a complete ordering is still returned along with
+// a *CycleError describing the cycles. In that case, the nodes of each cycle
+// are listed together, after everything the cycle depends on and before any
+// node depending on it. Nodes inside a cycle keep their NodeList order.
+func (nl *NodeList) TopologicalSort(edgeTypes ...Edge_Type) ([]*Node, error) {
+	g := nl.buildAdjacencyGraph(edgeTypes)
+	components := g.stronglyConnectedComponents()
+
+	ret := make([]*Node, 0, len(g.nodes))
+	for _, c := range components {
+		ret = append(ret, g.toNodes(c)...)
+	}
+
+	if cycles := g.findCycles(components); len(cycles) > 0 {
+		return ret, &CycleError{Cycles: cycles}
+	}
+	return ret, nil
+}
@@ -0,0 +1,151 @@
+package sbom
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func nodeIDs(nodes []*Node) []string {
+	ret := []string{}
+	for _, n := range nodes {
+		ret = append(ret, n.Id)
+	}
+	return ret
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	// log4j-core -> framework closes a loop through logging and README
+	// points to itself.
+	nl := sampleGraphNodeList()
+	nl.Edges = append(nl.Edges,
+		&Edge{Type: Edge_dependsOn, From: "log4j-core", To: []string{"framework"}},
+		&Edge{Type: Edge_devDependency, From: "README", To: []string{"README"}},
+	)
+	components := nl.StronglyConnectedComponents()
+	res := [][]string{}
+	for _, c := range components {
+		res = append(res, nodeIDs(c))
+	}
+	require.Equal(t, [][]string{
+		{"framework", "logging", "log4j-core"}, {"junit"}, {"compiler"}, {"README"},
+		{"product-a"}, {"plugin"}, {"product-b"}, {"unrelated"},
+	}, res)
+}
+
+func TestFindCycles(t *testing.T) {
+	// log4j-core -> framework closes a loop through logging and README
+	// points to itself.
+	nl := sampleGraphNodeList()
+	nl.Edges = append(nl.Edges,
+		&Edge{Type: Edge_dependsOn, From: "log4j-core", To: []string{"framework"}},
+		&Edge{Type: Edge_devDependency, From: "README", To: []string{"README"}},
+	)
+	for _, tc := range []struct {
+		name     string
+		sut      *NodeList
+		types    []Edge_Type
+		expected [][]string
+	}{
+		{
+			name:     "all edges",
+			sut:      nl,
+			expected: [][]string{{"framework", "logging", "log4j-core"}, {"README"}},
+		},
+		{
+			name:     "filtered edges",
+			sut:      nl,
+			types:    []Edge_Type{Edge_dependsOn},
+			expected: [][]string{{"framework", "logging", "log4j-core"}},
+		},
+		{
+			name: "shortest cycle",
+			sut: &NodeList{
+				Nodes: []*Node{{Id: "a"}, {Id: "b"}, {Id: "c"}},
+				Edges: []*Edge{
+					{Type: Edge_dependsOn, From: "a", To: []string{"b"}},
+					{Type: Edge_dependsOn, From: "b", To: []string{"c", "a"}},
+					{Type: Edge_dependsOn, From: "c", To: []string{"a"}},
+				},
+			},
+			expected: [][]string{{"a", "b"}},
+		},
+		{
+			name:     "acyclic",
+			sut:      sampleGraphNodeList(),
+			expected: [][]string{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res := [][]string{}
+			for _, c := range tc.sut.FindCycles(tc.types...) {
+				res = append(res, nodeIDs(c))
+			}
+			require.Equal(t, tc.expected, res)
+			require.Equal(t, len(tc.expected) > 0, tc.sut.HasCycles(tc.types...))
+		})
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	t.Run("acyclic", func(t *testing.T) {
+		nl := sampleGraphNodeList()
+		sorted, err := nl.TopologicalSort()
+		require.NoError(t, err)
+		require.Len(t, sorted, len(nl.Nodes))
+
+		// Every node must come after the nodes it points to
+		positions := map[string]int{}
+		for i, n := range sorted {
+			positions[n.Id] = i
+		}
+		for _, e := range nl.Edges {
+			for _, to := range e.To {
+				require.Less(t, positions[to], positions[e.From], "%s -> %s", e.From, to)
+			}
+		}
+	})
+
+	t.Run("cyclic", func(t *testing.T) {
+		// log4j-core -> framework closes a loop through logging and README
+		// points to itself.
+		nl := sampleGraphNodeList()
+		nl.Edges = append(nl.Edges,
+			&Edge{Type: Edge_dependsOn, From: "log4j-core", To: []string{"framework"}},
+			&Edge{Type: Edge_devDependency, From: "README", To: []string{"README"}},
+		)
+		sorted, err := nl.TopologicalSort()
+		require.Equal(t, []string{
+			"framework", "logging", "log4j-core", "junit", "compiler", "README",
+			"product-a", "plugin", "product-b", "unrelated",
+		}, nodeIDs(sorted))
+
+		cerr := &CycleError{}
+		require.ErrorAs(t, err, &cerr)
+		require.Len(t, cerr.Cycles, 2)
+		require.Contains(t, err.Error(), "framework -> logging -> log4j-core -> framework")
+	})
+}
+
+func TestStronglyConnectedComponentsDeepGraph(t *testing.T) {
+	// A long chain must not overflow the stack
+	const size = 200000
+	nl := NewNodeList()
+	for i := 0; i < size; i++ {
+		nl.Nodes = append(nl.Nodes, &Node{Id: fmt.Sprintf("node-%d", i)})
+		if i > 0 {
+			nl.Edges = append(nl.Edges, &Edge{
+				Type: Edge_dependsOn, From: fmt.Sprintf("node-%d", i-1), To: []string{fmt.Sprintf("node-%d", i)},
+			})
+		}
+	}
+	nl.Edges = append(nl.Edges, &Edge{
+		Type: Edge_dependsOn, From: fmt.Sprintf("node-%d", size-1), To: []string{"node-0"},
+	})
+
+	components := nl.StronglyConnectedComponents()
+	require.Len(t, components, 1)
+	require.Len(t, components[0], size)
+	require.True(t, nl.HasCycles())
+}
@@ -0,0 +1,375 @@
+package sbom
+
+import (
+	"fmt"
+	"slices"
+)
+
+// graphIndex indexes the nodes of a NodeList by ID and its edges by their
+// origin and destinations. The NodeList graph functions build one for each
+// call while IndexedNodeList keeps one up to date as the list is modified.
+type graphIndex struct {
+	// nodes indexes the first node with each ID
+	nodes map[string]*Node
+
+	// from indexes the edges by their From element, in NodeList order
+	from map[string][]*Edge
+
+	// to indexes the edges by each of their destinations. An edge may be
+	// listed more than once if it has duplicate destinations.
+	to map[string][]*Edge
+
+	// roots indexes the root elements of the NodeList
+	roots rootElementsIndex
+}
+
+// newGraphIndex returns a graphIndex of the nodes and edges in nl
+func newGraphIndex(nl *NodeList) *graphIndex {
+	idx := &graphIndex{
+		nodes: make(map[string]*Node, len(nl.Nodes)),
+		from:  make(map[string][]*Edge, len(nl.Edges)),
+		to:    make(map[string][]*Edge, len(nl.Nodes)),
+		roots: nl.indexRootElements(),
+	}
+	for _, n := range nl.Nodes {
+		idx.addNode(n)
+	}
+	for _, e := range nl.Edges {
+		idx.addEdge(e)
+	}
+	return idx
+}
+
+// addNode indexes node n unless there is already a node with its ID
+func (idx *graphIndex) addNode(n *Node) {
+	if _, ok := idx.nodes[n.Id]; !ok {
+		idx.nodes[n.Id] = n
+	}
+}
+
+// addEdge indexes edge e by its origin and destinations
+func (idx *graphIndex) addEdge(e *Edge) {
+	idx.from[e.From] = append(idx.from[e.From], e)
+	idx.addDestinations(e, e.To...)
+}
+
+// addDestinations indexes ids as destinations of edge e
+func (idx *graphIndex) addDestinations(e *Edge, ids ...string) {
+	for _, id := range ids {
+		idx.to[id] = append(idx.to[id], e)
+	}
+}
+
+// removeEdge removes edge e from the index
+func (idx *graphIndex) removeEdge(e *Edge) {
+	isEdge := func(e2 *Edge) bool { return e2 == e }
+	idx.from[e.From] = slices.DeleteFunc(idx.from[e.From], isEdge)
+	if len(idx.from[e.From]) == 0 {
+		delete(idx.from, e.From)
+	}
+	for _, id := range e.To {
+		idx.to[id] = slices.DeleteFunc(idx.to[id], isEdge)
+		if len(idx.to[id]) == 0 {
+			delete(idx.to, id)
+		}
+	}
+}
+
+// IndexedNodeList wraps a NodeList and keeps indexes of its nodes by ID and
+// of its edges by their origin and destinations. Looking up nodes and walking
+// the graph through the wrapper does not require scanning the NodeList, which
+// makes it suitable to build or query large SBOMs.
+//
+// The indexes are updated incrementally when the NodeList is modified through
+// the IndexedNodeList methods. After changing the wrapped NodeList directly,
+// call Reindex() before using the wrapper again. IndexedNodeList is not safe
+// for concurrent use, see DocumentBuilder for a synchronized alternative.
+type IndexedNodeList struct {
+	nl  *NodeList
+	idx *graphIndex
+}
+
+// NewIndexedNodeList returns an IndexedNodeList wrapping nl. If nl is nil a
+// new empty NodeList is created.
+func NewIndexedNodeList(nl *NodeList) *IndexedNodeList {
+	if nl == nil {
+		nl = NewNodeList()
+	}
+	inl := &IndexedNodeList{nl: nl}
+	inl.Reindex()
+	return inl
+}
+
+// NodeList returns the wrapped NodeList
+func (inl *IndexedNodeList) NodeList() *NodeList {
+	return inl.nl
+}
+
+// Reindex rebuilds the indexes from the wrapped NodeList. It needs to be
+// called after modifying the NodeList without going through the wrapper.
+func (inl *IndexedNodeList) Reindex() {
+	inl.idx = newGraphIndex(inl.nl)
+}
+
+// GetNodeByID returns the node with the specified ID
+func (inl *IndexedNodeList) GetNodeByID(id string) *Node {
+	return inl.idx.nodes[id]
+}
+
+// GetEdgeByType returns the first edge of the specified type (t) originating
+// from the given node ID (fromElement). If no such edge is found, it returns nil.
+func (inl *IndexedNodeList) GetEdgeByType(fromElement string, t Edge_Type) *Edge {
+	for _, e := range inl.idx.from[fromElement] {
+		if e.Type == t {
+			return e
+		}
+	}
+	return nil
+}
+
+// AddNode adds a new node to the NodeList
+func (inl *IndexedNodeList) AddNode(n *Node) {
+	inl.nl.AddNode(n)
+	inl.idx.addNode(n)
+}
+
+// AddRootNode adds a node to the NodeList and registers it as a root element.
+// See NodeList.AddRootNode for details.
+func (inl *IndexedNodeList) AddRootNode(n *Node) {
+	if n.Id == "" {
+		return
+	}
+	if _, ok := inl.idx.roots[n.Id]; ok {
+		return
+	}
+	inl.AddNode(n)
+	inl.nl.RootElements = append(inl.nl.RootElements, n.Id)
+	inl.idx.roots[n.Id] = struct{}{}
+}
+
+// AddEdge adds a new edge to the NodeList
+func (inl *IndexedNodeList) AddEdge(e *Edge) {
+	inl.nl.AddEdge(e)
+	inl.idx.addEdge(e)
+}
+
+// addDestinations adds the ids missing from the destinations of edge e
+func (inl *IndexedNodeList) addDestinations(e *Edge, ids ...string) {
+	l := len(e.To)
+	e.AddDestinationById(ids...)
+	inl.idx.addDestinations(e, e.To[l:]...)
+}
+
+// RemoveNodes removes the nodes with the specified IDs from the NodeList.
+// Edges originating from the removed nodes are deleted and the removed nodes
+// are dropped from the destinations of the rest, deleting the edges left
+// without destinations. Unlike NodeList.RemoveNodes, the remaining edges are
+// not otherwise cleaned or merged.
+func (inl *IndexedNodeList) RemoveNodes(ids []string) {
+	removed := map[string]struct{}{}
+	for _, id := range ids {
+		if _, ok := inl.idx.nodes[id]; ok {
+			removed[id] = struct{}{}
+		}
+	}
+	if len(removed) == 0 {
+		return
+	}
+
+	nodes := make([]*Node, 0, len(inl.nl.Nodes))
+	for _, n := range inl.nl.Nodes {
+		if _, ok := removed[n.Id]; !ok {
+			nodes = append(nodes, n)
+		}
+	}
+	inl.nl.Nodes = nodes
+
+	deadEdges := map[*Edge]struct{}{}
+	for id := range removed {
+		delete(inl.idx.nodes, id)
+		for _, e := range inl.idx.from[id] {
+			deadEdges[e] = struct{}{}
+		}
+		for _, e := range inl.idx.to[id] {
+			e.To = slices.DeleteFunc(e.To, func(to string) bool { return to == id })
+			if len(e.To) == 0 {
+				deadEdges[e] = struct{}{}
+			}
+		}
+		delete(inl.idx.to, id)
+	}
+
+	if len(deadEdges) == 0 {
+		return
+	}
+	for e := range deadEdges {
+		inl.idx.removeEdge(e)
+	}
+	inl.nl.Edges = slices.DeleteFunc(inl.nl.Edges, func(e *Edge) bool {
+		_, ok := deadEdges[e]
+		return ok
+	})
+}
+
+// Add combines the nodes and edges from nl2 into the NodeList. Nodes already
+// in the list are augmented with the data from nl2, edges equivalent to the
+// existing ones are merged into them and the rest are copied. Unlike
+// NodeList.Add, the existing edges are not otherwise cleaned.
+func (inl *IndexedNodeList) Add(nl2 *NodeList) {
+	for _, n := range nl2.Nodes {
+		if existing, ok := inl.idx.nodes[n.Id]; ok {
+			existing.Augment(n)
+			continue
+		}
+		inl.AddNode(n)
+	}
+
+	for _, e := range nl2.Edges {
+		if existing := equivalentEdge(inl.idx.from[e.From], e); existing != nil {
+			inl.addDestinations(existing, e.To...)
+			continue
+		}
+		inl.AddEdge(e.Copy())
+	}
+
+	for _, id := range nl2.RootElements {
+		if _, ok := inl.idx.roots[id]; ok {
+			continue
+		}
+		inl.nl.RootElements = append(inl.nl.RootElements, id)
+		inl.idx.roots[id] = struct{}{}
+	}
+}
+
+// Union returns a new IndexedNodeList with the union of the NodeList and
+// nl2. See NodeList.Union for details.
+func (inl *IndexedNodeList) Union(nl2 *NodeList) *IndexedNodeList {
+	return NewIndexedNodeList(inl.nl.Union(nl2))
+}
+
+// RelateNodeAtID adds node n to the NodeList and relates it to the node
+// identified by nodeID with an edge of type edgeType. See
+// NodeList.RelateNodeAtID for details.
+func (inl *IndexedNodeList) RelateNodeAtID(n *Node, nodeID string, edgeType Edge_Type) error {
+	if _, ok := inl.idx.nodes[nodeID]; !ok {
+		return fmt.Errorf("node with ID %s not found", nodeID)
+	}
+
+	if edge := inl.GetEdgeByType(nodeID, edgeType); edge != nil {
+		// Perhaps we should filter these
+		edge.To = append(edge.To, n.Id)
+		inl.idx.addDestinations(edge, n.Id)
+	} else {
+		inl.AddEdge(&Edge{
+			Type: edgeType,
+			From: nodeID,
+			To:   []string{n.Id},
+		})
+	}
+
+	if _, ok := inl.idx.nodes[n.Id]; !ok {
+		inl.AddNode(n)
+	}
+	return nil
+}
+
+// RelateNodeListAtID adds the nodes and edges of nl2 to the NodeList and
+// relates its root elements to the node identified by nodeID. See
+// NodeList.RelateNodeListAtID for details.
+func (inl *IndexedNodeList) RelateNodeListAtID(nl2 *NodeList, nodeID string, edgeType Edge_Type) error {
+	if _, ok := inl.idx.nodes[nodeID]; !ok {
+		return fmt.Errorf("node with ID %s not found", nodeID)
+	}
+
+	// Look up the edges that already exist before modifying the nodelist
+	existingEdges := make([]*Edge, len(nl2.Edges))
+	for i, e := range nl2.Edges {
+		existingEdges[i] = inl.GetEdgeByType(e.From, e.Type)
+	}
+
+	if edge := inl.GetEdgeByType(nodeID, edgeType); edge != nil {
+		// Perhaps we should filter these
+		inl.addDestinations(edge, nl2.RootElements...)
+	} else {
+		inl.AddEdge(&Edge{
+			Type: edgeType,
+			From: nodeID,
+			To:   nl2.RootElements,
+		})
+	}
+
+	for _, n := range nl2.Nodes {
+		if _, ok := inl.idx.nodes[n.Id]; ok {
+			continue
+		}
+		inl.AddNode(n)
+	}
+
+	// Copy the remaining edges from nl2, reusing the edges of the same
+	// type that already exist in the nodelist.
+	for i, e := range nl2.Edges {
+		if existingEdges[i] != nil {
+			inl.addDestinations(existingEdges[i], e.To...)
+			continue
+		}
+		inl.AddEdge(e.Copy())
+	}
+
+	return nil
+}
+
+// NodeSiblings returns a new NodeList containing the specified node at the
+// root and a graph fragment with its immediate siblings with their edges
+// preserved. See NodeList.NodeSiblings for details.
+func (inl *IndexedNodeList) NodeSiblings(id string) *NodeList {
+	if id == "" {
+		return nil
+	}
+
+	nodelist := &NodeList{}
+	node := inl.idx.nodes[id]
+	if node == nil {
+		return nodelist
+	}
+
+	nodelist.RootElements = append(nodelist.RootElements, node.Id)
+	ni := nodeIndex{node.Id: node}
+	nodelist.Nodes = append(nodelist.Nodes, node)
+	for _, e := range inl.idx.from[id] {
+		for _, to := range e.To {
+			if _, ok := ni[to]; ok {
+				continue
+			}
+			if n := inl.idx.nodes[to]; n != nil {
+				ni[to] = n
+				nodelist.Nodes = append(nodelist.Nodes, n)
+			}
+		}
+		nodelist.Edges = append(nodelist.Edges, e)
+	}
+
+	nodelist.cleanEdges()
+	return nodelist
+}
+
+// NodeGraph returns a new NodeList with the graph of the node identified by
+// id. See NodeList.NodeGraph for details on the traversal and its options.
+// The nodes in the returned list are sorted in the order they were reached.
+// If the node is not found, it returns nil.
+func (inl *IndexedNodeList) NodeGraph(id string, opts ...TraversalOption) *NodeList {
+	return inl.idx.nodeGraph(id, opts)
+}
+
+// NodeDescendants returns a new NodeList with the descendants of the node
+// identified by id at a maximal distance of maxDepth levels. See
+// NodeList.NodeDescendants for details.
+func (inl *IndexedNodeList) NodeDescendants(id string, maxDepth int, opts ...TraversalOption) *NodeList {
+	return inl.idx.descendants(id, maxDepth, opts)
+}
+
+// NodeAncestors returns a new NodeList with the ancestors of the node
+// identified by id at a maximal distance of maxDepth edges. See
+// NodeList.NodeAncestors for details.
+func (inl *IndexedNodeList) NodeAncestors(id string, maxDepth int, opts ...TraversalOption) *NodeList {
+	return inl.idx.ancestors(id, maxDepth, opts)
+}
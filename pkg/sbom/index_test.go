@@ -0,0 +1,226 @@
+package sbom
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// syntheticNodeList builds a tree of size nodes where each node has up to
+// fanout children.
+func syntheticNodeList(size, fanout int) *NodeList {
+	nl := NewNodeList()
+	for i := 0; i < size; i++ {
+		nl.Nodes = append(nl.Nodes, &Node{Id: fmt.Sprintf("node-%d", i), Name: fmt.Sprintf("package-%d", i)})
+	}
+	for i := 0; i < size; i++ {
+		e := &Edge{Type: Edge_dependsOn, From: fmt.Sprintf("node-%d", i), To: []string{}}
+		for j := i*fanout + 1; j <= i*fanout+fanout && j < size; j++ {
+			e.To = append(e.To, fmt.Sprintf("node-%d", j))
+		}
+		if len(e.To) > 0 {
+			nl.Edges = append(nl.Edges, e)
+		}
+	}
+	nl.RootElements = []string{"node-0"}
+	return nl
+}
+
+// requireIndexCurrent checks that the incrementally updated index of inl
+// matches one built from scratch.
+func requireIndexCurrent(t *testing.T, inl *IndexedNodeList) {
+	t.Helper()
+	fresh := newGraphIndex(inl.nl)
+	require.Equal(t, fresh.nodes, inl.idx.nodes)
+	require.Equal(t, fresh.roots, inl.idx.roots)
+	require.Len(t, inl.idx.from, len(fresh.from))
+	for id, edges := range fresh.from {
+		require.ElementsMatch(t, edges, inl.idx.from[id], id)
+	}
+	require.Len(t, inl.idx.to, len(fresh.to))
+	for id, edges := range fresh.to {
+		require.ElementsMatch(t, edges, inl.idx.to[id], id)
+	}
+}
+
+func TestIndexedNodeList(t *testing.T) {
+	inl := NewIndexedNodeList(syntheticNodeList(10, 3))
+	require.Equal(t, "package-5", inl.GetNodeByID("node-5").Name)
+	require.Nil(t, inl.GetNodeByID("node-50"))
+
+	// Changes made through the wrapper are reflected in the index
+	inl.AddNode(&Node{Id: "node-50"})
+	require.NotNil(t, inl.GetNodeByID("node-50"))
+
+	inl.AddEdge(&Edge{Type: Edge_contains, From: "node-9", To: []string{"node-50"}})
+	require.NotNil(t, inl.GetEdgeByType("node-9", Edge_contains))
+	require.Len(t, inl.NodeSiblings("node-9").Nodes, 2)
+
+	requireIndexCurrent(t, inl)
+
+	inl.RemoveNodes([]string{"node-50", "node-3"})
+	requireIndexCurrent(t, inl)
+	require.Nil(t, inl.GetNodeByID("node-50"))
+	require.Nil(t, inl.GetNodeByID("node-3"))
+	require.Nil(t, inl.GetEdgeByType("node-9", Edge_contains))
+	require.Nil(t, inl.GetEdgeByType("node-3", Edge_dependsOn))
+
+	require.NoError(t, inl.RelateNodeAtID(&Node{Id: "new"}, "node-1", Edge_contains))
+	require.Error(t, inl.RelateNodeAtID(&Node{Id: "new"}, "node-3", Edge_contains))
+	require.NotNil(t, inl.GetNodeByID("new"))
+	require.Equal(t, []string{"new"}, inl.GetEdgeByType("node-1", Edge_contains).To)
+
+	require.NoError(t, inl.RelateNodeListAtID(&NodeList{
+		Nodes:        []*Node{{Id: "other-0"}, {Id: "other-1"}, {Id: "new"}},
+		Edges:        []*Edge{{Type: Edge_dependsOn, From: "other-0", To: []string{"other-1", "new"}}},
+		RootElements: []string{"other-0"},
+	}, "node-2", Edge_contains))
+	require.NotNil(t, inl.GetNodeByID("other-1"))
+	require.NotNil(t, inl.GetEdgeByType("other-0", Edge_dependsOn))
+	requireIndexCurrent(t, inl)
+
+	// The wrapper returns the same results as the NodeList
+	nl := inl.NodeList()
+	require.Len(t, nl.Nodes, 12)
+	for _, n := range nl.Nodes {
+		require.Same(t, nl.GetNodeByID(n.Id), inl.GetNodeByID(n.Id))
+		require.True(t, nl.NodeSiblings(n.Id).Equal(inl.NodeSiblings(n.Id)), n.Id)
+		require.True(t, nl.NodeGraph(n.Id).Equal(inl.NodeGraph(n.Id)), n.Id)
+		require.True(t, nl.NodeGraph(n.Id, WithDirection(TraverseBoth)).Equal(inl.NodeGraph(n.Id, WithDirection(TraverseBoth))), n.Id)
+		require.True(t, nl.NodeDescendants(n.Id, 3).Equal(inl.NodeDescendants(n.Id, 3)), n.Id)
+		require.True(t, nl.NodeAncestors(n.Id, 0).Equal(inl.NodeAncestors(n.Id, 0)), n.Id)
+	}
+	require.Nil(t, inl.NodeGraph("nope"))
+
+	// Direct changes to the NodeList need a reindex
+	nl.Nodes[5] = &Node{Id: "replaced"}
+	nl.Edges = []*Edge{}
+	inl.Reindex()
+	require.NotNil(t, inl.GetNodeByID("replaced"))
+	require.Nil(t, inl.GetNodeByID("node-6"))
+	require.Nil(t, inl.GetEdgeByType("node-1", Edge_dependsOn))
+}
+
+func TestIndexedNodeListAddAndUnion(t *testing.T) {
+	inl := NewIndexedNodeList(syntheticNodeList(10, 3))
+	nl2 := syntheticNodeList(15, 2)
+
+	union := inl.Union(nl2)
+	inl.Add(nl2)
+	requireIndexCurrent(t, inl)
+	for _, res := range []*IndexedNodeList{inl, union} {
+		require.Len(t, res.NodeList().Nodes, 15)
+		for i := 0; i < 15; i++ {
+			id := fmt.Sprintf("node-%d", i)
+			require.NotNil(t, res.GetNodeByID(id), id)
+		}
+		for _, e := range res.NodeList().Edges {
+			require.Same(t, e, res.GetEdgeByType(e.From, e.Type))
+		}
+	}
+}
+
+func benchmarkIndexes(b *testing.B, linear func(*NodeList), indexed func(*IndexedNodeList)) {
+	for _, size := range []int{1000, 10000, 100000} {
+		nl := syntheticNodeList(size, 4)
+		inl := NewIndexedNodeList(nl)
+		b.Run(fmt.Sprintf("indexed-%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				indexed(inl)
+			}
+		})
+		if size > 10000 {
+			// Unindexed runs take too long on large graphs
+			continue
+		}
+		b.Run(fmt.Sprintf("linear-%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				linear(nl)
+			}
+		})
+	}
+}
+
+func BenchmarkGetNodeByID(b *testing.B) {
+	benchmarkIndexes(b,
+		func(nl *NodeList) { nl.GetNodeByID(fmt.Sprintf("node-%d", len(nl.Nodes)-1)) },
+		func(inl *IndexedNodeList) { inl.GetNodeByID(fmt.Sprintf("node-%d", len(inl.nl.Nodes)-1)) },
+	)
+}
+
+func BenchmarkNodeSiblings(b *testing.B) {
+	benchmarkIndexes(b,
+		func(nl *NodeList) { nl.NodeSiblings(fmt.Sprintf("node-%d", len(nl.Nodes)/8)) },
+		func(inl *IndexedNodeList) { inl.NodeSiblings(fmt.Sprintf("node-%d", len(inl.nl.Nodes)/8)) },
+	)
+}
+
+func BenchmarkNodeGraph(b *testing.B) {
+	benchmarkIndexes(b,
+		func(nl *NodeList) { nl.NodeGraph(fmt.Sprintf("node-%d", len(nl.Nodes)/64)) },
+		func(inl *IndexedNodeList) { inl.NodeGraph(fmt.Sprintf("node-%d", len(inl.nl.Nodes)/64)) },
+	)
+}
+
+func BenchmarkRelateNodeAtID(b *testing.B) {
+	// Builds a graph one node at a time, as the unserializers do
+	for _, size := range []int{1000, 10000, 100000} {
+		b.Run(fmt.Sprintf("indexed-%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				inl := NewIndexedNodeList(nil)
+				inl.AddRootNode(&Node{Id: "node-0"})
+				for j := 1; j < size; j++ {
+					if err := inl.RelateNodeAtID(
+						&Node{Id: fmt.Sprintf("node-%d", j)}, fmt.Sprintf("node-%d", (j-1)/4), Edge_dependsOn,
+					); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		if size > 1000 {
+			// Building the graph without an index is quadratic
+			continue
+		}
+		b.Run(fmt.Sprintf("linear-%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				nl := NewNodeList()
+				nl.AddRootNode(&Node{Id: "node-0"})
+				for j := 1; j < size; j++ {
+					if err := nl.RelateNodeAtID(
+						&Node{Id: fmt.Sprintf("node-%d", j)}, fmt.Sprintf("node-%d", (j-1)/4), Edge_dependsOn,
+					); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestIndexedNodeListRemoveNodes(t *testing.T) {
+	inl := NewIndexedNodeList(sampleGraphNodeList())
+	inl.RemoveNodes([]string{"logging", "junit", "nope"})
+	requireIndexCurrent(t, inl)
+
+	nl := inl.NodeList()
+	require.Len(t, nl.Nodes, 8)
+	require.Nil(t, inl.GetNodeByID("logging"))
+
+	// Edges from the removed nodes are deleted, and so are the edges left
+	// without destinations.
+	require.Nil(t, inl.GetEdgeByType("logging", Edge_dependsOn))
+	require.Nil(t, inl.GetEdgeByType("framework", Edge_dependsOn))
+	require.Nil(t, inl.GetEdgeByType("product-a", Edge_testDependency))
+	require.Equal(t, []string{"log4j-core"}, inl.GetEdgeByType("plugin", Edge_dependsOn).To)
+	require.Len(t, nl.Edges, 5)
+	require.Empty(t, nl.Validate().WithSeverity(SeverityError))
+}
+
+func BenchmarkNodeAncestors(b *testing.B) {
+	benchmarkIndexes(b,
+		func(nl *NodeList) { nl.NodeAncestors(fmt.Sprintf("node-%d", len(nl.Nodes)-1), 0) },
+		func(inl *IndexedNodeList) { inl.NodeAncestors(fmt.Sprintf("node-%d", len(inl.nl.Nodes)-1), 0) },
+	)
+}
@@ -0,0 +1,52 @@
+package sbom
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/protobom/protobom/pkg/license"
+)
+
+// LicenseExpressions parses the node licenses as SPDX license expressions.
+// Licenses that cannot be parsed are skipped and reported in the returned
+// error, the rest of the expressions are returned anyway.
+func (n *Node) LicenseExpressions() ([]license.Expression, error) {
+	ret := []license.Expression{}
+	errs := []error{}
+	for _, l := range n.Licenses {
+		e, err := license.Parse(l)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("parsing license %q: %w", l, err))
+			continue
+		}
+		ret = append(ret, e)
+	}
+	return ret, errors.Join(errs...)
+}
+
+// LicenseExpression returns the node licenses combined in a single SPDX
+// expression. As all the licenses apply to the component, they are joined
+// with the AND operator. If the node has no licenses, nil is returned.
+func (n *Node) LicenseExpression() (license.Expression, error) {
+	exprs, err := n.LicenseExpressions()
+	if err != nil {
+		return nil, err
+	}
+	return license.Join(license.And, exprs...), nil
+}
+
+// LicenseConcludedExpression parses the concluded license of the node. If
+// there is no concluded license or it is NOASSERTION, nil is returned.
+func (n *Node) LicenseConcludedExpression() (license.Expression, error) {
+	if n.LicenseConcluded == "" {
+		return nil, nil
+	}
+	e, err := license.Parse(n.LicenseConcluded)
+	if err != nil {
+		return nil, fmt.Errorf("parsing concluded license: %w", err)
+	}
+	if l, ok := e.(*license.License); ok && l.ID == license.NoAssertion {
+		return nil, nil
+	}
+	return e, nil
+}
@@ -0,0 +1,53 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/protobom/protobom/pkg/license"
+)
+
+func TestLicenseExpressions(t *testing.T) {
+	n := &Node{Licenses: []string{"MIT", "Apache-2.0 OR ISC", "Apache License"}}
+	exprs, err := n.LicenseExpressions()
+	require.Error(t, err)
+	require.ErrorIs(t, err, license.ErrInvalidExpression)
+	require.Len(t, exprs, 2)
+
+	_, err = n.LicenseExpression()
+	require.Error(t, err)
+
+	n.Licenses = n.Licenses[:2]
+	e, err := n.LicenseExpression()
+	require.NoError(t, err)
+	require.Equal(t, "MIT AND (Apache-2.0 OR ISC)", e.String())
+
+	n.Licenses = []string{}
+	e, err = n.LicenseExpression()
+	require.NoError(t, err)
+	require.Nil(t, e)
+}
+
+func TestLicenseConcludedExpression(t *testing.T) {
+	for concluded, expected := range map[string]string{
+		"":                    "",
+		"NOASSERTION":         "",
+		"NONE":                "NONE",
+		"gpl-2.0+ OR MIT":     "GPL-2.0+ OR MIT",
+		"LicenseRef-internal": "LicenseRef-internal",
+	} {
+		t.Run(concluded, func(t *testing.T) {
+			e, err := (&Node{LicenseConcluded: concluded}).LicenseConcludedExpression()
+			require.NoError(t, err)
+			if expected == "" {
+				require.Nil(t, e)
+				return
+			}
+			require.Equal(t, expected, e.String())
+		})
+	}
+
+	_, err := (&Node{LicenseConcluded: "MIT AND"}).LicenseConcludedExpression()
+	require.Error(t, err)
+}
@@ -27,13 +27,20 @@ func NewNode() *Node {
 		FileTypes:          []string{},
 		Identifiers:        map[int32]string{},
 		Hashes:             map[int32]string{},
+		Properties:         []*Property{},
+		Annotations:        []*Annotation{},
 	}
 }
 
 // Update updates the node's fields with values from an external node (n2).
 // It skips empty, null, or zero-length lists in n2, preserving the existing values in the current Node (n).
 // All other fields in n are overwritten with values from n2.
+// If n2 has provenance data, the fields taken from it are recorded in the
+// provenance of n.
 func (n *Node) Update(n2 *Node) {
+	if len(n2.GetProvenance()) > 0 {
+		defer n.trackMerge(n.Copy(), n2)
+	}
 	if n2.Name != "" {
 		n.Name = n2.Name
 	}
@@ -106,11 +113,52 @@ func (n *Node) Update(n2 *Node) {
 	if len(n2.FileTypes) > 0 {
 		n.FileTypes = n2.FileTypes
 	}
+	if len(n2.Properties) > 0 {
+		n.Properties = n2.Properties
+	}
+	if len(n2.Annotations) > 0 {
+		n.Annotations = n2.Annotations
+	}
+	if n2.ByteRange != nil {
+		n.ByteRange = n2.ByteRange
+	}
+	if n2.LineRange != nil {
+		n.LineRange = n2.LineRange
+	}
+	if len(n2.Endpoints) > 0 {
+		n.Endpoints = n2.Endpoints
+	}
+	if n2.Authenticated != nil {
+		n.Authenticated = n2.Authenticated
+	}
+	if n2.CrossesTrustBoundary != nil {
+		n.CrossesTrustBoundary = n2.CrossesTrustBoundary
+	}
+	if len(n2.DataFlows) > 0 {
+		n.DataFlows = n2.DataFlows
+	}
+	if len(n2.Commits) > 0 {
+		n.Commits = n2.Commits
+	}
+	if n2.PedigreeNotes != "" {
+		n.PedigreeNotes = n2.PedigreeNotes
+	}
+	if n2.Evidence != nil {
+		n.Evidence = n2.Evidence
+	}
+	if n2.Patch != nil {
+		n.Patch = n2.Patch
+	}
 }
 
 // Augment takes updates fields in n with data from n2 which is not already defined
 // (not empty string, not 0 length string, not nill pointer).
+// As in Update, the fields taken from n2 are recorded in the node provenance
+// when n2 has provenance data.
 func (n *Node) Augment(n2 *Node) {
+	if len(n2.GetProvenance()) > 0 {
+		defer n.trackMerge(n.Copy(), n2)
+	}
 	if n.Name == "" && n2.Name != "" {
 		n.Name = n2.Name
 	}
@@ -183,6 +231,42 @@ func (n *Node) Augment(n2 *Node) {
 	if len(n.FileTypes) == 0 && len(n2.FileTypes) > 0 {
 		n.FileTypes = n2.FileTypes
 	}
+	if len(n.Properties) == 0 && len(n2.Properties) > 0 {
+		n.Properties = n2.Properties
+	}
+	if len(n.Annotations) == 0 && len(n2.Annotations) > 0 {
+		n.Annotations = n2.Annotations
+	}
+	if n.ByteRange == nil && n2.ByteRange != nil {
+		n.ByteRange = n2.ByteRange
+	}
+	if n.LineRange == nil && n2.LineRange != nil {
+		n.LineRange = n2.LineRange
+	}
+	if len(n.Endpoints) == 0 && len(n2.Endpoints) > 0 {
+		n.Endpoints = n2.Endpoints
+	}
+	if n.Authenticated == nil && n2.Authenticated != nil {
+		n.Authenticated = n2.Authenticated
+	}
+	if n.CrossesTrustBoundary == nil && n2.CrossesTrustBoundary != nil {
+		n.CrossesTrustBoundary = n2.CrossesTrustBoundary
+	}
+	if len(n.DataFlows) == 0 && len(n2.DataFlows) > 0 {
+		n.DataFlows = n2.DataFlows
+	}
+	if len(n.Commits) == 0 && len(n2.Commits) > 0 {
+		n.Commits = n2.Commits
+	}
+	if n.PedigreeNotes == "" && n2.PedigreeNotes != "" {
+		n.PedigreeNotes = n2.PedigreeNotes
+	}
+	if n.Evidence == nil && n2.Evidence != nil {
+		n.Evidence = n2.Evidence
+	}
+	if n.Patch == nil && n2.Patch != nil {
+		n.Patch = n2.Patch
+	}
 }
 
 // Copy returns a duplicate of the Node.
@@ -214,6 +298,20 @@ func (n *Node) Copy() *Node {
 		ExternalReferences: []*ExternalReference{},
 		Identifiers:        maps.Clone(n.Identifiers),
 		FileTypes:          slices.Clone(n.FileTypes),
+		Properties:         []*Property{},
+		Annotations:        []*Annotation{},
+		ByteRange:          n.ByteRange.Copy(),
+		LineRange:          n.LineRange.Copy(),
+
+		Endpoints:            slices.Clone(n.Endpoints),
+		Authenticated:        copyFlag(n.Authenticated),
+		CrossesTrustBoundary: copyFlag(n.CrossesTrustBoundary),
+		DataFlows:            []*DataFlow{},
+
+		Commits:       []*Commit{},
+		PedigreeNotes: n.PedigreeNotes,
+		Evidence:      n.Evidence.Copy(),
+		Patch:         n.Patch.Copy(),
 	}
 
 	if n.ReleaseDate != nil {
@@ -235,6 +333,21 @@ func (n *Node) Copy() *Node {
 	for _, e := range n.ExternalReferences {
 		no.ExternalReferences = append(no.ExternalReferences, e.Copy())
 	}
+	for _, p := range n.Properties {
+		no.Properties = append(no.Properties, p.Copy())
+	}
+	for _, a := range n.Annotations {
+		no.Annotations = append(no.Annotations, a.Copy())
+	}
+	for _, p := range n.Provenance {
+		no.Provenance = append(no.Provenance, p.Copy())
+	}
+	for _, df := range n.DataFlows {
+		no.DataFlows = append(no.DataFlows, df.Copy())
+	}
+	for _, c := range n.Commits {
+		no.Commits = append(no.Commits, c.Copy())
+	}
 
 	return no
 }
@@ -265,6 +378,29 @@ func (n *Node) flatString() string {
 			for _, i := range n.Originators {
 				pairs = append(pairs, fmt.Sprintf("originator:%s", i.flatString()))
 			}
+		case "protobom.protobom.Node.properties":
+			for _, p := range n.Properties {
+				pairs = append(pairs, fmt.Sprintf("property:%s", p.flatString()))
+			}
+		case "protobom.protobom.Node.annotations":
+			for _, a := range n.Annotations {
+				pairs = append(pairs, fmt.Sprintf("annotation:%s", a.flatString()))
+			}
+		case "protobom.protobom.Node.data_flows":
+			for _, df := range n.DataFlows {
+				pairs = append(pairs, fmt.Sprintf("dataflow:%s", df.flatString()))
+			}
+		case "protobom.protobom.Node.commits":
+			for _, c := range n.Commits {
+				pairs = append(pairs, fmt.Sprintf("commit:%s", c.flatString()))
+			}
+		case "protobom.protobom.Node.evidence":
+			pairs = append(pairs, fmt.Sprintf("%s:%s", fd.FullName(), n.Evidence.flatString()))
+		case "protobom.protobom.Node.patch":
+			pairs = append(pairs, fmt.Sprintf("%s:%s", fd.FullName(), n.Patch.flatString()))
+		case "protobom.protobom.Node.provenance":
+			// Provenance records where the data came from, it is not part
+			// of the node contents.
 		case "protobom.protobom.Node.identifiers":
 			// Index the keys and sort them to make the string deterministic
 			idKeys := []int{}
@@ -287,11 +423,16 @@ func (n *Node) flatString() string {
 			if n.BuildDate != nil {
 				pairs = append(pairs, fmt.Sprintf("%s:%d", fd.FullName(), n.BuildDate.AsTime().Unix()))
 			}
+		case "protobom.protobom.Node.byte_range":
+			pairs = append(pairs, fmt.Sprintf("%s:%s", fd.FullName(), n.ByteRange.flatString()))
+		case "protobom.protobom.Node.line_range":
+			pairs = append(pairs, fmt.Sprintf("%s:%s", fd.FullName(), n.LineRange.flatString()))
 		case "protobom.protobom.Node.hashes":
 			pairs = append(pairs, string(fd.FullName())+":"+flatStringMap(v.Map()))
 		case "protobom.protobom.Node.licenses",
 			"protobom.protobom.Node.attribution",
 			"protobom.protobom.Node.file_types",
+			"protobom.protobom.Node.endpoints",
 			"protobom.protobom.Node.primary_purpose":
 			pairs = append(pairs, flatStringStrSlice(fd.FullName(), v.List()))
 
@@ -350,9 +491,9 @@ func (n *Node) Checksum() string {
 type PackageURL string
 
 // Purl returns the node's Package URL (PURL) as a string.
-// If the node is of type FILE empty PURL is returned.
+// If the node is of type FILE or SNIPPET empty PURL is returned.
 func (n *Node) Purl() PackageURL {
-	if n.Type == Node_FILE {
+	if n.Type == Node_FILE || n.Type == Node_SNIPPET {
 		return ""
 	}
 
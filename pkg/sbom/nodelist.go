@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/protobom/protobom/pkg/vers"
 )
 
 // This file adds a few methods to the NodeList type which
@@ -89,12 +91,13 @@ func (nl *NodeList) indexNodesByHash() hashIndex {
 	return ret
 }
 
-// Returns an indexed map of nodes by their package URLs. Note that more than
+// Returns an indexed map of nodes by their package URLs. Purls are indexed
+// in their canonical form (see PackageURL.Canonical). Note that more than
 // one node may have the same purl.
 func (nl *NodeList) indexNodesByPurl() map[PackageURL][]*Node {
 	ret := map[PackageURL][]*Node{}
 	for _, n := range nl.Nodes {
-		nodePurl := n.Purl()
+		nodePurl := n.Purl().normalized()
 		if nodePurl == "" {
 			continue
 		}
@@ -122,8 +125,9 @@ func (nl *NodeList) cleanEdges() {
 			continue
 		}
 
-		// Use a string key for a simpler datastruct
-		edgeKey := edge.From + "+++" + edge.Type.String()
+		// Use a string key for a simpler datastruct. Edges with different
+		// properties are different relationships, so they are not merged.
+		edgeKey := edge.From + "+++" + edge.Type.String() + "+++" + edge.propertiesKey()
 		if _, ok := newTos[edgeKey]; !ok {
 			newTos[edgeKey] = map[string]string{}
 		}
@@ -135,10 +139,14 @@ func (nl *NodeList) cleanEdges() {
 				From: edge.From,
 				To:   []string{},
 			}
+			for _, p := range edge.Properties {
+				seenCache[edgeKey].Properties = append(seenCache[edgeKey].Properties, p.Copy())
+			}
 		}
 
 		for _, s := range edge.To {
-			if _, ok := nodeIndex[s]; !ok {
+			// Nodes in external documents are not in the index
+			if _, ok := nodeIndex[s]; !ok && !isExternalNodeID(s) {
 				continue
 			}
 			newTos[edgeKey][s] = s
@@ -202,18 +210,14 @@ func (nl *NodeList) Add(nl2 *NodeList) {
 
 	existingEdges := nl.indexEdges()
 	for i := range nl2.Edges {
-		if _, ok := existingEdges[nl2.Edges[i].From]; !ok {
-			nl.Edges = append(nl.Edges, nl2.Edges[i])
-			continue
-		}
-
-		if _, ok := existingEdges[nl2.Edges[i].From][nl2.Edges[i].Type]; !ok {
+		existing := equivalentEdge(existingEdges[nl2.Edges[i].From][nl2.Edges[i].Type], nl2.Edges[i])
+		if existing == nil {
 			nl.Edges = append(nl.Edges, nl2.Edges[i])
 			continue
 		}
 
 		// Add it here to the existing edge
-		existingEdges[nl2.Edges[i].From][nl2.Edges[i].Type][0].To = append(existingEdges[nl2.Edges[i].From][nl2.Edges[i].Type][0].To, nl2.Edges[i].To...)
+		existing.To = append(existing.To, nl2.Edges[i].To...)
 	}
 
 	rootElements := nl.indexRootElements()
@@ -257,6 +261,18 @@ func (nl *NodeList) GetEdgeByType(fromElement string, t Edge_Type) *Edge {
 	return nil
 }
 
+// equivalentEdge returns the first edge in edges with the same origin, type
+// and properties as e, or nil if there is none.
+func equivalentEdge(edges []*Edge, e *Edge) *Edge {
+	key := e.propertiesKey()
+	for _, e2 := range edges {
+		if e2.From == e.From && e2.Type == e.Type && e2.propertiesKey() == key {
+			return e2
+		}
+	}
+	return nil
+}
+
 // copyEdgeList is a utility function that deep copies a list of edges
 func copyEdgeList(original []*Edge) []*Edge {
 	edgeCopy := []*Edge{}
@@ -323,7 +339,7 @@ func (nl *NodeList) Intersect(nl2 *NodeList) *NodeList {
 
 	// Copy root elements
 	for _, e := range nl2.Edges {
-		existingEdge := ret.GetEdgeByType(e.From, e.Type)
+		existingEdge := equivalentEdge(ret.Edges, e)
 		if existingEdge == nil {
 			ret.Edges = append(ret.Edges, e.Copy())
 		} else {
@@ -374,7 +390,7 @@ func (nl *NodeList) Union(nl2 *NodeList) *NodeList {
 
 	// Add or append all edges from nl2
 	for _, e := range nl2.Edges {
-		existingEdge := ret.GetEdgeByType(e.From, e.Type)
+		existingEdge := equivalentEdge(ret.Edges, e)
 		if existingEdge == nil {
 			ret.Edges = append(ret.Edges, e.Copy())
 		} else {
@@ -459,7 +475,7 @@ func (nl *NodeList) GetMatchingNode(node *Node) (*Node, error) {
 	// Here, if we have exactly one node, then we have a match. If we have zero
 	// then we reindex and match on the purl. If more than one node matched on
 	// the hashes, we try to disambiguate by looking at the purl of the hash matches.
-	testPurl := node.Purl()
+	testPurl := node.Purl().normalized()
 	switch len(foundNodes) {
 	case 1:
 		// If there is a single match, our job is done.
@@ -468,8 +484,6 @@ func (nl *NodeList) GetMatchingNode(node *Node) (*Node, error) {
 		}
 	case 0:
 		// No matches by hash, try to match by purl
-		// TODO(puerco): Purls should be normalized to match correctly,
-		// even more: ensuring correct globing of qualifiers.
 		if testPurl == "" {
 			return nil, nil
 		}
@@ -491,7 +505,7 @@ func (nl *NodeList) GetMatchingNode(node *Node) (*Node, error) {
 
 		foundByPurl := []*Node{}
 		for _, n := range foundNodes {
-			if tp := n.Purl(); tp != "" && tp == testPurl {
+			if n.Purl().Matches(testPurl, PurlMatchExact) {
 				foundByPurl = append(foundByPurl, n)
 			}
 		}
@@ -523,6 +537,105 @@ func (nl *NodeList) GetNodesByIdentifier(t, v string) []*Node {
 	return ret
 }
 
+// GetNodesByPurl returns the nodes whose package URL matches purl according
+// to the match mode. For example, to find all versions of a package, pass its
+// purl with PurlMatchIgnoreVersion. When using PurlMatchQualifierSubset, purl
+// is the subset. See PackageURL.Matches for details.
+func (nl *NodeList) GetNodesByPurl(purl PackageURL, mode PurlMatchMode) []*Node {
+	ret := []*Node{}
+	if purl == "" {
+		return ret
+	}
+	for _, n := range nl.Nodes {
+		if purl.Matches(n.Purl(), mode) {
+			ret = append(ret, n)
+		}
+	}
+	return ret
+}
+
+// GetNodesByPurlRange returns the nodes of the package identified by purl
+// whose version is within the vers range. Any version in purl is ignored and
+// its qualifiers must be a subset of the node purl qualifiers. For example,
+// to find the vulnerable log4j-core nodes:
+//
+//	nl.GetNodesByPurlRange(
+//	    "pkg:maven/org.apache.logging.log4j/log4j-core",
+//	    "vers:maven/>=2.0|<2.17.1",
+//	)
+//
+// The node version is read from its purl, falling back to the node version
+// field. Versions are compared using the versioning scheme of the range.
+// Nodes without a version or with one that can't be compared are skipped.
+func (nl *NodeList) GetNodesByPurlRange(purl PackageURL, versRange string) ([]*Node, error) {
+	r, err := vers.Parse(versRange)
+	if err != nil {
+		return nil, fmt.Errorf("parsing version range: %w", err)
+	}
+
+	ret := []*Node{}
+	for _, n := range nl.GetNodesByPurl(purl, PurlMatchIgnoreVersion|PurlMatchQualifierSubset) {
+		if nodeVersionInRange(n, r) {
+			ret = append(ret, n)
+		}
+	}
+	return ret, nil
+}
+
+// nodeVersionInRange returns true if the version of the node, read from its
+// purl or its version field, is within the range.
+func nodeVersionInRange(n *Node, r *vers.Range) bool {
+	version := n.Purl().Version()
+	if version == "" {
+		version = n.Version
+	}
+	if version == "" {
+		return false
+	}
+	ok, err := r.Contains(version)
+	return err == nil && ok
+}
+
+// GetNodesByCPE returns the nodes that have a CPE 2.2 or 2.3 identifier
+// matched by the pattern. The pattern can be a CPE name in either binding and
+// may use the ANY value and wildcards in its attributes, for example
+// cpe:2.3:a:apache:log4j:2.*:*:*:*:*:*:*:*. Matching follows the CPE Name
+// Matching specification (see CPE.Matches). Node identifiers that are not
+// valid CPE names are ignored.
+func (nl *NodeList) GetNodesByCPE(pattern string) ([]*Node, error) {
+	source, err := ParseCPE(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("parsing cpe pattern: %w", err)
+	}
+
+	ret := []*Node{}
+	for _, n := range nl.Nodes {
+		if nodeMatchesCPE(n, source) {
+			ret = append(ret, n)
+		}
+	}
+	return ret, nil
+}
+
+// nodeMatchesCPE returns true if any of the CPE identifiers of the node is
+// matched by the source CPE.
+func nodeMatchesCPE(n *Node, source *CPE) bool {
+	for _, t := range []SoftwareIdentifierType{SoftwareIdentifierType_CPE23, SoftwareIdentifierType_CPE22} {
+		id, ok := n.Identifiers[int32(t)]
+		if !ok {
+			continue
+		}
+		target, err := ParseCPE(id)
+		if err != nil {
+			continue
+		}
+		if source.Matches(target) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetRootNodes returns a list of the document root nodes.
 func (nl *NodeList) GetRootNodes() []*Node {
 	ret := []*Node{}
@@ -538,7 +651,8 @@ func (nl *NodeList) GetRootNodes() []*Node {
 			}
 		}
 	}
-	// TODO(ehandling): What if not all nodes were found?
+	// Root elements not found in the node list are skipped, use Validate()
+	// to detect them.
 	return ret
 }
 
@@ -703,10 +817,9 @@ func (nl *NodeList) GetNodesByPurlType(purlType string) *NodeList {
 		return ret
 	}
 
+	purlType = strings.ToLower(purlType)
 	for _, n := range nl.Nodes {
-		// I think the SPDX libraries have a bug where an extra slash is added when parsing purls
-		if strings.HasPrefix(string(n.Purl()), fmt.Sprintf("pkg:%s/", purlType)) ||
-			strings.HasPrefix(string(n.Purl()), fmt.Sprintf("pkg:/%s/", purlType)) {
+		if n.Purl().Type() == purlType {
 			ret.Nodes = append(ret.Nodes, n)
 		}
 	}
@@ -747,78 +860,17 @@ func (nl *NodeList) reconnectOrphanNodes() {
 // graph of the node identified by the provided ID. The method traverses the SBOM graph,
 // adding all nodes connected to the specified ID.
 // If no nodes match, an empty NodeList is returned.
-func (nl *NodeList) NodeGraph(id string) *NodeList {
-	nodelist := &NodeList{
-		Nodes:        []*Node{},
-		Edges:        []*Edge{},
-		RootElements: []string{},
-	}
-
-	// Get the list of connected nodes
-	graphIndex := nl.indexConnectedNodes(id)
-
-	// Verify that the root is in the resulting index
-	if _, ok := graphIndex[id]; !ok {
+//
+// When called without options, root elements are treated as boundaries and are
+// not added to the graph. Traversal options can be passed to follow only some
+// edge types, limit the depth or change the direction of the traversal. In that
+// case only the edges of the followed types are returned.
+func (nl *NodeList) NodeGraph(id string, opts ...TraversalOption) *NodeList {
+	res := newGraphIndex(nl).nodeGraph(id, opts)
+	if res == nil {
 		return nil
 	}
-
-	edgeIdx := nl.indexEdges()
-	for id, n := range graphIndex {
-		// Add the node
-		nodelist.AddNode(n)
-
-		if _, ok := edgeIdx[id]; !ok {
-			continue
-		}
-
-		for t := range edgeIdx[id] {
-			nodelist.Edges = append(nodelist.Edges, edgeIdx[id][t]...)
-		}
-	}
-	nodelist.RootElements = append(nodelist.RootElements, id)
-	nodelist.cleanEdges()
-
-	return nodelist
-}
-
-// indexConnectedNodes traverses the graph of NodeList nl and returns an index of
-// nodes connected to id. Root nodes are considered boundaries and recursion will
-// stop when reaching them.
-func (nl *NodeList) indexConnectedNodes(id string) nodeIndex {
-	index := nodeIndex{}
-	node := nl.GetNodeByID(id)
-	if node == nil {
-		return index
-	}
-
-	index[id] = node
-
-	boundaries := nl.indexRootElements()
-	nl.connectedIndexRecursion(node.Id, &boundaries, &index)
-	return index
-}
-
-// connectedIndexRecursion traverses the NodeList graph starting at id to
-// populate the connectedNodes index stopping at the end of the edges or when
-// it hits a node in the boundaries list.
-func (nl *NodeList) connectedIndexRecursion(id string, boundaries *rootElementsIndex, connectedNodes *nodeIndex) {
-	siblings := nl.NodeSiblings(id)
-	for _, s := range siblings.Nodes {
-		// If we've seen it, skip
-		if _, ok := (*connectedNodes)[s.Id]; ok {
-			continue
-		}
-
-		// If the node is in the boundaries list, skip
-		if _, ok := (*boundaries)[s.Id]; ok {
-			continue
-		}
-
-		(*connectedNodes)[s.Id] = s
-
-		// Traverse the node path:
-		nl.connectedIndexRecursion(s.Id, boundaries, connectedNodes)
-	}
+	return nl.inListOrder(res)
 }
 
 // NodeSiblings returns a new NodeList containing the specified node at the root
@@ -839,14 +891,18 @@ func (nl *NodeList) NodeSiblings(id string) *NodeList {
 
 	nodelist.RootElements = append(nodelist.RootElements, node.Id)
 	ni := nodeIndex{node.Id: node}
+	var allNodes nodeIndex
 	for _, r := range nl.Edges {
 		if r.From != id {
 			continue
 		}
 
+		if allNodes == nil {
+			allNodes = nl.indexNodes()
+		}
 		for _, to := range r.To {
 			if _, ok := ni[to]; !ok {
-				n := nl.GetNodeByID(to)
+				n := allNodes[to]
 				if n == nil {
 					continue
 				}
@@ -870,72 +926,121 @@ func (nl *NodeList) NodeSiblings(id string) *NodeList {
 // by id and returns a new node list with elements related at a maximal distance
 // of maxDepth levels. If the specified id is not found, the NodeList will be
 // empty. Traversing the graph will stop if any of the related nodes is a RootNode.
-func (nl *NodeList) NodeDescendants(id string, maxDepth int) *NodeList {
-	rootIdx := nl.indexRootElements()
-	edgeIdx := nl.indexEdges()
-	startNode := nl.GetNodeByID(id)
-	if startNode == nil {
-		return &NodeList{}
+//
+// Traversal options can be passed to filter the edge types or nodes followed,
+// only the edges of the followed types are returned in that case. The depth
+// is always controlled by maxDepth and the direction is always descendants.
+func (nl *NodeList) NodeDescendants(id string, maxDepth int, opts ...TraversalOption) *NodeList {
+	return nl.inListOrder(newGraphIndex(nl).descendants(id, maxDepth, opts))
+}
+
+// indexEdgesByDestination returns the edges of the NodeList indexed by their
+// destination nodes and type. It is the reverse of indexEdges.
+func (nl *NodeList) indexEdgesByDestination() edgeIndex {
+	index := edgeIndex{}
+	for _, e := range nl.Edges {
+		for _, to := range e.To {
+			if _, ok := index[to]; !ok {
+				index[to] = map[Edge_Type][]*Edge{}
+			}
+			index[to][e.Type] = append(index[to][e.Type], e)
+		}
 	}
+	return index
+}
 
-	nl2 := NodeList{
-		Nodes:        []*Node{},
-		Edges:        nl.Edges,
-		RootElements: []string{startNode.Id},
+// edgeTypeSet returns an index of edge types. If no types are specified the
+// returned set is nil, meaning all types are allowed.
+func edgeTypeSet(types []Edge_Type) map[Edge_Type]struct{} {
+	if len(types) == 0 {
+		return nil
 	}
+	ret := map[Edge_Type]struct{}{}
+	for _, t := range types {
+		ret[t] = struct{}{}
+	}
+	return ret
+}
 
-	siblings := nodeIndex{}
+// NodeAncestors traverses the NodeList graph backwards starting at the node
+// identified by id and returns a new NodeList with the nodes that have a path
+// to it, at a maximal distance of maxDepth edges. A maxDepth lower than one
+// means the traversal will not stop until it runs out of ancestors.
+//
+// Traversal options can be passed to filter the edge types or nodes followed,
+// the depth is always controlled by maxDepth and the direction is always
+// ancestors. The returned NodeList includes the starting node and the edges
+// of the followed types among the nodes found, its root elements are the
+// topmost ancestors found. If the specified id is not found, the NodeList will
+// be empty.
+func (nl *NodeList) NodeAncestors(id string, maxDepth int, opts ...TraversalOption) *NodeList {
+	return nl.inListOrder(newGraphIndex(nl).ancestors(id, maxDepth, opts))
+}
 
-	var loopNodes []*Node
-	newLoopNodes := []*Node{}
+// PathsToRoot returns all the paths that lead from the NodeList's root
+// elements to the node identified by id. Each path is a slice of nodes that
+// starts at a root element and ends at the node. Paths stop at the first root
+// element found and never visit a node twice, so cycles in the graph are
+// ignored. If the node is itself a root element, one of the paths will
+// contain only the node.
+//
+// If edge types are specified, only edges of those types are followed. Note
+// that the number of paths can grow very quickly in densely connected graphs.
+func (nl *NodeList) PathsToRoot(id string, edgeTypes ...Edge_Type) [][]*Node {
+	ret := [][]*Node{}
+	startNode := nl.GetNodeByID(id)
+	if startNode == nil {
+		return ret
+	}
 
-	for i := 0; i < maxDepth; i++ {
-		if i == 0 {
-			loopNodes = []*Node{startNode}
-		} else {
-			loopNodes = newLoopNodes
-		}
-		newLoopNodes = []*Node{}
-		for _, n := range loopNodes {
-			// If we've seen it, we're done
-			if _, ok := siblings[n.Id]; ok {
-				continue
-			}
+	types := edgeTypeSet(edgeTypes)
+	reverseIdx := nl.indexEdgesByDestination()
+	nodeIdx := nl.indexNodes()
+	rootIdx := nl.indexRootElements()
 
-			siblings[n.Id] = n
+	// path is built from the node upwards, it gets reversed when stored
+	path := []*Node{startNode}
+	inPath := map[string]struct{}{startNode.Id: {}}
 
-			// If node has no relationships, we're done
-			if _, ok := edgeIdx[n.Id]; !ok {
-				continue
+	var walk func(n *Node)
+	walk = func(n *Node) {
+		if _, ok := rootIdx[n.Id]; ok {
+			p := make([]*Node, len(path))
+			for i := range path {
+				p[len(path)-1-i] = path[i]
 			}
+			ret = append(ret, p)
+			return
+		}
 
-			// If node is a root node, we're done
-			if _, ok := rootIdx[n.Id]; ok && n.Id != id {
+		// Sort the edge types to make the order of the paths predictable
+		edgeTypes := make([]Edge_Type, 0, len(reverseIdx[n.Id]))
+		for t := range reverseIdx[n.Id] {
+			if _, ok := types[t]; types != nil && !ok {
 				continue
 			}
+			edgeTypes = append(edgeTypes, t)
+		}
+		sort.Slice(edgeTypes, func(i, j int) bool { return edgeTypes[i] < edgeTypes[j] })
 
-			for et := range edgeIdx[n.Id] {
-				for j := range edgeIdx[n.Id][et] {
-					for _, siblingID := range edgeIdx[n.Id][et][j].To {
-						if _, ok := siblings[siblingID]; ok {
-							continue
-						}
-
-						sibling := nl.GetNodeByID(siblingID)
-						if sibling != nil {
-							newLoopNodes = append(newLoopNodes, sibling)
-						}
-					}
+		for _, t := range edgeTypes {
+			for _, e := range reverseIdx[n.Id][t] {
+				parent, ok := nodeIdx[e.From]
+				if !ok {
+					continue
+				}
+				if _, ok := inPath[parent.Id]; ok {
+					continue
 				}
+				path = append(path, parent)
+				inPath[parent.Id] = struct{}{}
+				walk(parent)
+				delete(inPath, parent.Id)
+				path = path[:len(path)-1]
 			}
 		}
 	}
+	walk(startNode)
 
-	// Assign found nodes to nodelist
-	for _, n := range siblings {
-		nl2.AddNode(n)
-	}
-
-	nl2.cleanEdges()
-	return &nl2
+	return ret
 }
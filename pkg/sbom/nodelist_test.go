@@ -94,12 +94,70 @@ func TestCleanEdges(t *testing.T) {
 				RootElements: []string{"node1"},
 			},
 		},
+		"Edges with different properties are not consolidated": {
+			sut: &NodeList{
+				Nodes: []*Node{
+					{Id: "node1"}, {Id: "node2"}, {Id: "node3"},
+				},
+				Edges: []*Edge{
+					{Type: Edge_dependsOn, From: "node1", To: []string{"node2"}, Properties: []*Property{{Name: "scope", Value: "runtime"}}},
+					{Type: Edge_dependsOn, From: "node1", To: []string{"node3"}, Properties: []*Property{{Name: "scope", Value: "test"}}},
+					{Type: Edge_dependsOn, From: "node1", To: []string{"node3"}, Properties: []*Property{{Name: "scope", Value: "runtime"}}},
+				},
+				RootElements: []string{"node1"},
+			},
+			expected: &NodeList{
+				Nodes: []*Node{
+					{Id: "node1"}, {Id: "node2"}, {Id: "node3"},
+				},
+				Edges: []*Edge{
+					{Type: Edge_dependsOn, From: "node1", To: []string{"node2", "node3"}, Properties: []*Property{{Name: "scope", Value: "runtime"}}},
+					{Type: Edge_dependsOn, From: "node1", To: []string{"node3"}, Properties: []*Property{{Name: "scope", Value: "test"}}},
+				},
+				RootElements: []string{"node1"},
+			},
+		},
 	} {
 		tc.sut.cleanEdges()
 		require.True(t, tc.sut.Equal(tc.expected), m)
 	}
 }
 
+func TestEdgePropertiesPreserved(t *testing.T) {
+	runtime := []*Property{{Name: "scope", Value: "runtime"}}
+	test := []*Property{{Name: "scope", Value: "test"}}
+	nl := &NodeList{
+		Nodes: []*Node{{Id: "app"}, {Id: "lib"}, {Id: "junit"}},
+		Edges: []*Edge{
+			{Type: Edge_dependsOn, From: "app", To: []string{"lib", "junit"}, Properties: runtime},
+		},
+		RootElements: []string{"app"},
+	}
+
+	nl.RemoveNodes([]string{"junit"})
+	require.Len(t, nl.Edges, 1)
+	require.True(t, nl.Edges[0].Equal(&Edge{
+		Type: Edge_dependsOn, From: "app", To: []string{"lib"}, Properties: runtime,
+	}), nl.Edges[0].String())
+
+	// Edges of the same type with other properties are not merged
+	union := nl.Union(&NodeList{
+		Nodes: []*Node{{Id: "app"}, {Id: "junit"}, {Id: "lib"}},
+		Edges: []*Edge{
+			{Type: Edge_dependsOn, From: "app", To: []string{"junit"}, Properties: test},
+			{Type: Edge_dependsOn, From: "app", To: []string{"lib"}, Properties: runtime},
+		},
+	})
+	require.True(t, union.Equal(&NodeList{
+		Nodes: []*Node{{Id: "app"}, {Id: "lib"}, {Id: "junit"}},
+		Edges: []*Edge{
+			{Type: Edge_dependsOn, From: "app", To: []string{"lib"}, Properties: runtime},
+			{Type: Edge_dependsOn, From: "app", To: []string{"junit"}, Properties: test},
+		},
+		RootElements: []string{"app"},
+	}), union.String())
+}
+
 func TestRemoveNodes(t *testing.T) {
 	for _, tc := range []struct {
 		sut      *NodeList
@@ -775,6 +833,23 @@ func TestIndexByPurl(t *testing.T) {
 			expectedLength: 1,
 			mustEqual:      true,
 		},
+		"2 nodes, equivalent purls": {
+			sut: &NodeList{
+				Nodes: []*Node{
+					{
+						Id: "angular-1", Name: "core",
+						Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/%40angular/core@1.0?b=2&a=1"},
+					},
+					{
+						Id: "angular-2", Name: "core",
+						Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/@angular/core@1.0?a=1&b=2"},
+					},
+				},
+			},
+			expected:       purlIndex{},
+			expectedLength: 1,
+			mustEqual:      true,
+		},
 	} {
 		res := tc.sut.indexNodesByPurl()
 		require.Equal(t, tc.expectedLength, len(res), label)
@@ -910,33 +985,49 @@ func TestGetMatchingNode(t *testing.T) {
 			},
 			exptectedId: "node2",
 		},
-		/* this one needs to be implemented
 		"rearranged purls should match": {
 			sut: &NodeList{
 				Nodes: []*Node{
 					{
-						Id: "node1",
-						Identifiers: []*Identifier{
-							{
-								Type:  "purl",
-								Value: "pkg:deb/libzstd1@1.3.8+dfsg-3+deb10u2?arch=amd64&upstream=libzstd",
-							},
-						},
+						Id:          "node1",
+						Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:deb/libzstd1@1.3.8+dfsg-3+deb10u2?arch=amd64&upstream=libzstd"},
 					},
 				},
 			},
 			node: &Node{
-				Hashes: map[string]string{"sha1": "0b13c24e584ef7075f3d4fd3a9f8872c9fffa1b1"},
-				Identifiers: []*Identifier{
+				Hashes:      map[int32]string{int32(HashAlgorithm_SHA1): "0b13c24e584ef7075f3d4fd3a9f8872c9fffa1b1"},
+				Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:deb/libzstd1@1.3.8%2Bdfsg-3%2Bdeb10u2?upstream=libzstd&arch=amd64"},
+			},
+			exptectedId: "node1",
+		},
+		"escaped npm scope": {
+			sut: &NodeList{
+				Nodes: []*Node{
 					{
-						Type:  "purl",
-						Value: "pkg:deb/libzstd1@1.3.8+dfsg-3+deb10u2?upstream=libzstd&arch=amd64",
+						Id:          "node1",
+						Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/%40angular/core@1.0"},
 					},
 				},
 			},
+			node: &Node{
+				Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/@angular/core@1.0"},
+			},
 			exptectedId: "node1",
 		},
-		*/
+		"different version does not match": {
+			sut: &NodeList{
+				Nodes: []*Node{
+					{
+						Id:          "node1",
+						Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/%40angular/core@1.0"},
+					},
+				},
+			},
+			node: &Node{
+				Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/@angular/core@2.0"},
+			},
+			shouldNil: true,
+		},
 	} {
 		res, err := tc.sut.GetMatchingNode(tc.node)
 		if tc.shouldError {
@@ -1395,3 +1486,169 @@ func TestRelateNodeListAtId(t *testing.T) {
 		})
 	}
 }
+
+// sampleGraphNodeList returns the nodelist shared by the graph traversal
+// tests. It has two products that pull in a shared library through
+// different paths and edges of several types.
+func sampleGraphNodeList() *NodeList {
+	return &NodeList{
+		Nodes: []*Node{
+			{Id: "product-a"}, {Id: "product-b"}, {Id: "framework"}, {Id: "plugin"},
+			{Id: "logging"}, {Id: "log4j-core"}, {Id: "junit"}, {Id: "compiler"},
+			{Id: "README", Type: Node_FILE}, {Id: "unrelated"},
+		},
+		Edges: []*Edge{
+			{Type: Edge_dependsOn, From: "product-a", To: []string{"framework"}},
+			{Type: Edge_testDependency, From: "product-a", To: []string{"junit"}},
+			{Type: Edge_buildTool, From: "product-a", To: []string{"compiler"}},
+			{Type: Edge_documentation, From: "product-a", To: []string{"README"}},
+			{Type: Edge_contains, From: "product-b", To: []string{"plugin"}},
+			{Type: Edge_dependsOn, From: "framework", To: []string{"logging"}},
+			{Type: Edge_dependsOn, From: "plugin", To: []string{"logging", "log4j-core"}},
+			{Type: Edge_dependsOn, From: "logging", To: []string{"log4j-core"}},
+			{Type: Edge_testDependency, From: "junit", To: []string{"log4j-core"}},
+			{Type: Edge_dependsOn, From: "unrelated", To: []string{"junit"}},
+		},
+		RootElements: []string{"product-a", "product-b"},
+	}
+}
+
+func TestNodeAncestors(t *testing.T) {
+	for _, tc := range []struct {
+		testName      string
+		id            string
+		maxDepth      int
+		opts          []TraversalOption
+		expectedNodes []string
+		expectedRoots []string
+	}{
+		{
+			testName:      "all ancestors",
+			id:            "log4j-core",
+			expectedNodes: []string{"product-a", "product-b", "framework", "plugin", "logging", "log4j-core", "junit", "unrelated"},
+			expectedRoots: []string{"product-a", "product-b", "unrelated"},
+		},
+		{
+			testName:      "depth limited",
+			id:            "log4j-core",
+			maxDepth:      1,
+			expectedNodes: []string{"plugin", "logging", "log4j-core", "junit"},
+			expectedRoots: []string{"plugin", "junit"},
+		},
+		{
+			testName:      "filtered by edge type",
+			id:            "log4j-core",
+			opts:          []TraversalOption{WithEdgeTypes(Edge_dependsOn)},
+			expectedNodes: []string{"product-a", "framework", "plugin", "logging", "log4j-core"},
+			expectedRoots: []string{"product-a", "plugin"},
+		},
+		{
+			testName:      "without development edges",
+			id:            "log4j-core",
+			opts:          []TraversalOption{WithoutEdgeTypes(DevelopmentEdgeTypes...)},
+			expectedNodes: []string{"product-a", "product-b", "framework", "plugin", "logging", "log4j-core"},
+			expectedRoots: []string{"product-a", "product-b"},
+		},
+		{
+			testName:      "direction and depth options are ignored",
+			id:            "log4j-core",
+			maxDepth:      1,
+			opts:          []TraversalOption{WithDirection(TraverseDescendants), WithMaxDepth(5)},
+			expectedNodes: []string{"plugin", "logging", "log4j-core", "junit"},
+			expectedRoots: []string{"plugin", "junit"},
+		},
+		{
+			testName:      "node without ancestors",
+			id:            "product-a",
+			expectedNodes: []string{"product-a"},
+			expectedRoots: []string{"product-a"},
+		},
+		{
+			testName:      "node not found",
+			id:            "does-not-exist",
+			expectedNodes: []string{},
+			expectedRoots: []string{},
+		},
+	} {
+		t.Run(tc.testName, func(t *testing.T) {
+			res := sampleGraphNodeList().NodeAncestors(tc.id, tc.maxDepth, tc.opts...)
+			ids := []string{}
+			for _, n := range res.Nodes {
+				ids = append(ids, n.Id)
+			}
+			require.Equal(t, tc.expectedNodes, ids)
+			require.ElementsMatch(t, tc.expectedRoots, res.RootElements)
+			require.Empty(t, res.Validate().WithSeverity(SeverityError))
+		})
+	}
+}
+
+func TestPathsToRoot(t *testing.T) {
+	for _, tc := range []struct {
+		testName string
+		sut      *NodeList
+		id       string
+		types    []Edge_Type
+		expected [][]string
+	}{
+		{
+			testName: "all paths",
+			sut:      sampleGraphNodeList(),
+			id:       "log4j-core",
+			expected: [][]string{
+				{"product-a", "framework", "logging", "log4j-core"},
+				{"product-b", "plugin", "logging", "log4j-core"},
+				{"product-b", "plugin", "log4j-core"},
+				{"product-a", "junit", "log4j-core"},
+			},
+		},
+		{
+			testName: "runtime paths",
+			sut:      sampleGraphNodeList(),
+			id:       "log4j-core",
+			types:    []Edge_Type{Edge_dependsOn},
+			expected: [][]string{
+				{"product-a", "framework", "logging", "log4j-core"},
+			},
+		},
+		{
+			testName: "root node",
+			sut:      sampleGraphNodeList(),
+			id:       "product-b",
+			expected: [][]string{{"product-b"}},
+		},
+		{
+			testName: "orphan node",
+			sut:      sampleGraphNodeList(),
+			id:       "unrelated",
+			expected: [][]string{},
+		},
+		{
+			testName: "cycle",
+			sut: &NodeList{
+				Nodes: []*Node{{Id: "root"}, {Id: "a"}, {Id: "b"}},
+				Edges: []*Edge{
+					{Type: Edge_dependsOn, From: "root", To: []string{"a"}},
+					{Type: Edge_dependsOn, From: "a", To: []string{"b"}},
+					{Type: Edge_dependsOn, From: "b", To: []string{"a"}},
+				},
+				RootElements: []string{"root"},
+			},
+			id:       "b",
+			expected: [][]string{{"root", "a", "b"}},
+		},
+	} {
+		t.Run(tc.testName, func(t *testing.T) {
+			paths := tc.sut.PathsToRoot(tc.id, tc.types...)
+			res := [][]string{}
+			for _, p := range paths {
+				ids := []string{}
+				for _, n := range p {
+					ids = append(ids, n.Id)
+				}
+				res = append(res, ids)
+			}
+			require.ElementsMatch(t, tc.expected, res)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package sbom
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// flatStringMessage returns a deterministic serialized representation of a
+// protobuf message. It must only be used with messages without maps.
+func flatStringMessage(m proto.Message) string {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", data)
+}
+
+// flatString returns a deterministic serialized representation of the commit
+func (c *Commit) flatString() string {
+	return flatStringMessage(c)
+}
+
+// Copy returns a duplicate of the commit
+func (c *Commit) Copy() *Commit {
+	return proto.Clone(c).(*Commit) //nolint:forcetypeassert
+}
+
+// flatString returns a deterministic serialized representation of the patch
+func (p *Patch) flatString() string {
+	return flatStringMessage(p)
+}
+
+// Copy returns a duplicate of the patch. Copying a nil patch returns nil.
+func (p *Patch) Copy() *Patch {
+	if p == nil {
+		return nil
+	}
+	return proto.Clone(p).(*Patch) //nolint:forcetypeassert
+}
+
+// flatString returns a deterministic serialized representation of the evidence
+func (e *Evidence) flatString() string {
+	return flatStringMessage(e)
+}
+
+// Copy returns a duplicate of the evidence. Copying nil evidence returns nil.
+func (e *Evidence) Copy() *Evidence {
+	if e == nil {
+		return nil
+	}
+	return proto.Clone(e).(*Evidence) //nolint:forcetypeassert
+}
+
+// diffMessages compares two optional messages and returns m2 in added if
+// there is a change or m1 in removed if m2 is nil. count will be 1 if there
+// was a change.
+func diffMessages[T interface {
+	comparable
+	Flattenable
+}](m1, m2 T) (added, removed T, count int) {
+	var null T
+	switch {
+	case m1 == null && m2 == null:
+		return null, null, 0
+	case m2 == null:
+		return null, m1, 1
+	case m1 == null || m1.flatString() != m2.flatString():
+		return m2, null, 1
+	}
+	return null, null, 0
+}
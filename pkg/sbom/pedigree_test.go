@@ -0,0 +1,59 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPedigreeNodeCopyAndDiff(t *testing.T) {
+	n := &Node{
+		Id:            "tomcat",
+		PedigreeNotes: "Patched by Acme",
+		Commits:       []*Commit{{Uid: "7638417", Authored: timestamppb.Now()}},
+		Evidence:      &Evidence{Licenses: []string{"Apache-2.0"}},
+	}
+
+	n2 := n.Copy()
+	require.True(t, n.Equal(n2))
+	require.NotSame(t, n.Commits[0], n2.Commits[0])
+	require.NotSame(t, n.Evidence, n2.Evidence)
+	require.Nil(t, n2.Patch)
+
+	n2.Evidence.Copyright = []string{"Copyright 2024 Acme"}
+	n2.Commits = append(n2.Commits, &Commit{Uid: "e6b1000"})
+	require.Empty(t, n.Evidence.Copyright)
+	require.False(t, n.Equal(n2))
+
+	nd := n.Diff(n2)
+	require.NotNil(t, nd)
+	require.Equal(t, 2, nd.DiffCount)
+	require.Equal(t, n2.Evidence, nd.Added.Evidence)
+	require.Len(t, nd.Added.Commits, 1)
+	require.Equal(t, "e6b1000", nd.Added.Commits[0].Uid)
+}
+
+func TestDiffMessages(t *testing.T) {
+	p1 := &Patch{Type: Patch_BACKPORT}
+	p2 := &Patch{Type: Patch_CHERRY_PICK}
+	for _, tc := range []struct {
+		name            string
+		m1, m2          *Patch
+		added, removed  *Patch
+		expectedChanges int
+	}{
+		{name: "both nil"},
+		{name: "same", m1: p1, m2: &Patch{Type: Patch_BACKPORT}},
+		{name: "set", m2: p2, added: p2, expectedChanges: 1},
+		{name: "changed", m1: p1, m2: p2, added: p2, expectedChanges: 1},
+		{name: "unset", m1: p1, removed: p1, expectedChanges: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			added, removed, count := diffMessages(tc.m1, tc.m2)
+			require.Equal(t, tc.added, added)
+			require.Equal(t, tc.removed, removed)
+			require.Equal(t, tc.expectedChanges, count)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package sbom
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// flatString returns a deterministic serialized representation of the
+// property and its nested properties.
+func (p *Property) flatString() string {
+	ret := fmt.Sprintf("(n)%s(v)%s", p.Name, p.Value)
+	if len(p.Properties) > 0 {
+		ret += "(p)"
+		for _, np := range p.Properties {
+			ret += "[" + np.flatString() + "]"
+		}
+	}
+	return ret
+}
+
+// Copy returns a duplicate of the property, including its nested properties.
+func (p *Property) Copy() *Property {
+	np := &Property{
+		Name:       p.Name,
+		Value:      p.Value,
+		Properties: []*Property{},
+	}
+	for _, child := range p.Properties {
+		np.Properties = append(np.Properties, child.Copy())
+	}
+	return np
+}
+
+// FlattenProperties returns the properties with their nested properties
+// hoisted to the top level for formats that only support flat name/value
+// pairs. The names of nested properties are prefixed with the names of their
+// parents separated with colons. Properties without a value are only kept
+// if they have no children.
+func FlattenProperties(props []*Property) []*Property {
+	ret := []*Property{}
+	var flatten func(prefix string, props []*Property)
+	flatten = func(prefix string, props []*Property) {
+		for _, p := range props {
+			name := p.Name
+			if prefix != "" {
+				name = prefix + ":" + p.Name
+			}
+			if p.Value != "" || len(p.Properties) == 0 {
+				ret = append(ret, &Property{Name: name, Value: p.Value})
+			}
+			flatten(name, p.Properties)
+		}
+	}
+	flatten("", props)
+	return ret
+}
+
+// getPropertyValue returns the value of the first property with the name
+func getPropertyValue(props []*Property, name string) string {
+	for _, p := range props {
+		if p.Name == name {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+// GetPropertyValue returns the value of the first top level property of the
+// node with the specified name or an empty string if there is none.
+func (n *Node) GetPropertyValue(name string) string {
+	return getPropertyValue(n.GetProperties(), name)
+}
+
+// AddProperty adds a name/value property to the node
+func (n *Node) AddProperty(name, value string) {
+	n.Properties = append(n.Properties, &Property{Name: name, Value: value})
+}
+
+// GetPropertyValue returns the value of the first top level property of the
+// document metadata with the specified name or an empty string if there is none.
+func (md *Metadata) GetPropertyValue(name string) string {
+	return getPropertyValue(md.GetProperties(), name)
+}
+
+// AddProperty adds a name/value property to the document metadata
+func (md *Metadata) AddProperty(name, value string) {
+	md.Properties = append(md.Properties, &Property{Name: name, Value: value})
+}
+
+// flatString returns a deterministic serialized representation of the
+// annotation.
+func (a *Annotation) flatString() string {
+	ret := fmt.Sprintf("(t)%d", a.Type)
+	if a.Annotator != nil {
+		ret += fmt.Sprintf("(a)%s", a.Annotator.flatString())
+	}
+	if a.Tool != nil {
+		ret += fmt.Sprintf("(tool)%s@%s(%s)", a.Tool.Name, a.Tool.Version, a.Tool.Vendor)
+	}
+	if a.Date != nil {
+		ret += fmt.Sprintf("(d)%d", a.Date.AsTime().Unix())
+	}
+	return ret + "(x)" + strings.TrimSpace(a.Text)
+}
+
+// Copy returns a duplicate of the annotation
+func (a *Annotation) Copy() *Annotation {
+	na := &Annotation{
+		Type: a.Type,
+		Text: a.Text,
+	}
+	if a.Annotator != nil {
+		na.Annotator = a.Annotator.Copy()
+	}
+	if a.Tool != nil {
+		na.Tool = &Tool{Name: a.Tool.Name, Version: a.Tool.Version, Vendor: a.Tool.Vendor}
+	}
+	if a.Date != nil {
+		na.Date = timestamppb.New(a.Date.AsTime())
+	}
+	return na
+}
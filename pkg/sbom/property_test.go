@@ -0,0 +1,131 @@
+package sbom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFlattenProperties(t *testing.T) {
+	for m, tc := range map[string]struct {
+		sut      []*Property
+		expected []*Property
+	}{
+		"empty": {sut: []*Property{}, expected: []*Property{}},
+		"flat": {
+			sut:      []*Property{{Name: "a", Value: "1"}, {Name: "b", Value: "2"}},
+			expected: []*Property{{Name: "a", Value: "1"}, {Name: "b", Value: "2"}},
+		},
+		"nested": {
+			sut: []*Property{
+				{Name: "syft", Properties: []*Property{
+					{Name: "package:foundBy", Value: "python-cataloger"},
+					{Name: "location", Value: "/usr/lib", Properties: []*Property{{Name: "layer", Value: "sha256:1234"}}},
+				}},
+				{Name: "empty"},
+			},
+			expected: []*Property{
+				{Name: "syft:package:foundBy", Value: "python-cataloger"},
+				{Name: "syft:location", Value: "/usr/lib"},
+				{Name: "syft:location:layer", Value: "sha256:1234"},
+				{Name: "empty"},
+			},
+		},
+	} {
+		t.Run(m, func(t *testing.T) {
+			res := FlattenProperties(tc.sut)
+			require.Len(t, res, len(tc.expected))
+			for i := range tc.expected {
+				require.Equal(t, tc.expected[i].Name, res[i].Name)
+				require.Equal(t, tc.expected[i].Value, res[i].Value)
+				require.Empty(t, res[i].Properties)
+			}
+		})
+	}
+}
+
+func TestNodePropertiesDiff(t *testing.T) {
+	for m, tc := range map[string]struct {
+		n1, n2             *Node
+		added, removed     int
+		addedAnnotations   int
+		removedAnnotations int
+	}{
+		"same": {
+			n1: &Node{Properties: []*Property{{Name: "a", Value: "1"}}},
+			n2: &Node{Properties: []*Property{{Name: "a", Value: "1"}}},
+		},
+		"changed value": {
+			n1:      &Node{Properties: []*Property{{Name: "a", Value: "1"}}},
+			n2:      &Node{Properties: []*Property{{Name: "a", Value: "2"}}},
+			added:   1,
+			removed: 1,
+		},
+		"nested property added": {
+			n1:      &Node{Properties: []*Property{{Name: "a", Value: "1"}}},
+			n2:      &Node{Properties: []*Property{{Name: "a", Value: "1", Properties: []*Property{{Name: "b"}}}}},
+			added:   1,
+			removed: 1,
+		},
+		"annotation added": {
+			n1: &Node{},
+			n2: &Node{Annotations: []*Annotation{{
+				Type: Annotation_REVIEW, Annotator: &Person{Name: "John"}, Text: "Looks good",
+			}}},
+			addedAnnotations: 1,
+		},
+	} {
+		t.Run(m, func(t *testing.T) {
+			nd := tc.n1.Diff(tc.n2)
+			if tc.added+tc.removed+tc.addedAnnotations+tc.removedAnnotations == 0 {
+				require.Nil(t, nd)
+				return
+			}
+			require.NotNil(t, nd)
+			require.Len(t, nd.Added.Properties, tc.added)
+			require.Len(t, nd.Removed.Properties, tc.removed)
+			require.Len(t, nd.Added.Annotations, tc.addedAnnotations)
+			require.Len(t, nd.Removed.Annotations, tc.removedAnnotations)
+		})
+	}
+}
+
+func TestNodePropertiesCopy(t *testing.T) {
+	n := NewNode()
+	n.AddProperty("a", "1")
+	n.Properties[0].Properties = []*Property{{Name: "b", Value: "2"}}
+	n.Annotations = append(n.Annotations, &Annotation{
+		Type: Annotation_OTHER, Tool: &Tool{Name: "scanner", Version: "1.0"},
+		Date: timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)), Text: "scanned",
+	})
+
+	n2 := n.Copy()
+	require.Equal(t, n.flatString(), n2.flatString())
+	require.Equal(t, "1", n2.GetPropertyValue("a"))
+	require.Empty(t, n2.GetPropertyValue("b"))
+
+	// Mutating the copy must not change the original
+	n2.Properties[0].Properties[0].Value = "3"
+	n2.Annotations[0].Tool.Version = "2.0"
+	require.Equal(t, "2", n.Properties[0].Properties[0].Value)
+	require.Equal(t, "1.0", n.Annotations[0].Tool.Version)
+	require.NotEqual(t, n.flatString(), n2.flatString())
+}
+
+func TestNodePropertiesAugment(t *testing.T) {
+	n := NewNode()
+	n2 := NewNode()
+	n2.AddProperty("a", "1")
+	n2.Annotations = append(n2.Annotations, &Annotation{Text: "note"})
+	n.Augment(n2)
+	require.Equal(t, "1", n.GetPropertyValue("a"))
+	require.Len(t, n.Annotations, 1)
+
+	// Augment does not overwrite existing properties
+	n3 := NewNode()
+	n3.AddProperty("a", "2")
+	n.Augment(n3)
+	require.Equal(t, "1", n.GetPropertyValue("a"))
+}
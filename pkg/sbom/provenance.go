@@ -0,0 +1,266 @@
+package sbom
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ProvenancePropertyName is the name of the properties used to serialize the
+// provenance of nodes in formats that don't support it natively.
+const ProvenancePropertyName = "protobom:provenance"
+
+// provenanceFieldName is the name of the node field that holds the provenance
+const provenanceFieldName = "provenance"
+
+// NewProvenance returns a provenance entry for the document described by the
+// metadata. The first tool of the metadata is recorded as the source tool.
+func NewProvenance(md *Metadata) *Provenance {
+	p := &Provenance{
+		DocumentId: md.GetId(),
+		Fields:     []string{},
+	}
+	if len(md.GetTools()) > 0 {
+		t := md.GetTools()[0]
+		p.Tool = &Tool{Name: t.GetName(), Version: t.GetVersion(), Vendor: t.GetVendor()}
+	}
+	if md.GetDate() != nil {
+		p.Date = timestamppb.New(md.GetDate().AsTime())
+	}
+	return p
+}
+
+// TrackProvenance enables provenance tracking for the document by recording
+// the document as the source of all its nodes. Once enabled, Update, Augment
+// and the NodeList merge operations keep a record of the documents that
+// contributed each node and which fields they set.
+func (d *Document) TrackProvenance() {
+	p := NewProvenance(d.GetMetadata())
+	for _, n := range d.GetNodeList().GetNodes() {
+		n.AddProvenance(p)
+	}
+}
+
+// Copy returns a duplicate of the provenance entry
+func (p *Provenance) Copy() *Provenance {
+	np := &Provenance{
+		DocumentId: p.DocumentId,
+		Fields:     slices.Clone(p.Fields),
+	}
+	if p.Tool != nil {
+		np.Tool = &Tool{Name: p.Tool.Name, Version: p.Tool.Version, Vendor: p.Tool.Vendor}
+	}
+	if p.Date != nil {
+		np.Date = timestamppb.New(p.Date.AsTime())
+	}
+	return np
+}
+
+// sameSource returns true if both entries point to the same document and tool
+func (p *Provenance) sameSource(p2 *Provenance) bool {
+	return p.GetDocumentId() == p2.GetDocumentId() &&
+		p.GetTool().GetName() == p2.GetTool().GetName() &&
+		p.GetTool().GetVersion() == p2.GetTool().GetVersion()
+}
+
+// AddProvenance records a source of the node. If the node already has an
+// entry for the same source, the fields are added to it. A field can only be
+// attributed to one source so it is removed from the entries of other sources.
+func (n *Node) AddProvenance(p *Provenance) {
+	var entry *Provenance
+	for _, e := range n.Provenance {
+		if e.sameSource(p) {
+			entry = e
+			continue
+		}
+		e.Fields = slices.DeleteFunc(e.Fields, func(f string) bool {
+			return slices.Contains(p.GetFields(), f)
+		})
+	}
+
+	if entry == nil {
+		n.Provenance = append(n.Provenance, p.Copy())
+		return
+	}
+	for _, f := range p.GetFields() {
+		if !slices.Contains(entry.Fields, f) {
+			entry.Fields = append(entry.Fields, f)
+		}
+	}
+}
+
+// GetFieldProvenance returns the source that set the value of a node field,
+// named as in the protobom proto definition (eg "licenses"). Fields not set
+// by a merge are attributed to the first source of the node. Returns nil if
+// provenance is not tracked for the node.
+func (n *Node) GetFieldProvenance(field string) *Provenance {
+	for _, p := range n.GetProvenance() {
+		if slices.Contains(p.GetFields(), field) {
+			return p
+		}
+	}
+	if len(n.GetProvenance()) > 0 {
+		return n.GetProvenance()[0]
+	}
+	return nil
+}
+
+// trackMerge records the provenance of the data merged from n2 into the node.
+// before is a copy of the node taken before the merge. Nothing is recorded if
+// n2 has no provenance data.
+func (n *Node) trackMerge(before, n2 *Node) {
+	if len(n2.GetProvenance()) == 0 {
+		return
+	}
+	for _, field := range changedFields(before, n) {
+		src := n2.GetFieldProvenance(field).Copy()
+		src.Fields = []string{field}
+		n.AddProvenance(src)
+	}
+	for _, p := range n2.GetProvenance() {
+		src := p.Copy()
+		src.Fields = []string{}
+		n.AddProvenance(src)
+	}
+}
+
+// changedFields returns the names of the node fields that differ between the
+// nodes, not including the provenance.
+func changedFields(n, n2 *Node) []string {
+	ret := []string{}
+	fields := n.ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Name() == provenanceFieldName {
+			continue
+		}
+		if !proto.Equal(nodeField(n, fd), nodeField(n2, fd)) {
+			ret = append(ret, string(fd.Name()))
+		}
+	}
+	return ret
+}
+
+// nodeField returns a node with only the value of the field copied from n
+func nodeField(n *Node, fd protoreflect.FieldDescriptor) *Node {
+	ret := &Node{}
+	if n.ProtoReflect().Has(fd) {
+		ret.ProtoReflect().Set(fd, n.ProtoReflect().Get(fd))
+	}
+	return ret
+}
+
+// ToProperty renders the provenance entry as a property to serialize it in
+// formats without native support for provenance.
+func (p *Provenance) ToProperty() *Property {
+	v := url.Values{}
+	v.Set("document", p.GetDocumentId())
+	if p.GetTool() != nil {
+		v.Set("tool", p.GetTool().GetName())
+		if p.GetTool().GetVersion() != "" {
+			v.Set("tool_version", p.GetTool().GetVersion())
+		}
+		if p.GetTool().GetVendor() != "" {
+			v.Set("tool_vendor", p.GetTool().GetVendor())
+		}
+	}
+	if p.GetDate() != nil {
+		v.Set("date", p.GetDate().AsTime().UTC().Format(time.RFC3339))
+	}
+	for _, f := range p.GetFields() {
+		v.Add("field", f)
+	}
+	return &Property{Name: ProvenancePropertyName, Value: v.Encode()}
+}
+
+// ProvenanceFromProperty parses a provenance entry serialized as a property
+// by Provenance.ToProperty.
+func ProvenanceFromProperty(prop *Property) (*Provenance, error) {
+	if prop.GetName() != ProvenancePropertyName {
+		return nil, fmt.Errorf("property %q is not a provenance entry", prop.GetName())
+	}
+	v, err := url.ParseQuery(prop.GetValue())
+	if err != nil {
+		return nil, fmt.Errorf("parsing provenance data: %w", err)
+	}
+	if !v.Has("document") {
+		return nil, errors.New("provenance data has no document")
+	}
+
+	p := &Provenance{
+		DocumentId: v.Get("document"),
+		Fields:     []string{},
+	}
+	if v.Has("tool") {
+		p.Tool = &Tool{Name: v.Get("tool"), Version: v.Get("tool_version"), Vendor: v.Get("tool_vendor")}
+	}
+	if v.Get("date") != "" {
+		t, err := time.Parse(time.RFC3339, v.Get("date"))
+		if err != nil {
+			return nil, fmt.Errorf("parsing provenance date: %w", err)
+		}
+		p.Date = timestamppb.New(t)
+	}
+	if v.Has("field") {
+		p.Fields = v["field"]
+	}
+	return p, nil
+}
+
+// ToAnnotation renders the provenance entry as an annotation made by protobom
+// to serialize it in formats without properties.
+func (p *Provenance) ToAnnotation() *Annotation {
+	a := &Annotation{
+		Type: Annotation_OTHER,
+		Tool: &Tool{Name: "protobom"},
+		Text: ProvenancePropertyName + " " + p.ToProperty().GetValue(),
+	}
+	if p.GetDate() != nil {
+		a.Date = timestamppb.New(p.GetDate().AsTime())
+	}
+	return a
+}
+
+// ProvenanceFromAnnotation parses a provenance entry serialized as an
+// annotation by Provenance.ToAnnotation.
+func ProvenanceFromAnnotation(a *Annotation) (*Provenance, error) {
+	value, ok := strings.CutPrefix(a.GetText(), ProvenancePropertyName+" ")
+	if !ok {
+		return nil, errors.New("annotation is not a provenance entry")
+	}
+	return ProvenanceFromProperty(&Property{Name: ProvenancePropertyName, Value: value})
+}
+
+// RestoreProvenance moves the provenance entries serialized as properties or
+// annotations of the node back to its provenance. Entries that cannot be
+// parsed are left in place.
+func (n *Node) RestoreProvenance() {
+	props := []*Property{}
+	for _, prop := range n.Properties {
+		p, err := ProvenanceFromProperty(prop)
+		if err != nil {
+			props = append(props, prop)
+			continue
+		}
+		n.Provenance = append(n.Provenance, p)
+	}
+	n.Properties = props
+
+	annotations := []*Annotation{}
+	for _, a := range n.Annotations {
+		p, err := ProvenanceFromAnnotation(a)
+		if err != nil {
+			annotations = append(annotations, a)
+			continue
+		}
+		n.Provenance = append(n.Provenance, p)
+	}
+	n.Annotations = annotations
+}
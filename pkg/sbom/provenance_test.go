@@ -0,0 +1,113 @@
+package sbom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func provenanceTestDocument(id, tool string, n *Node) *Document {
+	doc := NewDocument()
+	doc.Metadata.Id = id
+	doc.Metadata.Tools = append(doc.Metadata.Tools, &Tool{Name: tool, Version: "1.0"})
+	doc.Metadata.Date = timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	doc.NodeList.AddRootNode(n)
+	doc.TrackProvenance()
+	return doc
+}
+
+func TestProvenanceUnion(t *testing.T) {
+	scan := provenanceTestDocument("scan", "scanner", &Node{
+		Id: "log4j", Name: "log4j-core", Version: "2.14.1",
+		Hashes: map[int32]string{int32(HashAlgorithm_SHA256): "abcdef"},
+	})
+	build := provenanceTestDocument("build", "builder", &Node{
+		Id: "log4j", Name: "log4j-core", Version: "2.14.1", Licenses: []string{"Apache-2.0"},
+	})
+
+	nl := scan.NodeList.Union(build.NodeList)
+	n := nl.GetNodeByID("log4j")
+	require.NotNil(t, n)
+	require.Len(t, n.Provenance, 2)
+	require.Equal(t, "scan", n.Provenance[0].DocumentId)
+	require.Equal(t, "build", n.Provenance[1].DocumentId)
+	require.Equal(t, []string{"licenses"}, n.Provenance[1].Fields)
+
+	require.Equal(t, "build", n.GetFieldProvenance("licenses").DocumentId)
+	require.Equal(t, "scan", n.GetFieldProvenance("hashes").DocumentId)
+	require.Equal(t, "builder", n.GetFieldProvenance("licenses").Tool.Name)
+
+	// The merge must not modify the source nodes
+	require.Len(t, scan.NodeList.Nodes[0].Provenance, 1)
+	require.Empty(t, scan.NodeList.Nodes[0].Provenance[0].Fields)
+}
+
+func TestProvenanceOverwrite(t *testing.T) {
+	n := &Node{Id: "a", Licenses: []string{"MIT"}}
+	n.AddProvenance(&Provenance{DocumentId: "first"})
+
+	n2 := &Node{Id: "a", Licenses: []string{"Apache-2.0"}}
+	n2.AddProvenance(&Provenance{DocumentId: "second"})
+	n.Update(n2)
+	require.Equal(t, "second", n.GetFieldProvenance("licenses").DocumentId)
+
+	n3 := &Node{Id: "a", Licenses: []string{"MIT"}}
+	n3.AddProvenance(&Provenance{DocumentId: "first"})
+	n.Update(n3)
+	require.Equal(t, "first", n.GetFieldProvenance("licenses").DocumentId)
+	require.Empty(t, n.Provenance[1].Fields)
+
+	// Augment only records the fields it sets
+	n4 := &Node{Id: "a", Licenses: []string{"GPL-2.0-only"}, Description: "A package"}
+	n4.AddProvenance(&Provenance{DocumentId: "third"})
+	n.Augment(n4)
+	require.Equal(t, "first", n.GetFieldProvenance("licenses").DocumentId)
+	require.Equal(t, "third", n.GetFieldProvenance("description").DocumentId)
+}
+
+func TestProvenanceNotTracked(t *testing.T) {
+	n := &Node{Id: "a"}
+	n.Update(&Node{Id: "a", Name: "test"})
+	require.Nil(t, n.GetFieldProvenance("name"))
+	require.Empty(t, n.Provenance)
+
+	// Provenance is not part of the node contents
+	n2 := n.Copy()
+	n2.AddProvenance(&Provenance{DocumentId: "doc"})
+	require.True(t, n.Equal(n2))
+	require.Nil(t, n.Diff(n2))
+}
+
+func TestProvenanceSerialization(t *testing.T) {
+	p := &Provenance{
+		DocumentId: "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+		Tool:       &Tool{Name: "syft", Version: "0.96.0", Vendor: "Anchore"},
+		Date:       timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Fields:     []string{"licenses", "hashes"},
+	}
+
+	prop := p.ToProperty()
+	require.Equal(t, ProvenancePropertyName, prop.Name)
+	parsed, err := ProvenanceFromProperty(prop)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(p, parsed))
+
+	parsed, err = ProvenanceFromAnnotation(p.ToAnnotation())
+	require.NoError(t, err)
+	require.True(t, proto.Equal(p, parsed))
+
+	_, err = ProvenanceFromProperty(&Property{Name: ProvenancePropertyName, Value: "tool=syft"})
+	require.Error(t, err)
+
+	n := &Node{
+		Properties:  []*Property{{Name: "a", Value: "1"}, p.ToProperty()},
+		Annotations: []*Annotation{{Text: "Reviewed"}, p.ToAnnotation()},
+	}
+	n.RestoreProvenance()
+	require.Len(t, n.Provenance, 2)
+	require.Len(t, n.Properties, 1)
+	require.Len(t, n.Annotations, 1)
+}
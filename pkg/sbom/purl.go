@@ -0,0 +1,384 @@
+package sbom
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// This file implements parsing and normalization of package URLs following
+// the purl specification (https://github.com/package-url/purl-spec). The
+// PackageURL type is kept as a plain string so that purls are stored exactly
+// as they were read, parsing happens only when the components are needed.
+
+// ErrInvalidPurl is returned when a string cannot be parsed as a package URL
+var ErrInvalidPurl = fmt.Errorf("invalid package url")
+
+// PurlMatchMode controls which parts of two package URLs are compared when
+// matching them. Modes can be combined with the bitwise OR operator.
+type PurlMatchMode int
+
+// PurlMatchExact compares all the components of the purls
+const PurlMatchExact PurlMatchMode = 0
+
+const (
+	// PurlMatchIgnoreVersion does not compare the versions
+	PurlMatchIgnoreVersion PurlMatchMode = 1 << iota
+
+	// PurlMatchIgnoreQualifiers does not compare the qualifiers
+	PurlMatchIgnoreQualifiers
+
+	// PurlMatchIgnoreSubpath does not compare the subpaths
+	PurlMatchIgnoreSubpath
+
+	// PurlMatchQualifierSubset matches if the qualifiers of the first purl
+	// are a subset of the qualifiers in the second one. For example,
+	// pkg:deb/debian/bash@5.1 matches pkg:deb/debian/bash@5.1?arch=amd64
+	// but not the other way around.
+	PurlMatchQualifierSubset
+)
+
+// PurlComponents are the parsed parts of a package URL. Namespace and
+// subpath segments are joined with slashes, all values are unescaped.
+type PurlComponents struct {
+	Type       string
+	Namespace  string
+	Name       string
+	Version    string
+	Qualifiers map[string]string
+	Subpath    string
+}
+
+// Parse splits the package URL into its components. Besides checking the
+// syntax of the purl, it applies the normalization rules of the purl spec:
+// the type and qualifier keys are lowercased, empty qualifiers and segments
+// are dropped and the namespace and name are normalized for types that are
+// case insensitive.
+func (purl PackageURL) Parse() (*PurlComponents, error) {
+	s := strings.TrimSpace(string(purl))
+	scheme, remainder, ok := strings.Cut(s, ":")
+	if !ok || !strings.EqualFold(scheme, "pkg") {
+		return nil, fmt.Errorf("%w: %q does not start with pkg:", ErrInvalidPurl, s)
+	}
+
+	// Some tools write the scheme followed by slashes, they are ignored
+	remainder = strings.TrimLeft(remainder, "/")
+
+	c := &PurlComponents{Qualifiers: map[string]string{}}
+	var err error
+
+	if i := strings.LastIndex(remainder, "#"); i != -1 {
+		c.Subpath, err = parsePurlSegments(remainder[i+1:], true)
+		if err != nil {
+			return nil, fmt.Errorf("%w: parsing subpath: %w", ErrInvalidPurl, err)
+		}
+		remainder = remainder[:i]
+	}
+
+	if i := strings.LastIndex(remainder, "?"); i != -1 {
+		if err := c.parseQualifiers(remainder[i+1:]); err != nil {
+			return nil, err
+		}
+		remainder = remainder[:i]
+	}
+
+	// The type is the first segment
+	typ, remainder, ok := strings.Cut(remainder, "/")
+	if !ok {
+		return nil, fmt.Errorf("%w: %q has no name", ErrInvalidPurl, s)
+	}
+	c.Type = strings.ToLower(typ)
+	if !validPurlType(c.Type) {
+		return nil, fmt.Errorf("%w: invalid type %q", ErrInvalidPurl, typ)
+	}
+
+	// The version is after the last @ of the name. An @ in the namespace
+	// (as in unencoded npm scopes) is not a version separator.
+	remainder = strings.Trim(remainder, "/")
+	if i := strings.LastIndex(remainder, "@"); i != -1 && i > strings.LastIndex(remainder, "/") {
+		c.Version, err = url.PathUnescape(remainder[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: parsing version: %w", ErrInvalidPurl, err)
+		}
+		remainder = remainder[:i]
+	}
+
+	namespace := ""
+	if i := strings.LastIndex(remainder, "/"); i != -1 {
+		namespace = remainder[:i]
+		remainder = remainder[i+1:]
+	}
+
+	c.Name, err = url.PathUnescape(remainder)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parsing name: %w", ErrInvalidPurl, err)
+	}
+	if c.Name == "" {
+		return nil, fmt.Errorf("%w: %q has no name", ErrInvalidPurl, s)
+	}
+
+	c.Namespace, err = parsePurlSegments(namespace, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parsing namespace: %w", ErrInvalidPurl, err)
+	}
+
+	c.normalize()
+	return c, nil
+}
+
+// parseQualifiers reads the query string of the purl into the qualifiers map
+func (c *PurlComponents) parseQualifiers(qs string) error {
+	for _, pair := range strings.Split(qs, "&") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			if pair == "" {
+				continue
+			}
+			return fmt.Errorf("%w: malformed qualifier %q", ErrInvalidPurl, pair)
+		}
+		key = strings.ToLower(key)
+		if !validPurlQualifierKey(key) {
+			return fmt.Errorf("%w: invalid qualifier key %q", ErrInvalidPurl, key)
+		}
+		value, err := url.PathUnescape(value)
+		if err != nil {
+			return fmt.Errorf("%w: parsing qualifier %q: %w", ErrInvalidPurl, key, err)
+		}
+		if value == "" {
+			continue
+		}
+		c.Qualifiers[key] = value
+	}
+	return nil
+}
+
+// parsePurlSegments unescapes a slash separated path, dropping empty segments.
+// If subpath is true, "." and ".." segments are dropped too.
+func parsePurlSegments(path string, subpath bool) (string, error) {
+	segments := []string{}
+	for _, s := range strings.Split(path, "/") {
+		s, err := url.PathUnescape(s)
+		if err != nil {
+			return "", err
+		}
+		if s == "" || (subpath && (s == "." || s == "..")) {
+			continue
+		}
+		segments = append(segments, s)
+	}
+	return strings.Join(segments, "/"), nil
+}
+
+// validPurlType checks the type is made of the allowed characters and does
+// not start with a number.
+func validPurlType(t string) bool {
+	if t == "" || (t[0] >= '0' && t[0] <= '9') {
+		return false
+	}
+	for _, r := range t {
+		if !(r >= 'a' && r <= 'z') && !(r >= '0' && r <= '9') && r != '.' && r != '+' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// validPurlQualifierKey checks a (lowercased) qualifier key is valid
+func validPurlQualifierKey(k string) bool {
+	if k == "" || (k[0] >= '0' && k[0] <= '9') {
+		return false
+	}
+	for _, r := range k {
+		if !(r >= 'a' && r <= 'z') && !(r >= '0' && r <= '9') && r != '.' && r != '_' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// normalize applies the type specific rules of the purl spec
+func (c *PurlComponents) normalize() {
+	switch c.Type {
+	case "alpm", "apk", "bitbucket", "composer", "deb", "github", "hex", "npm":
+		c.Namespace = strings.ToLower(c.Namespace)
+		c.Name = strings.ToLower(c.Name)
+	case "pub":
+		c.Name = strings.ToLower(c.Name)
+	case "pypi":
+		c.Name = strings.ReplaceAll(strings.ToLower(c.Name), "_", "-")
+	}
+}
+
+// escapePurlComponent percent-encodes all characters in s except the
+// unreserved ones and those in keep.
+func escapePurlComponent(s, keep string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z', ch >= '0' && ch <= '9',
+			ch == '-', ch == '.', ch == '_', ch == '~', strings.IndexByte(keep, ch) != -1:
+			b.WriteByte(ch)
+		default:
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
+
+// escapePurlSegments escapes each segment of a slash separated path
+func escapePurlSegments(path string) string {
+	segments := strings.Split(path, "/")
+	for i := range segments {
+		segments[i] = escapePurlComponent(segments[i], ":")
+	}
+	return strings.Join(segments, "/")
+}
+
+// String returns the canonical form of the package URL: components are
+// escaped consistently and qualifiers are sorted by key.
+func (c *PurlComponents) String() string {
+	var b strings.Builder
+	b.WriteString("pkg:")
+	b.WriteString(c.Type)
+	b.WriteString("/")
+	if c.Namespace != "" {
+		b.WriteString(escapePurlSegments(c.Namespace))
+		b.WriteString("/")
+	}
+	b.WriteString(escapePurlComponent(c.Name, ":"))
+	if c.Version != "" {
+		b.WriteString("@")
+		b.WriteString(escapePurlComponent(c.Version, ":"))
+	}
+	if qs := c.qualifierString(); qs != "" {
+		b.WriteString("?")
+		b.WriteString(qs)
+	}
+	if c.Subpath != "" {
+		b.WriteString("#")
+		b.WriteString(escapePurlSegments(c.Subpath))
+	}
+	return b.String()
+}
+
+// qualifierString returns the escaped qualifiers sorted by key
+func (c *PurlComponents) qualifierString() string {
+	keys := make([]string, 0, len(c.Qualifiers))
+	for k := range c.Qualifiers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+escapePurlComponent(c.Qualifiers[k], ":/"))
+	}
+	return strings.Join(pairs, "&")
+}
+
+// Valid returns true if the package URL can be parsed
+func (purl PackageURL) Valid() bool {
+	_, err := purl.Parse()
+	return err == nil
+}
+
+// Canonical returns the normalized form of the package URL. Two purls that
+// refer to the same package with the same qualifiers have the same canonical
+// form, regardless of the escaping used or the order of their qualifiers.
+func (purl PackageURL) Canonical() (PackageURL, error) {
+	c, err := purl.Parse()
+	if err != nil {
+		return "", err
+	}
+	return PackageURL(c.String()), nil
+}
+
+// normalized returns the canonical form of the purl or the original string
+// if it cannot be parsed.
+func (purl PackageURL) normalized() PackageURL {
+	if c, err := purl.Canonical(); err == nil {
+		return c
+	}
+	return purl
+}
+
+// components parses the purl returning empty components if it is invalid
+func (purl PackageURL) components() *PurlComponents {
+	c, err := purl.Parse()
+	if err != nil {
+		return &PurlComponents{Qualifiers: map[string]string{}}
+	}
+	return c
+}
+
+// Type returns the package type of the purl (npm, deb, oci, etc)
+func (purl PackageURL) Type() string {
+	return purl.components().Type
+}
+
+// Namespace returns the purl namespace, segments are separated by slashes
+func (purl PackageURL) Namespace() string {
+	return purl.components().Namespace
+}
+
+// Name returns the package name of the purl
+func (purl PackageURL) Name() string {
+	return purl.components().Name
+}
+
+// Version returns the package version of the purl
+func (purl PackageURL) Version() string {
+	return purl.components().Version
+}
+
+// Qualifiers returns the qualifiers of the purl
+func (purl PackageURL) Qualifiers() map[string]string {
+	return purl.components().Qualifiers
+}
+
+// Subpath returns the subpath of the purl
+func (purl PackageURL) Subpath() string {
+	return purl.components().Subpath
+}
+
+// Matches compares the purl with purl2 according to the match mode. Both
+// purls are normalized before comparing them. If any of the purls is not
+// valid, they are compared as strings.
+func (purl PackageURL) Matches(purl2 PackageURL, mode PurlMatchMode) bool {
+	c1, err1 := purl.Parse()
+	c2, err2 := purl2.Parse()
+	if err1 != nil || err2 != nil {
+		return purl != "" && purl == purl2
+	}
+	return c1.matches(c2, mode)
+}
+
+// matches compares two parsed purls according to the match mode
+func (c *PurlComponents) matches(c2 *PurlComponents, mode PurlMatchMode) bool {
+	if c.Type != c2.Type || c.Namespace != c2.Namespace || c.Name != c2.Name {
+		return false
+	}
+
+	if mode&PurlMatchIgnoreVersion == 0 && c.Version != c2.Version {
+		return false
+	}
+
+	if mode&PurlMatchIgnoreSubpath == 0 && c.Subpath != c2.Subpath {
+		return false
+	}
+
+	switch {
+	case mode&PurlMatchIgnoreQualifiers != 0:
+		return true
+	case mode&PurlMatchQualifierSubset != 0:
+		for k, v := range c.Qualifiers {
+			if v2, ok := c2.Qualifiers[k]; !ok || v != v2 {
+				return false
+			}
+		}
+		return true
+	default:
+		return c.qualifierString() == c2.qualifierString()
+	}
+}
@@ -0,0 +1,288 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurlParse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		purl      PackageURL
+		expected  *PurlComponents
+		canonical PackageURL
+		mustErr   bool
+	}{
+		"simple": {
+			purl:      "pkg:deb/debian/bash@5.1-2",
+			expected:  &PurlComponents{Type: "deb", Namespace: "debian", Name: "bash", Version: "5.1-2", Qualifiers: map[string]string{}},
+			canonical: "pkg:deb/debian/bash@5.1-2",
+		},
+		"all components": {
+			purl: "pkg:golang/github.com/protobom/protobom@v0.4.0?goos=linux&goarch=amd64#pkg/sbom",
+			expected: &PurlComponents{
+				Type: "golang", Namespace: "github.com/protobom", Name: "protobom", Version: "v0.4.0",
+				Qualifiers: map[string]string{"goos": "linux", "goarch": "amd64"}, Subpath: "pkg/sbom",
+			},
+			canonical: "pkg:golang/github.com/protobom/protobom@v0.4.0?goarch=amd64&goos=linux#pkg/sbom",
+		},
+		"escaped npm scope": {
+			purl:      "pkg:npm/%40angular/core@1.0",
+			expected:  &PurlComponents{Type: "npm", Namespace: "@angular", Name: "core", Version: "1.0", Qualifiers: map[string]string{}},
+			canonical: "pkg:npm/%40angular/core@1.0",
+		},
+		"unescaped npm scope": {
+			purl:      "pkg:npm/@angular/core@1.0",
+			expected:  &PurlComponents{Type: "npm", Namespace: "@angular", Name: "core", Version: "1.0", Qualifiers: map[string]string{}},
+			canonical: "pkg:npm/%40angular/core@1.0",
+		},
+		"unescaped npm scope no version": {
+			purl:      "pkg:npm/@angular/core",
+			expected:  &PurlComponents{Type: "npm", Namespace: "@angular", Name: "core", Qualifiers: map[string]string{}},
+			canonical: "pkg:npm/%40angular/core",
+		},
+		"no namespace": {
+			purl:      "pkg:cargo/rand@0.7.2",
+			expected:  &PurlComponents{Type: "cargo", Name: "rand", Version: "0.7.2", Qualifiers: map[string]string{}},
+			canonical: "pkg:cargo/rand@0.7.2",
+		},
+		"case and slashes": {
+			purl:      "PKG://GitHub/Protobom/Protobom@V1",
+			expected:  &PurlComponents{Type: "github", Namespace: "protobom", Name: "protobom", Version: "V1", Qualifiers: map[string]string{}},
+			canonical: "pkg:github/protobom/protobom@V1",
+		},
+		"pypi name": {
+			purl:      "pkg:pypi/Django_Allauth@1.0",
+			expected:  &PurlComponents{Type: "pypi", Name: "django-allauth", Version: "1.0", Qualifiers: map[string]string{}},
+			canonical: "pkg:pypi/django-allauth@1.0",
+		},
+		"qualifiers normalized": {
+			purl: "pkg:deb/debian/curl@7.50.3-1?Arch=i386&distro=&upstream=curl%2Bextra",
+			expected: &PurlComponents{
+				Type: "deb", Namespace: "debian", Name: "curl", Version: "7.50.3-1",
+				Qualifiers: map[string]string{"arch": "i386", "upstream": "curl+extra"},
+			},
+			canonical: "pkg:deb/debian/curl@7.50.3-1?arch=i386&upstream=curl%2Bextra",
+		},
+		"version escaping": {
+			purl:      "pkg:deb/libzstd1@1.3.8+dfsg-3+deb10u2",
+			expected:  &PurlComponents{Type: "deb", Name: "libzstd1", Version: "1.3.8+dfsg-3+deb10u2", Qualifiers: map[string]string{}},
+			canonical: "pkg:deb/libzstd1@1.3.8%2Bdfsg-3%2Bdeb10u2",
+		},
+		"subpath cleaned": {
+			purl:      "pkg:golang/google.golang.org/genproto#/googleapis/./api/../annotations/",
+			expected:  &PurlComponents{Type: "golang", Namespace: "google.golang.org", Name: "genproto", Qualifiers: map[string]string{}, Subpath: "googleapis/api/annotations"},
+			canonical: "pkg:golang/google.golang.org/genproto#googleapis/api/annotations",
+		},
+		"qualifier url": {
+			purl: "pkg:maven/org.apache/xmlgraphics-commons@1.5?repository_url=https%3A%2F%2Frepo.spring.io%2Frelease",
+			expected: &PurlComponents{
+				Type: "maven", Namespace: "org.apache", Name: "xmlgraphics-commons", Version: "1.5",
+				Qualifiers: map[string]string{"repository_url": "https://repo.spring.io/release"},
+			},
+			canonical: "pkg:maven/org.apache/xmlgraphics-commons@1.5?repository_url=https://repo.spring.io/release",
+		},
+		"no scheme":            {purl: "npm/core@1.0", mustErr: true},
+		"wrong scheme":         {purl: "http://example.com/", mustErr: true},
+		"no name":              {purl: "pkg:npm", mustErr: true},
+		"empty name":           {purl: "pkg:npm/@1.0", mustErr: true},
+		"invalid type":         {purl: "pkg:3npm/core", mustErr: true},
+		"invalid type chars":   {purl: "pkg:n_pm/core", mustErr: true},
+		"invalid qualifier":    {purl: "pkg:npm/core?arch", mustErr: true},
+		"invalid escape":       {purl: "pkg:npm/co%zzre", mustErr: true},
+		"invalid qualifierkey": {purl: "pkg:npm/core?a%20b=1", mustErr: true},
+		"empty":                {purl: "", mustErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c, err := tc.purl.Parse()
+			if tc.mustErr {
+				require.Error(t, err)
+				require.ErrorIs(t, err, ErrInvalidPurl)
+				require.False(t, tc.purl.Valid())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, c)
+			require.True(t, tc.purl.Valid())
+
+			canonical, err := tc.purl.Canonical()
+			require.NoError(t, err)
+			require.Equal(t, tc.canonical, canonical)
+
+			// The canonical form must be stable
+			again, err := canonical.Canonical()
+			require.NoError(t, err)
+			require.Equal(t, canonical, again)
+		})
+	}
+}
+
+func TestPurlAccessors(t *testing.T) {
+	purl := PackageURL("pkg:oci/debian@sha256%3A244fd47e07d10?repository_url=docker.io/library/debian&tag=latest#etc")
+	require.Equal(t, "oci", purl.Type())
+	require.Equal(t, "", purl.Namespace())
+	require.Equal(t, "debian", purl.Name())
+	require.Equal(t, "sha256:244fd47e07d10", purl.Version())
+	require.Equal(t, map[string]string{"repository_url": "docker.io/library/debian", "tag": "latest"}, purl.Qualifiers())
+	require.Equal(t, "etc", purl.Subpath())
+
+	invalid := PackageURL("not a purl")
+	require.Equal(t, "", invalid.Type())
+	require.Equal(t, "", invalid.Name())
+	require.Empty(t, invalid.Qualifiers())
+}
+
+func TestPurlMatches(t *testing.T) {
+	for name, tc := range map[string]struct {
+		purl1, purl2 PackageURL
+		mode         PurlMatchMode
+		expected     bool
+	}{
+		"identical":                {"pkg:npm/lodash@4.17.21", "pkg:npm/lodash@4.17.21", PurlMatchExact, true},
+		"equivalent escaping":      {"pkg:npm/%40angular/core@1.0", "pkg:npm/@angular/core@1.0", PurlMatchExact, true},
+		"qualifier order":          {"pkg:deb/debian/bash@5.1?arch=amd64&distro=bookworm", "pkg:deb/debian/bash@5.1?distro=bookworm&arch=amd64", PurlMatchExact, true},
+		"different version":        {"pkg:npm/lodash@4.17.21", "pkg:npm/lodash@4.17.20", PurlMatchExact, false},
+		"ignore version":           {"pkg:npm/lodash@4.17.21", "pkg:npm/lodash@4.17.20", PurlMatchIgnoreVersion, true},
+		"ignore version, no ver":   {"pkg:npm/lodash", "pkg:npm/lodash@4.17.20", PurlMatchIgnoreVersion, true},
+		"ignore version, diff pkg": {"pkg:npm/lodash@1", "pkg:npm/underscore@1", PurlMatchIgnoreVersion, false},
+		"different namespace":      {"pkg:apk/alpine/bash@4.0.1", "pkg:apk/wolfi/bash@4.0.1", PurlMatchIgnoreVersion, false},
+		"different qualifiers":     {"pkg:deb/debian/bash@5.1?arch=amd64", "pkg:deb/debian/bash@5.1?arch=arm64", PurlMatchExact, false},
+		"ignore qualifiers":        {"pkg:deb/debian/bash@5.1?arch=amd64", "pkg:deb/debian/bash@5.1?arch=arm64", PurlMatchIgnoreQualifiers, true},
+		"qualifier subset":         {"pkg:deb/debian/bash@5.1", "pkg:deb/debian/bash@5.1?arch=arm64", PurlMatchQualifierSubset, true},
+		"qualifier not subset":     {"pkg:deb/debian/bash@5.1?arch=arm64", "pkg:deb/debian/bash@5.1", PurlMatchQualifierSubset, false},
+		"qualifier subset values":  {"pkg:deb/debian/bash@5.1?arch=amd64", "pkg:deb/debian/bash@5.1?arch=arm64&distro=x", PurlMatchQualifierSubset, false},
+		"different subpath":        {"pkg:golang/x/y#a", "pkg:golang/x/y#b", PurlMatchExact, false},
+		"ignore subpath":           {"pkg:golang/x/y#a", "pkg:golang/x/y#b", PurlMatchIgnoreSubpath, true},
+		"combined modes": {
+			"pkg:deb/debian/bash@5.1?arch=amd64", "pkg:deb/debian/bash@5.2?arch=arm64",
+			PurlMatchIgnoreVersion | PurlMatchIgnoreQualifiers, true,
+		},
+		"invalid equal":  {"not a purl", "not a purl", PurlMatchExact, true},
+		"invalid differ": {"not a purl", "pkg:npm/lodash", PurlMatchExact, false},
+		"empty purls":    {"", "", PurlMatchExact, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.purl1.Matches(tc.purl2, tc.mode))
+		})
+	}
+}
+
+func TestGetNodesByPurl(t *testing.T) {
+	nl := &NodeList{
+		Nodes: []*Node{
+			{Id: "lodash-1", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/lodash@4.17.21"}},
+			{Id: "lodash-2", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/lodash@4.17.20?foo=bar"}},
+			{Id: "angular", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/%40angular/core@1.0"}},
+			{Id: "file", Type: Node_FILE, Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/lodash@4.17.21"}},
+			{Id: "nopurl"},
+		},
+	}
+	for name, tc := range map[string]struct {
+		purl     PackageURL
+		mode     PurlMatchMode
+		expected []string
+	}{
+		"exact":             {"pkg:npm/lodash@4.17.21", PurlMatchExact, []string{"lodash-1"}},
+		"all versions":      {"pkg:npm/lodash", PurlMatchIgnoreVersion | PurlMatchIgnoreQualifiers, []string{"lodash-1", "lodash-2"}},
+		"qualifier subset":  {"pkg:npm/lodash", PurlMatchIgnoreVersion | PurlMatchQualifierSubset, []string{"lodash-1", "lodash-2"}},
+		"qualifiers differ": {"pkg:npm/lodash", PurlMatchIgnoreVersion, []string{"lodash-1"}},
+		"normalized":        {"pkg:npm/@angular/core@1.0", PurlMatchExact, []string{"angular"}},
+		"no match":          {"pkg:npm/react@18", PurlMatchIgnoreVersion, []string{}},
+		"empty":             {"", PurlMatchIgnoreVersion, []string{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ids := []string{}
+			for _, n := range nl.GetNodesByPurl(tc.purl, tc.mode) {
+				ids = append(ids, n.Id)
+			}
+			require.Equal(t, tc.expected, ids)
+		})
+	}
+}
+
+func TestGetNodesByPurlType(t *testing.T) {
+	nl := &NodeList{
+		Nodes: []*Node{
+			{Id: "npm-1", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/lodash@4.17.21"}},
+			{Id: "npm-2", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:/NPM/%40angular/core@1.0"}},
+			{Id: "deb", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:deb/debian/bash@5.1"}},
+			{Id: "nopurl"},
+		},
+		Edges: []*Edge{
+			{Type: Edge_dependsOn, From: "npm-1", To: []string{"npm-2", "deb"}},
+		},
+	}
+	res := nl.GetNodesByPurlType("npm")
+	require.Len(t, res.Nodes, 2)
+	require.Equal(t, "npm-1", res.Nodes[0].Id)
+	require.Equal(t, "npm-2", res.Nodes[1].Id)
+	require.Len(t, res.Edges, 1)
+	require.Equal(t, []string{"npm-2"}, res.Edges[0].To)
+}
+
+func TestGetNodesByPurlRange(t *testing.T) {
+	nl := &NodeList{
+		Nodes: []*Node{
+			{Id: "log4j-1", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:maven/org.apache.logging.log4j/log4j-core@1.2.17"}},
+			{Id: "log4j-2", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:maven/org.apache.logging.log4j/log4j-core@2.0-beta9"}},
+			{Id: "log4j-3", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1?type=jar"}},
+			{Id: "log4j-4", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:maven/org.apache.logging.log4j/log4j-core@2.17.1"}},
+			{Id: "log4j-5", Version: "2.16.0", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:maven/org.apache.logging.log4j/log4j-core"}},
+			{Id: "log4j-6", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:maven/org.apache.logging.log4j/log4j-core"}},
+			{Id: "log4j-api", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:maven/org.apache.logging.log4j/log4j-api@2.14.1"}},
+			{Id: "bad-version", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/lodash@latest"}},
+			{Id: "lodash", Identifiers: map[int32]string{int32(SoftwareIdentifierType_PURL): "pkg:npm/lodash@4.17.20"}},
+		},
+	}
+	for name, tc := range map[string]struct {
+		purl     PackageURL
+		vers     string
+		expected []string
+		mustErr  bool
+	}{
+		"log4shell": {
+			purl:     "pkg:maven/org.apache.logging.log4j/log4j-core",
+			vers:     "vers:maven/>=2.0-beta9|<2.17.1",
+			expected: []string{"log4j-2", "log4j-3", "log4j-5"},
+		},
+		"version in purl is ignored": {
+			purl:     "pkg:maven/org.apache.logging.log4j/log4j-core@2.17.1",
+			vers:     "vers:maven/>=2.17.1",
+			expected: []string{"log4j-4"},
+		},
+		"qualifier subset": {
+			purl:     "pkg:maven/org.apache.logging.log4j/log4j-core?type=jar",
+			vers:     "vers:maven/*",
+			expected: []string{"log4j-3"},
+		},
+		"skips invalid versions": {
+			purl:     "pkg:npm/lodash",
+			vers:     "vers:npm/<4.17.21",
+			expected: []string{"lodash"},
+		},
+		"no match": {
+			purl:     "pkg:maven/org.apache.logging.log4j/log4j-core",
+			vers:     "vers:maven/>=3.0",
+			expected: []string{},
+		},
+		"invalid range": {
+			purl:    "pkg:maven/org.apache.logging.log4j/log4j-core",
+			vers:    ">=2.0",
+			mustErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := nl.GetNodesByPurlRange(tc.purl, tc.vers)
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			ids := []string{}
+			for _, n := range res {
+				ids = append(ids, n.Id)
+			}
+			require.Equal(t, tc.expected, ids)
+		})
+	}
+}
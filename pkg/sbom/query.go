@@ -0,0 +1,437 @@
+package sbom
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/protobom/protobom/pkg/vers"
+)
+
+// ErrInvalidQuery is returned when a query expression cannot be parsed
+var ErrInvalidQuery = fmt.Errorf("invalid query")
+
+// timestampName is the full name of the protobuf timestamp message
+const timestampName protoreflect.FullName = "google.protobuf.Timestamp"
+
+// Query is a compiled node query expression. Queries are made of predicates
+// combined with && (and), || (or), ! (not) and parentheses, for example:
+//
+//	type == PACKAGE && licenses contains "GPL-3.0-only" && suppliers.name ~ "Acme"
+//
+// Field predicates compare the node fields with a value. Fields are named
+// after the Node proto fields and can descend into messages (suppliers.name).
+// Identifier and hash maps are indexed by type (identifiers.cpe23,
+// hashes.sha256) and purl is a pseudo field with the node package URL and
+// its components (purl.type, purl.namespace, purl.name, purl.version,
+// purl.subpath). Enum values are compared by name. The operators are:
+//
+//	==, !=              equality, case insensitive for enums
+//	~, !~               regular expression match
+//	<, <=, >, >=        ordering of numbers, dates and versions
+//	contains            element of a list or substring of a string
+//
+// When a field has many values (repeated fields, maps or fields inside
+// repeated messages) the predicate is true if any value matches, except for
+// != and !~ which are true when no value matches. Empty values are never
+// ordered, so version < 1.3 does not match nodes without a version.
+//
+// The following functions are supported:
+//
+//	has(field)                     the field has a non-empty value
+//	is_root()                      the node is a root element
+//	reachable_from(id, types...)   the node can be reached walking the edges
+//	                               from node id, of the types if specified
+//	ancestor_of(id, types...)      node id is reachable from the node
+//	purl_matches(purl)             the node purl matches purl, any version
+//	                               if purl has none, see PackageURL.Matches
+//	purl_in_range(purl, vers)      the node is a version of purl in the vers
+//	                               range, see NodeList.GetNodesByPurlRange
+//	cpe_matches(pattern)           a node CPE matches the pattern
+//
+// In the graph functions, the bare word root can be used instead of an ID to
+// refer to the root elements of the NodeList.
+type Query struct {
+	expr string
+	root queryExpr
+}
+
+// ParseQuery compiles a query expression. See Query for the syntax.
+func ParseQuery(expr string) (*Query, error) {
+	e, err := parseQuery(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidQuery, err)
+	}
+	return &Query{expr: expr, root: e}, nil
+}
+
+// String returns the query expression
+func (q *Query) String() string {
+	return q.expr
+}
+
+// Filter evaluates the query against every node of the NodeList and returns
+// a new NodeList with the matching nodes and the edges between them. The
+// root elements of nl that match are kept as roots.
+func (q *Query) Filter(nl *NodeList) *NodeList {
+	ret := &NodeList{
+		Nodes:        []*Node{},
+		Edges:        []*Edge{},
+		RootElements: []string{},
+	}
+	if nl == nil {
+		return ret
+	}
+
+	ctx := &queryContext{
+		nl:     nl,
+		roots:  nl.indexRootElements(),
+		graphs: map[*graphExpr]map[string]struct{}{},
+	}
+
+	matched := map[string]struct{}{}
+	for _, n := range nl.Nodes {
+		if _, ok := matched[n.Id]; ok {
+			continue
+		}
+		if q.root.match(ctx, n) {
+			matched[n.Id] = struct{}{}
+			ret.Nodes = append(ret.Nodes, n)
+		}
+	}
+
+	for _, e := range nl.Edges {
+		if _, ok := matched[e.From]; !ok {
+			continue
+		}
+		tos := []string{}
+		for _, to := range e.To {
+			if _, ok := matched[to]; ok {
+				tos = append(tos, to)
+			}
+		}
+		if len(tos) > 0 {
+			ne := e.Copy()
+			ne.To = tos
+			ret.Edges = append(ret.Edges, ne)
+		}
+	}
+
+	for _, id := range nl.RootElements {
+		if _, ok := matched[id]; ok {
+			ret.RootElements = append(ret.RootElements, id)
+		}
+	}
+	return ret
+}
+
+// Query returns a new NodeList with the nodes that match the query expression
+// and the edges between them. See Query for the expression syntax.
+func (nl *NodeList) Query(expr string) (*NodeList, error) {
+	q, err := ParseQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+	return q.Filter(nl), nil
+}
+
+// queryContext holds the data shared by the predicates while evaluating a
+// query against a NodeList.
+type queryContext struct {
+	nl     *NodeList
+	roots  rootElementsIndex
+	graphs map[*graphExpr]map[string]struct{}
+
+	// index is built the first time a graph expression is evaluated
+	index *graphIndex
+}
+
+// queryExpr is a node of the query syntax tree
+type queryExpr interface {
+	match(*queryContext, *Node) bool
+}
+
+type andExpr struct {
+	left, right queryExpr
+}
+
+func (e *andExpr) match(ctx *queryContext, n *Node) bool {
+	return e.left.match(ctx, n) && e.right.match(ctx, n)
+}
+
+type orExpr struct {
+	left, right queryExpr
+}
+
+func (e *orExpr) match(ctx *queryContext, n *Node) bool {
+	return e.left.match(ctx, n) || e.right.match(ctx, n)
+}
+
+type notExpr struct {
+	expr queryExpr
+}
+
+func (e *notExpr) match(ctx *queryContext, n *Node) bool {
+	return !e.expr.match(ctx, n)
+}
+
+// compareExpr compares the values of a field with a literal
+type compareExpr struct {
+	path  *queryPath
+	op    string
+	value string
+	re    *regexp.Regexp
+}
+
+func (e *compareExpr) match(_ *queryContext, n *Node) bool {
+	values := e.path.get(n)
+	switch e.op {
+	case "==", "!=":
+		found := false
+		for _, v := range values {
+			if v == e.value || (e.path.enum && strings.EqualFold(v, e.value)) {
+				found = true
+				break
+			}
+		}
+		return found == (e.op == "==")
+	case "~", "!~":
+		found := false
+		for _, v := range values {
+			if e.re.MatchString(v) {
+				found = true
+				break
+			}
+		}
+		return found == (e.op == "~")
+	case "contains":
+		for _, v := range values {
+			if (e.path.repeated && v == e.value) || (!e.path.repeated && strings.Contains(v, e.value)) {
+				return true
+			}
+		}
+		return false
+	default:
+		for _, v := range values {
+			if v == "" {
+				continue
+			}
+			c := compareQueryValues(v, e.value)
+			if (e.op == "<" && c < 0) || (e.op == "<=" && c <= 0) ||
+				(e.op == ">" && c > 0) || (e.op == ">=" && c >= 0) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// compareQueryValues orders two values. They are compared as numbers or dates
+// if both can be parsed as such, then as generic versions and finally as
+// strings.
+func compareQueryValues(a, b string) int {
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	ta, okA := parseQueryTime(a)
+	tb, okB := parseQueryTime(b)
+	if okA && okB {
+		return ta.Compare(tb)
+	}
+
+	if c, err := vers.Generic.Compare(a, b); err == nil {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// parseQueryTime parses a date or a timestamp in RFC 3339 format
+func parseQueryTime(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// hasExpr checks if a field has a non-empty value
+type hasExpr struct {
+	path *queryPath
+}
+
+func (e *hasExpr) match(_ *queryContext, n *Node) bool {
+	for _, v := range e.path.get(n) {
+		if v != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// isRootExpr checks if the node is a root element
+type isRootExpr struct{}
+
+func (e *isRootExpr) match(ctx *queryContext, n *Node) bool {
+	_, ok := ctx.roots[n.Id]
+	return ok
+}
+
+// graphExpr checks if a node is connected to a target node
+type graphExpr struct {
+	target    string
+	root      bool
+	direction TraversalDirection
+	edgeTypes []Edge_Type
+}
+
+func (e *graphExpr) match(ctx *queryContext, n *Node) bool {
+	reached, ok := ctx.graphs[e]
+	if !ok {
+		reached = map[string]struct{}{}
+		targets := []string{e.target}
+		if e.root {
+			targets = ctx.nl.RootElements
+		}
+		if ctx.index == nil {
+			ctx.index = newGraphIndex(ctx.nl)
+		}
+		opts := &TraversalOptions{Direction: e.direction, IncludeEdgeTypes: e.edgeTypes}
+		for _, id := range targets {
+			for _, r := range ctx.index.traverse(id, opts).Nodes {
+				reached[r.Id] = struct{}{}
+			}
+		}
+		ctx.graphs[e] = reached
+	}
+	_, ok = reached[n.Id]
+	return ok
+}
+
+// purlExpr matches the node package URL and, optionally, its version
+type purlExpr struct {
+	purl      PackageURL
+	mode      PurlMatchMode
+	versRange *vers.Range
+}
+
+func (e *purlExpr) match(_ *queryContext, n *Node) bool {
+	if !e.purl.Matches(n.Purl(), e.mode) {
+		return false
+	}
+	return e.versRange == nil || nodeVersionInRange(n, e.versRange)
+}
+
+// cpeExpr matches the node CPE identifiers
+type cpeExpr struct {
+	cpe *CPE
+}
+
+func (e *cpeExpr) match(_ *queryContext, n *Node) bool {
+	return nodeMatchesCPE(n, e.cpe)
+}
+
+// queryPath extracts the values of a field from a node
+type queryPath struct {
+	name  string
+	steps []queryPathStep
+
+	// enum is true when the values are enum names
+	enum bool
+
+	// repeated is true when the field can have many values
+	repeated bool
+
+	// values extracts the values of pseudo fields
+	values func(*Node) []string
+}
+
+// queryPathStep is a field of the path and, for maps, the selected key
+type queryPathStep struct {
+	field protoreflect.FieldDescriptor
+	key   *protoreflect.MapKey
+}
+
+// get returns the values of the field in the node as strings
+func (p *queryPath) get(n *Node) []string {
+	if p.values != nil {
+		return p.values(n)
+	}
+	ret := []string{}
+	collectQueryValues(n.ProtoReflect(), p.steps, &ret)
+	return ret
+}
+
+// collectQueryValues walks the path steps from message m appending the
+// values found to ret.
+func collectQueryValues(m protoreflect.Message, steps []queryPathStep, ret *[]string) {
+	step := steps[0]
+	fd := step.field
+	v := m.Get(fd)
+	switch {
+	case fd.IsMap():
+		if step.key != nil {
+			if v.Map().Has(*step.key) {
+				*ret = append(*ret, formatQueryValue(fd.MapValue(), v.Map().Get(*step.key)))
+			}
+			return
+		}
+		v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+			*ret = append(*ret, formatQueryValue(fd.MapValue(), mv))
+			return true
+		})
+	case fd.IsList():
+		l := v.List()
+		for i := 0; i < l.Len(); i++ {
+			collectQueryItem(fd, l.Get(i), steps[1:], ret)
+		}
+	default:
+		if fd.Kind() == protoreflect.MessageKind && !m.Has(fd) {
+			return
+		}
+		collectQueryItem(fd, v, steps[1:], ret)
+	}
+}
+
+// collectQueryItem appends a single value, descending into it if it is a
+// message.
+func collectQueryItem(fd protoreflect.FieldDescriptor, v protoreflect.Value, rest []queryPathStep, ret *[]string) {
+	if fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() != timestampName {
+		collectQueryValues(v.Message(), rest, ret)
+		return
+	}
+	*ret = append(*ret, formatQueryValue(fd, v))
+}
+
+// formatQueryValue returns the string used to compare a scalar value
+func formatQueryValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.Itoa(int(v.Enum()))
+	case protoreflect.MessageKind:
+		if ts, ok := v.Message().Interface().(*timestamppb.Timestamp); ok {
+			return ts.AsTime().UTC().Format(time.RFC3339)
+		}
+		return ""
+	default:
+		return v.String()
+	}
+}
@@ -0,0 +1,601 @@
+package sbom
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/protobom/protobom/pkg/vers"
+)
+
+// This file implements the lexer and the recursive descent parser of the
+// NodeList query language. The grammar is:
+//
+//	expr       = and { ( "||" | "or" ) and }
+//	and        = unary { ( "&&" | "and" ) unary }
+//	unary      = ( "!" | "not" ) unary | primary
+//	primary    = "(" expr ")" | call | comparison
+//	call       = "has" "(" path ")" | ident "(" [ value { "," value } ] ")"
+//	comparison = path operator value
+//	path       = ident { "." ident }
+//	operator   = "==" | "!=" | "~" | "!~" | "<" | "<=" | ">" | ">=" | "contains"
+//	value      = string | number | ident
+
+// queryTokenType is the kind of a lexical token of a query
+type queryTokenType int
+
+const (
+	tokenEOF queryTokenType = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+	tokenComma
+	tokenDot
+)
+
+// queryToken is a lexical token and its position in the expression
+type queryToken struct {
+	typ   queryTokenType
+	value string
+	pos   int
+}
+
+// String returns a description of the token for error messages
+func (t queryToken) String() string {
+	switch t.typ {
+	case tokenEOF:
+		return "end of query"
+	case tokenString:
+		return strconv.Quote(t.value)
+	default:
+		return fmt.Sprintf("%q", t.value)
+	}
+}
+
+// lexQuery splits a query expression into tokens
+func lexQuery(expr string) ([]queryToken, error) {
+	tokens := []queryToken{}
+	i := 0
+	for i < len(expr) {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, queryToken{tokenLParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, queryToken{tokenRParen, ")", i})
+			i++
+		case c == ',':
+			tokens = append(tokens, queryToken{tokenComma, ",", i})
+			i++
+		case c == '.':
+			tokens = append(tokens, queryToken{tokenDot, ".", i})
+			i++
+		case c == '"' || c == '\'':
+			end := i + 1
+			for end < len(expr) && expr[end] != c {
+				if expr[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(expr) {
+				return nil, fmt.Errorf("unterminated string at position %d", i)
+			}
+			value := expr[i+1 : end]
+			if c == '"' {
+				v, err := strconv.Unquote(expr[i : end+1])
+				if err != nil {
+					return nil, fmt.Errorf("invalid string at position %d: %w", i, err)
+				}
+				value = v
+			}
+			tokens = append(tokens, queryToken{tokenString, value, i})
+			i = end + 1
+		case strings.HasPrefix(expr[i:], "&&"):
+			tokens = append(tokens, queryToken{tokenAnd, "&&", i})
+			i += 2
+		case strings.HasPrefix(expr[i:], "||"):
+			tokens = append(tokens, queryToken{tokenOr, "||", i})
+			i += 2
+		case strings.HasPrefix(expr[i:], "=="), strings.HasPrefix(expr[i:], "!="),
+			strings.HasPrefix(expr[i:], "!~"), strings.HasPrefix(expr[i:], "<="),
+			strings.HasPrefix(expr[i:], ">="):
+			tokens = append(tokens, queryToken{tokenOperator, expr[i : i+2], i})
+			i += 2
+		case c == '~' || c == '<' || c == '>':
+			tokens = append(tokens, queryToken{tokenOperator, string(c), i})
+			i++
+		case c == '!':
+			tokens = append(tokens, queryToken{tokenNot, "!", i})
+			i++
+		case isQueryIdentStart(c):
+			start := i
+			for i < len(expr) && (isQueryIdentStart(expr[i]) || isDigit(expr[i]) || expr[i] == '-') {
+				i++
+			}
+			word := expr[start:i]
+			switch strings.ToLower(word) {
+			case "and":
+				tokens = append(tokens, queryToken{tokenAnd, word, start})
+			case "or":
+				tokens = append(tokens, queryToken{tokenOr, word, start})
+			case "not":
+				tokens = append(tokens, queryToken{tokenNot, word, start})
+			case "contains":
+				tokens = append(tokens, queryToken{tokenOperator, "contains", start})
+			default:
+				tokens = append(tokens, queryToken{tokenIdent, word, start})
+			}
+		case isDigit(c) || c == '-':
+			// Numbers can be followed by more dotted numbers and letters
+			// to allow unquoted versions like 1.2.3 or dates like 2024-01-01
+			start := i
+			i++
+			for i < len(expr) && (isDigit(expr[i]) || isQueryIdentStart(expr[i]) || strings.ContainsRune(".-+:", rune(expr[i]))) {
+				i++
+			}
+			tokens = append(tokens, queryToken{tokenNumber, expr[start:i], start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+	tokens = append(tokens, queryToken{tokenEOF, "", len(expr)})
+	return tokens, nil
+}
+
+// isQueryIdentStart returns true if c can start an identifier
+func isQueryIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// isDigit returns true if c is an ASCII digit
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// queryParser is a recursive descent parser over the query tokens
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+// peek returns the current token without consuming it
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
+
+// next consumes the current token and returns it
+func (p *queryParser) next() queryToken {
+	t := p.tokens[p.pos]
+	if t.typ != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// expect consumes a token of the specified type or returns an error
+func (p *queryParser) expect(typ queryTokenType, what string) (queryToken, error) {
+	t := p.next()
+	if t.typ != typ {
+		return t, fmt.Errorf("expected %s at position %d, found %s", what, t.pos, t)
+	}
+	return t, nil
+}
+
+// parseQuery parses the query expression into its syntax tree
+func parseQuery(expr string) (queryExpr, error) {
+	tokens, err := lexQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &queryParser{tokens: tokens}
+	if p.peek().typ == tokenEOF {
+		return nil, fmt.Errorf("query is empty")
+	}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.typ != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d", t, t.pos)
+	}
+	return e, nil
+}
+
+func (p *queryParser) parseOr() (queryExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().typ == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() (queryExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().typ == tokenAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseUnary() (queryExpr, error) {
+	if p.peek().typ == tokenNot {
+		p.next()
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{expr: e}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (queryExpr, error) {
+	t := p.peek()
+	switch t.typ {
+	case tokenLParen:
+		p.next()
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRParen, "closing parenthesis"); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case tokenIdent:
+		if p.tokens[p.pos+1].typ == tokenLParen {
+			return p.parseCall()
+		}
+		return p.parseComparison()
+	default:
+		return nil, fmt.Errorf("expected a predicate at position %d, found %s", t.pos, t)
+	}
+}
+
+// parseValue reads a literal operand
+func (p *queryParser) parseValue() (queryToken, error) {
+	t := p.next()
+	switch {
+	case t.typ == tokenString, t.typ == tokenNumber, t.typ == tokenIdent:
+		return t, nil
+	case t.value != "" && isQueryIdentStart(t.value[0]):
+		// Keywords are plain words when used as values (eg the contains
+		// edge type)
+		t.typ = tokenIdent
+		return t, nil
+	default:
+		return t, fmt.Errorf("expected a value at position %d, found %s", t.pos, t)
+	}
+}
+
+// parsePath reads a dotted field path and resolves it
+func (p *queryParser) parsePath() (*queryPath, error) {
+	start := p.peek()
+	segments := []string{}
+	for {
+		t, err := p.expect(tokenIdent, "field name")
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, t.value)
+		if p.peek().typ != tokenDot {
+			break
+		}
+		p.next()
+	}
+
+	path, err := resolveQueryPath(segments)
+	if err != nil {
+		return nil, fmt.Errorf("at position %d: %w", start.pos, err)
+	}
+	return path, nil
+}
+
+func (p *queryParser) parseComparison() (queryExpr, error) {
+	path, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := p.expect(tokenOperator, "comparison operator")
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	cmp := &compareExpr{path: path, op: op.value, value: value.value}
+	if op.value == "~" || op.value == "!~" {
+		re, err := regexp.Compile(value.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression at position %d: %w", value.pos, err)
+		}
+		cmp.re = re
+	}
+	return cmp, nil
+}
+
+func (p *queryParser) parseCall() (queryExpr, error) {
+	name := p.next()
+	p.next() // Opening parenthesis
+
+	// has() takes a field path instead of values
+	if strings.EqualFold(name.value, "has") {
+		path, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRParen, "closing parenthesis"); err != nil {
+			return nil, err
+		}
+		return &hasExpr{path: path}, nil
+	}
+
+	args := []queryToken{}
+	if p.peek().typ != tokenRParen {
+		for {
+			arg, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if p.peek().typ != tokenComma {
+				break
+			}
+			p.next()
+		}
+	}
+	if _, err := p.expect(tokenRParen, "closing parenthesis"); err != nil {
+		return nil, err
+	}
+
+	e, err := newQueryFunction(strings.ToLower(name.value), args)
+	if err != nil {
+		return nil, fmt.Errorf("%s() at position %d: %w", name.value, name.pos, err)
+	}
+	return e, nil
+}
+
+// newQueryFunction builds the predicate of a function call
+func newQueryFunction(name string, args []queryToken) (queryExpr, error) {
+	checkArgs := func(min, max int) error {
+		if len(args) < min || (max >= 0 && len(args) > max) {
+			return fmt.Errorf("wrong number of arguments (%d)", len(args))
+		}
+		return nil
+	}
+
+	switch name {
+	case "is_root":
+		if err := checkArgs(0, 0); err != nil {
+			return nil, err
+		}
+		return &isRootExpr{}, nil
+	case "reachable_from", "ancestor_of":
+		if err := checkArgs(1, -1); err != nil {
+			return nil, err
+		}
+		e := &graphExpr{
+			target:    args[0].value,
+			root:      args[0].typ == tokenIdent && strings.EqualFold(args[0].value, "root"),
+			direction: TraverseDescendants,
+		}
+		if name == "ancestor_of" {
+			e.direction = TraverseAncestors
+		}
+		for _, a := range args[1:] {
+			t, ok := Edge_Type_value[a.value]
+			if !ok {
+				return nil, fmt.Errorf("unknown edge type %q", a.value)
+			}
+			e.edgeTypes = append(e.edgeTypes, Edge_Type(t))
+		}
+		return e, nil
+	case "purl_matches":
+		if err := checkArgs(1, 1); err != nil {
+			return nil, err
+		}
+		purl := PackageURL(args[0].value)
+		if !purl.Valid() {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidPurl, purl)
+		}
+		mode := PurlMatchQualifierSubset
+		if purl.Version() == "" {
+			mode |= PurlMatchIgnoreVersion
+		}
+		return &purlExpr{purl: purl, mode: mode}, nil
+	case "purl_in_range":
+		if err := checkArgs(2, 2); err != nil {
+			return nil, err
+		}
+		purl := PackageURL(args[0].value)
+		if !purl.Valid() {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidPurl, purl)
+		}
+		r, err := vers.Parse(args[1].value)
+		if err != nil {
+			return nil, err
+		}
+		return &purlExpr{purl: purl, mode: PurlMatchIgnoreVersion | PurlMatchQualifierSubset, versRange: r}, nil
+	case "cpe_matches":
+		if err := checkArgs(1, 1); err != nil {
+			return nil, err
+		}
+		cpe, err := ParseCPE(args[0].value)
+		if err != nil {
+			return nil, err
+		}
+		return &cpeExpr{cpe: cpe}, nil
+	default:
+		return nil, fmt.Errorf("unknown function")
+	}
+}
+
+// resolveQueryPath maps the segments of a field path to the function that
+// extracts its values from a node. Paths name the node proto fields and
+// can descend into messages (suppliers.name), select a map entry by its
+// type name (identifiers.cpe23, hashes.sha256) or use the purl pseudo field
+// and its components (purl.type, purl.name, ...).
+func resolveQueryPath(segments []string) (*queryPath, error) {
+	path := &queryPath{name: strings.Join(segments, ".")}
+
+	if segments[0] == "purl" {
+		return resolvePurlPath(path, segments[1:])
+	}
+
+	desc := (&Node{}).ProtoReflect().Descriptor()
+	for i := 0; i < len(segments); i++ {
+		fd := desc.Fields().ByName(protoreflect.Name(segments[i]))
+		if fd == nil {
+			fd = desc.Fields().ByJSONName(segments[i])
+		}
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field %q", strings.Join(segments[:i+1], "."))
+		}
+		step := queryPathStep{field: fd}
+		if fd.IsList() {
+			path.repeated = true
+		}
+
+		switch {
+		case fd.IsMap():
+			path.repeated = i+1 == len(segments)
+			if i+1 < len(segments) {
+				key, err := resolveMapKey(fd, segments[i+1])
+				if err != nil {
+					return nil, err
+				}
+				step.key = &key
+				i++
+			}
+			if i+1 < len(segments) {
+				return nil, fmt.Errorf("field %q has no subfields", path.name)
+			}
+			path.enum = fd.MapValue().Enum() != nil
+		case fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() != timestampName:
+			if i+1 == len(segments) {
+				return nil, fmt.Errorf("field %q is a message, a subfield must be selected", path.name)
+			}
+			desc = fd.Message()
+		default:
+			if i+1 < len(segments) {
+				return nil, fmt.Errorf("field %q has no subfields", strings.Join(segments[:i+1], "."))
+			}
+			path.enum = fd.Kind() == protoreflect.EnumKind
+		}
+		path.steps = append(path.steps, step)
+	}
+	return path, nil
+}
+
+// resolvePurlPath builds the path of the purl pseudo field
+func resolvePurlPath(path *queryPath, segments []string) (*queryPath, error) {
+	if len(segments) > 1 {
+		return nil, fmt.Errorf("unknown field %q", path.name)
+	}
+	component := ""
+	if len(segments) == 1 {
+		component = segments[0]
+	}
+
+	var getter func(PackageURL) string
+	switch component {
+	case "":
+		getter = func(p PackageURL) string {
+			if c, err := p.Canonical(); err == nil {
+				return string(c)
+			}
+			return string(p)
+		}
+	case "type":
+		getter = PackageURL.Type
+	case "namespace":
+		getter = PackageURL.Namespace
+	case "name":
+		getter = PackageURL.Name
+	case "version":
+		getter = PackageURL.Version
+	case "subpath":
+		getter = PackageURL.Subpath
+	default:
+		return nil, fmt.Errorf("unknown field %q", path.name)
+	}
+
+	path.values = func(n *Node) []string {
+		p := n.Purl()
+		if p == "" {
+			return []string{}
+		}
+		return []string{getter(p)}
+	}
+	return path, nil
+}
+
+// resolveMapKey converts the key name used in a path to the map key of the
+// node field. Identifier and hash maps are keyed by the enum number of
+// the type, so the key can be written using the enum name.
+func resolveMapKey(fd protoreflect.FieldDescriptor, key string) (protoreflect.MapKey, error) {
+	switch fd.Name() {
+	case "identifiers":
+		t := SoftwareIdentifierTypeFromString(key)
+		if t == SoftwareIdentifierType_UNKNOWN_IDENTIFIER_TYPE {
+			v, ok := SoftwareIdentifierType_value[strings.ToUpper(key)]
+			if !ok {
+				return protoreflect.MapKey{}, fmt.Errorf("unknown identifier type %q", key)
+			}
+			t = SoftwareIdentifierType(v)
+		}
+		return protoreflect.ValueOfInt32(int32(t)).MapKey(), nil
+	case "hashes":
+		v, ok := HashAlgorithm_value[strings.ToUpper(key)]
+		if !ok {
+			return protoreflect.MapKey{}, fmt.Errorf("unknown hash algorithm %q", key)
+		}
+		return protoreflect.ValueOfInt32(v).MapKey(), nil
+	}
+
+	switch fd.MapKey().Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(key).MapKey(), nil
+	case protoreflect.Int32Kind:
+		v, err := strconv.ParseInt(key, 10, 32)
+		if err != nil {
+			return protoreflect.MapKey{}, fmt.Errorf("invalid key %q for field %s", key, fd.Name())
+		}
+		return protoreflect.ValueOfInt32(int32(v)).MapKey(), nil
+	default:
+		return protoreflect.MapKey{}, fmt.Errorf("field %s cannot be keyed", fd.Name())
+	}
+}
@@ -0,0 +1,197 @@
+package sbom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestQuery(t *testing.T) {
+	nl := &NodeList{
+		Nodes: []*Node{
+			{
+				Id: "app", Name: "app", Version: "1.0.0",
+				PrimaryPurpose: []Purpose{Purpose_APPLICATION},
+				Suppliers:      []*Person{{Name: "Acme Corp", IsOrg: true}},
+				ReleaseDate:    timestamppb.New(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			{
+				Id: "log4j", Name: "log4j-core", Version: "2.14.1",
+				Licenses:  []string{"Apache-2.0"},
+				Suppliers: []*Person{{Name: "Apache Software Foundation", IsOrg: true}},
+				Identifiers: map[int32]string{
+					int32(SoftwareIdentifierType_PURL):  "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1?type=jar",
+					int32(SoftwareIdentifierType_CPE23): "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+				},
+				Hashes:      map[int32]string{int32(HashAlgorithm_SHA256): "abc123"},
+				ReleaseDate: timestamppb.New(time.Date(2021, 3, 12, 0, 0, 0, 0, time.UTC)),
+			},
+			{
+				Id: "readline", Name: "readline", Version: "8.2",
+				Licenses:  []string{"GPL-3.0-only"},
+				Suppliers: []*Person{{Name: "Acme Packaging"}},
+				Identifiers: map[int32]string{
+					int32(SoftwareIdentifierType_PURL): "pkg:deb/debian/readline@8.2-1",
+				},
+			},
+			{
+				Id: "readline-src", Type: Node_FILE, Name: "readline.c",
+				Licenses: []string{"GPL-3.0-only", "GPL-2.0-or-later"},
+			},
+			{
+				Id: "junit", Name: "junit", Version: "4.13",
+				Licenses: []string{"EPL-1.0"},
+				Identifiers: map[int32]string{
+					int32(SoftwareIdentifierType_PURL): "pkg:maven/junit/junit@4.13",
+				},
+			},
+			{Id: "orphan", Name: "orphan"},
+		},
+		Edges: []*Edge{
+			{Type: Edge_dependsOn, From: "app", To: []string{"log4j", "readline"}},
+			{Type: Edge_contains, From: "readline", To: []string{"readline-src"}},
+			{Type: Edge_testDependency, From: "app", To: []string{"junit"}},
+		},
+		RootElements: []string{"app"},
+	}
+	for name, tc := range map[string]struct {
+		query    string
+		expected []string
+	}{
+		"enum":                    {`type == FILE`, []string{"readline-src"}},
+		"enum case insensitive":   {`type == package`, []string{"app", "log4j", "readline", "junit", "orphan"}},
+		"string equality":         {`name == "log4j-core"`, []string{"log4j"}},
+		"not equal":               {`type != PACKAGE`, []string{"readline-src"}},
+		"list contains":           {`licenses contains "GPL-3.0-only"`, []string{"readline", "readline-src"}},
+		"list contains element":   {`licenses contains "GPL-3.0"`, []string{}},
+		"string contains":         {`name contains "line"`, []string{"readline", "readline-src"}},
+		"regex in message list":   {`suppliers.name ~ "^Acme"`, []string{"app", "readline"}},
+		"regex negated":           {`name !~ "^read"`, []string{"app", "log4j", "junit", "orphan"}},
+		"bool field":              {`suppliers.is_org == true`, []string{"app", "log4j"}},
+		"json name":               {`suppliers.isOrg == true`, []string{"app", "log4j"}},
+		"repeated enum":           {`primary_purpose contains APPLICATION`, []string{"app"}},
+		"combined":                {`type == PACKAGE && licenses contains "GPL-3.0-only" && suppliers.name ~ "Acme"`, []string{"readline"}},
+		"or":                      {`name == junit || name == orphan`, []string{"junit", "orphan"}},
+		"keywords":                {`name == junit or not (type == PACKAGE)`, []string{"readline-src", "junit"}},
+		"precedence":              {`name == app || name == log4j-core && version == 1`, []string{"app"}},
+		"not":                     {`!has(licenses)`, []string{"app", "orphan"}},
+		"has nested":              {`has(suppliers.name) && !has(identifiers)`, []string{"app"}},
+		"identifier":              {`identifiers.cpe23 ~ "apache:log4j"`, []string{"log4j"}},
+		"identifier by spdx name": {`identifiers.purl == "pkg:maven/junit/junit@4.13"`, []string{"junit"}},
+		"hash":                    {`hashes.sha256 == abc123`, []string{"log4j"}},
+		"purl":                    {`purl ~ "^pkg:maven/"`, []string{"log4j", "junit"}},
+		"purl type":               {`purl.type == deb`, []string{"readline"}},
+		"purl namespace":          {`purl.namespace == "org.apache.logging.log4j"`, []string{"log4j"}},
+		"version ordering":        {`version >= 2.0 && version < 4.13`, []string{"log4j"}},
+		"empty values unordered":  {`version < 0.1 || version <= "" || name > zzz`, []string{}},
+		"date ordering":           {`release_date < 2022-01-01`, []string{"log4j"}},
+		"unset date unordered":    {`release_date >= 2020-01-01`, []string{"app", "log4j"}},
+		"timestamp":               {`release_date == "2024-03-01T00:00:00Z"`, []string{"app"}},
+		"purl matches":            {`purl_matches("pkg:maven/org.apache.logging.log4j/log4j-core")`, []string{"log4j"}},
+		"purl matches version":    {`purl_matches("pkg:maven/junit/junit@4.12")`, []string{}},
+		"purl in range":           {`purl_in_range("pkg:maven/org.apache.logging.log4j/log4j-core", "vers:maven/>=2.0|<2.17.1")`, []string{"log4j"}},
+		"cpe":                     {`cpe_matches("cpe:2.3:a:apache:log4j:2.*:*:*:*:*:*:*:*")`, []string{"log4j"}},
+		"is root":                 {`is_root()`, []string{"app"}},
+		"reachable from root":     {`reachable_from(root)`, []string{"app", "log4j", "readline", "readline-src", "junit"}},
+		"reachable by edge type":  {`reachable_from(root, dependsOn, contains) && !is_root()`, []string{"log4j", "readline", "readline-src"}},
+		"reachable from node":     {`reachable_from("readline")`, []string{"readline", "readline-src"}},
+		"ancestor of":             {`ancestor_of("readline-src")`, []string{"app", "readline", "readline-src"}},
+		"single quotes":           {`name == 'readline.c'`, []string{"readline-src"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := nl.Query(tc.query)
+			require.NoError(t, err)
+			ids := []string{}
+			for _, n := range res.Nodes {
+				ids = append(ids, n.Id)
+			}
+			require.Equal(t, tc.expected, ids)
+		})
+	}
+}
+
+func TestQueryGraph(t *testing.T) {
+	nl := &NodeList{
+		Nodes: []*Node{
+			{Id: "app", Name: "app"}, {Id: "log4j", Name: "log4j-core"}, {Id: "readline", Name: "readline"},
+			{Id: "readline-src", Type: Node_FILE, Name: "readline.c"}, {Id: "junit", Name: "junit"},
+		},
+		Edges: []*Edge{
+			{
+				Type: Edge_dependsOn, From: "app", To: []string{"log4j", "readline"},
+				Properties: []*Property{{Name: "scope", Value: "runtime"}},
+			},
+			{Type: Edge_contains, From: "readline", To: []string{"readline-src"}},
+			{Type: Edge_testDependency, From: "app", To: []string{"junit"}},
+		},
+		RootElements: []string{"app"},
+	}
+	res, err := nl.Query(`reachable_from(root, dependsOn, contains)`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"app"}, res.RootElements)
+	expected := []*Edge{
+		{
+			Type: Edge_dependsOn, From: "app", To: []string{"log4j", "readline"},
+			Properties: []*Property{{Name: "scope", Value: "runtime"}},
+		},
+		{Type: Edge_contains, From: "readline", To: []string{"readline-src"}},
+	}
+	require.Len(t, res.Edges, len(expected))
+	for i := range expected {
+		require.True(t, expected[i].Equal(res.Edges[i]), "%v", res.Edges[i])
+	}
+
+	// Edges to nodes not in the result are trimmed, keeping their properties
+	res, err = nl.Query(`name ~ "^(app|readline)$"`)
+	require.NoError(t, err)
+	require.Len(t, res.Edges, 1)
+	require.True(t, res.Edges[0].Equal(&Edge{
+		Type: Edge_dependsOn, From: "app", To: []string{"readline"},
+		Properties: []*Property{{Name: "scope", Value: "runtime"}},
+	}), "%v", res.Edges[0])
+
+	// The original NodeList is not modified
+	require.Len(t, nl.Edges[0].To, 2)
+
+	// Queries can be compiled and reused
+	q, err := ParseQuery(`type == FILE`)
+	require.NoError(t, err)
+	require.Equal(t, `type == FILE`, q.String())
+	require.Len(t, q.Filter(nl).Nodes, 1)
+	require.Empty(t, q.Filter(nil).Nodes)
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	for name, query := range map[string]string{
+		"empty":                  ``,
+		"unknown field":          `colour == red`,
+		"unknown subfield":       `suppliers.shoe_size == 42`,
+		"message without field":  `suppliers == "Acme"`,
+		"scalar with subfield":   `name.first == "x"`,
+		"unknown identifier":     `identifiers.isbn == "x"`,
+		"unknown hash":           `hashes.crc32 == "x"`,
+		"unknown purl component": `purl.color == "x"`,
+		"missing operator":       `name "x"`,
+		"missing value":          `name ==`,
+		"bad regex":              `name ~ "("`,
+		"unbalanced":             `(name == x`,
+		"trailing tokens":        `name == x y`,
+		"dangling and":           `name == x &&`,
+		"unterminated string":    `name == "x`,
+		"bad character":          `name == x; drop`,
+		"unknown function":       `frobnicate()`,
+		"wrong arguments":        `is_root(1)`,
+		"unknown edge type":      `reachable_from(root, likes)`,
+		"invalid purl":           `purl_matches("npm/lodash")`,
+		"invalid range":          `purl_in_range("pkg:npm/lodash", ">=1.0")`,
+		"invalid cpe":            `cpe_matches("cpe:/x")`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseQuery(query)
+			require.Error(t, err)
+			require.ErrorIs(t, err, ErrInvalidQuery)
+		})
+	}
+}
@@ -308,6 +308,8 @@ type Node_NodeType int32
 const (
 	Node_PACKAGE Node_NodeType = 0 // Software component type is a package.
 	Node_FILE    Node_NodeType = 1 // Software component type is a file.
+	Node_SNIPPET Node_NodeType = 2 // Software component type is a snippet, a part of a file. The file is related to it with a contains edge.
+	Node_SERVICE Node_NodeType = 3 // Software component type is a service, such as a microservice or a SaaS API.
 )
 
 // Enum value maps for Node_NodeType.
@@ -315,10 +317,14 @@ var (
 	Node_NodeType_name = map[int32]string{
 		0: "PACKAGE",
 		1: "FILE",
+		2: "SNIPPET",
+		3: "SERVICE",
 	}
 	Node_NodeType_value = map[string]int32{
 		"PACKAGE": 0,
 		"FILE":    1,
+		"SNIPPET": 2,
+		"SERVICE": 3,
 	}
 )
 
@@ -522,7 +528,7 @@ func (x Edge_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Edge_Type.Descriptor instead.
 func (Edge_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_sbom_proto_rawDescGZIP(), []int{3, 0}
+	return file_api_sbom_proto_rawDescGZIP(), []int{4, 0}
 }
 
 // Type enumerator representing of the external reference.
@@ -746,7 +752,7 @@ func (x ExternalReference_ExternalReferenceType) Number() protoreflect.EnumNumbe
 
 // Deprecated: Use ExternalReference_ExternalReferenceType.Descriptor instead.
 func (ExternalReference_ExternalReferenceType) EnumDescriptor() ([]byte, []int) {
-	return file_api_sbom_proto_rawDescGZIP(), []int{4, 0}
+	return file_api_sbom_proto_rawDescGZIP(), []int{5, 0}
 }
 
 // Enumeration of SBOM document types.
@@ -814,7 +820,539 @@ func (x DocumentType_SBOMType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DocumentType_SBOMType.Descriptor instead.
 func (DocumentType_SBOMType) EnumDescriptor() ([]byte, []int) {
-	return file_api_sbom_proto_rawDescGZIP(), []int{7, 0}
+	return file_api_sbom_proto_rawDescGZIP(), []int{8, 0}
+}
+
+// Direction of the data flow.
+type DataFlow_Direction int32
+
+const (
+	DataFlow_UNKNOWN       DataFlow_Direction = 0 // The direction of the flow is unknown.
+	DataFlow_INBOUND       DataFlow_Direction = 1 // Data sent to the service.
+	DataFlow_OUTBOUND      DataFlow_Direction = 2 // Data sent by the service.
+	DataFlow_BIDIRECTIONAL DataFlow_Direction = 3 // Data flows both ways.
+)
+
+// Enum value maps for DataFlow_Direction.
+var (
+	DataFlow_Direction_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "INBOUND",
+		2: "OUTBOUND",
+		3: "BIDIRECTIONAL",
+	}
+	DataFlow_Direction_value = map[string]int32{
+		"UNKNOWN":       0,
+		"INBOUND":       1,
+		"OUTBOUND":      2,
+		"BIDIRECTIONAL": 3,
+	}
+)
+
+func (x DataFlow_Direction) Enum() *DataFlow_Direction {
+	p := new(DataFlow_Direction)
+	*p = x
+	return p
+}
+
+func (x DataFlow_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataFlow_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_sbom_proto_enumTypes[7].Descriptor()
+}
+
+func (DataFlow_Direction) Type() protoreflect.EnumType {
+	return &file_api_sbom_proto_enumTypes[7]
+}
+
+func (x DataFlow_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataFlow_Direction.Descriptor instead.
+func (DataFlow_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{13, 0}
+}
+
+// Type of the patch.
+type Patch_Type int32
+
+const (
+	Patch_UNKNOWN     Patch_Type = 0 // Unknown patch type.
+	Patch_UNOFFICIAL  Patch_Type = 1 // Patch not provided by the upstream project.
+	Patch_MONKEY      Patch_Type = 2 // Patch applied at runtime.
+	Patch_BACKPORT    Patch_Type = 3 // Change from a later release applied to an earlier one.
+	Patch_CHERRY_PICK Patch_Type = 4 // Individual change applied from another branch.
+)
+
+// Enum value maps for Patch_Type.
+var (
+	Patch_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "UNOFFICIAL",
+		2: "MONKEY",
+		3: "BACKPORT",
+		4: "CHERRY_PICK",
+	}
+	Patch_Type_value = map[string]int32{
+		"UNKNOWN":     0,
+		"UNOFFICIAL":  1,
+		"MONKEY":      2,
+		"BACKPORT":    3,
+		"CHERRY_PICK": 4,
+	}
+)
+
+func (x Patch_Type) Enum() *Patch_Type {
+	p := new(Patch_Type)
+	*p = x
+	return p
+}
+
+func (x Patch_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Patch_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_sbom_proto_enumTypes[8].Descriptor()
+}
+
+func (Patch_Type) Type() protoreflect.EnumType {
+	return &file_api_sbom_proto_enumTypes[8]
+}
+
+func (x Patch_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Patch_Type.Descriptor instead.
+func (Patch_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{15, 0}
+}
+
+// Type of the issue.
+type PatchIssue_Type int32
+
+const (
+	PatchIssue_UNKNOWN     PatchIssue_Type = 0 // Unknown issue type.
+	PatchIssue_DEFECT      PatchIssue_Type = 1 // A bug.
+	PatchIssue_ENHANCEMENT PatchIssue_Type = 2 // A new feature or improvement.
+	PatchIssue_SECURITY    PatchIssue_Type = 3 // A security issue.
+)
+
+// Enum value maps for PatchIssue_Type.
+var (
+	PatchIssue_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "DEFECT",
+		2: "ENHANCEMENT",
+		3: "SECURITY",
+	}
+	PatchIssue_Type_value = map[string]int32{
+		"UNKNOWN":     0,
+		"DEFECT":      1,
+		"ENHANCEMENT": 2,
+		"SECURITY":    3,
+	}
+)
+
+func (x PatchIssue_Type) Enum() *PatchIssue_Type {
+	p := new(PatchIssue_Type)
+	*p = x
+	return p
+}
+
+func (x PatchIssue_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PatchIssue_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_sbom_proto_enumTypes[9].Descriptor()
+}
+
+func (PatchIssue_Type) Type() protoreflect.EnumType {
+	return &file_api_sbom_proto_enumTypes[9]
+}
+
+func (x PatchIssue_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PatchIssue_Type.Descriptor instead.
+func (PatchIssue_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{16, 0}
+}
+
+// Type of annotation.
+type Annotation_Type int32
+
+const (
+	Annotation_UNKNOWN Annotation_Type = 0 // Unknown type, CycloneDX annotations have no type.
+	Annotation_REVIEW  Annotation_Type = 1 // The annotation is the result of a review.
+	Annotation_OTHER   Annotation_Type = 2 // Any other annotation.
+)
+
+// Enum value maps for Annotation_Type.
+var (
+	Annotation_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "REVIEW",
+		2: "OTHER",
+	}
+	Annotation_Type_value = map[string]int32{
+		"UNKNOWN": 0,
+		"REVIEW":  1,
+		"OTHER":   2,
+	}
+)
+
+func (x Annotation_Type) Enum() *Annotation_Type {
+	p := new(Annotation_Type)
+	*p = x
+	return p
+}
+
+func (x Annotation_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Annotation_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_sbom_proto_enumTypes[10].Descriptor()
+}
+
+func (Annotation_Type) Type() protoreflect.EnumType {
+	return &file_api_sbom_proto_enumTypes[10]
+}
+
+func (x Annotation_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Annotation_Type.Descriptor instead.
+func (Annotation_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{22, 0}
+}
+
+// Severity of a vulnerability rating.
+type VulnerabilityRating_Severity int32
+
+const (
+	VulnerabilityRating_UNKNOWN_SEVERITY VulnerabilityRating_Severity = 0 // Unknown severity.
+	VulnerabilityRating_NONE             VulnerabilityRating_Severity = 1 // No severity.
+	VulnerabilityRating_INFO             VulnerabilityRating_Severity = 2 // Informational severity. (CDX Specific)
+	VulnerabilityRating_LOW              VulnerabilityRating_Severity = 3 // Low severity.
+	VulnerabilityRating_MEDIUM           VulnerabilityRating_Severity = 4 // Medium severity.
+	VulnerabilityRating_HIGH             VulnerabilityRating_Severity = 5 // High severity.
+	VulnerabilityRating_CRITICAL         VulnerabilityRating_Severity = 6 // Critical severity.
+)
+
+// Enum value maps for VulnerabilityRating_Severity.
+var (
+	VulnerabilityRating_Severity_name = map[int32]string{
+		0: "UNKNOWN_SEVERITY",
+		1: "NONE",
+		2: "INFO",
+		3: "LOW",
+		4: "MEDIUM",
+		5: "HIGH",
+		6: "CRITICAL",
+	}
+	VulnerabilityRating_Severity_value = map[string]int32{
+		"UNKNOWN_SEVERITY": 0,
+		"NONE":             1,
+		"INFO":             2,
+		"LOW":              3,
+		"MEDIUM":           4,
+		"HIGH":             5,
+		"CRITICAL":         6,
+	}
+)
+
+func (x VulnerabilityRating_Severity) Enum() *VulnerabilityRating_Severity {
+	p := new(VulnerabilityRating_Severity)
+	*p = x
+	return p
+}
+
+func (x VulnerabilityRating_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VulnerabilityRating_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_sbom_proto_enumTypes[11].Descriptor()
+}
+
+func (VulnerabilityRating_Severity) Type() protoreflect.EnumType {
+	return &file_api_sbom_proto_enumTypes[11]
+}
+
+func (x VulnerabilityRating_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VulnerabilityRating_Severity.Descriptor instead.
+func (VulnerabilityRating_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{25, 0}
+}
+
+// Scoring method of a vulnerability rating.
+type VulnerabilityRating_Method int32
+
+const (
+	VulnerabilityRating_OTHER_METHOD VulnerabilityRating_Method = 0 // Other or unknown scoring method.
+	VulnerabilityRating_CVSSV2       VulnerabilityRating_Method = 1 // CVSS version 2.
+	VulnerabilityRating_CVSSV3       VulnerabilityRating_Method = 2 // CVSS version 3.0.
+	VulnerabilityRating_CVSSV31      VulnerabilityRating_Method = 3 // CVSS version 3.1.
+	VulnerabilityRating_CVSSV4       VulnerabilityRating_Method = 4 // CVSS version 4.
+	VulnerabilityRating_OWASP        VulnerabilityRating_Method = 5 // OWASP risk rating. (CDX Specific)
+	VulnerabilityRating_SSVC         VulnerabilityRating_Method = 6 // Stakeholder-Specific Vulnerability Categorization.
+	VulnerabilityRating_EPSS         VulnerabilityRating_Method = 7 // Exploit Prediction Scoring System. (SPDX3 Specific)
+)
+
+// Enum value maps for VulnerabilityRating_Method.
+var (
+	VulnerabilityRating_Method_name = map[int32]string{
+		0: "OTHER_METHOD",
+		1: "CVSSV2",
+		2: "CVSSV3",
+		3: "CVSSV31",
+		4: "CVSSV4",
+		5: "OWASP",
+		6: "SSVC",
+		7: "EPSS",
+	}
+	VulnerabilityRating_Method_value = map[string]int32{
+		"OTHER_METHOD": 0,
+		"CVSSV2":       1,
+		"CVSSV3":       2,
+		"CVSSV31":      3,
+		"CVSSV4":       4,
+		"OWASP":        5,
+		"SSVC":         6,
+		"EPSS":         7,
+	}
+)
+
+func (x VulnerabilityRating_Method) Enum() *VulnerabilityRating_Method {
+	p := new(VulnerabilityRating_Method)
+	*p = x
+	return p
+}
+
+func (x VulnerabilityRating_Method) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VulnerabilityRating_Method) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_sbom_proto_enumTypes[12].Descriptor()
+}
+
+func (VulnerabilityRating_Method) Type() protoreflect.EnumType {
+	return &file_api_sbom_proto_enumTypes[12]
+}
+
+func (x VulnerabilityRating_Method) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VulnerabilityRating_Method.Descriptor instead.
+func (VulnerabilityRating_Method) EnumDescriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{25, 1}
+}
+
+// VEX status of the nodes affected by a vulnerability.
+type VulnerabilityAnalysis_Status int32
+
+const (
+	VulnerabilityAnalysis_UNKNOWN_STATUS      VulnerabilityAnalysis_Status = 0 // Unknown or not analyzed.
+	VulnerabilityAnalysis_AFFECTED            VulnerabilityAnalysis_Status = 1 // The nodes are affected by the vulnerability. (CDX: exploitable)
+	VulnerabilityAnalysis_NOT_AFFECTED        VulnerabilityAnalysis_Status = 2 // The nodes are not affected by the vulnerability. (CDX: not_affected, false_positive)
+	VulnerabilityAnalysis_FIXED               VulnerabilityAnalysis_Status = 3 // The nodes contain a fix for the vulnerability. (CDX: resolved)
+	VulnerabilityAnalysis_UNDER_INVESTIGATION VulnerabilityAnalysis_Status = 4 // It is not yet known if the nodes are affected. (CDX: in_triage)
+)
+
+// Enum value maps for VulnerabilityAnalysis_Status.
+var (
+	VulnerabilityAnalysis_Status_name = map[int32]string{
+		0: "UNKNOWN_STATUS",
+		1: "AFFECTED",
+		2: "NOT_AFFECTED",
+		3: "FIXED",
+		4: "UNDER_INVESTIGATION",
+	}
+	VulnerabilityAnalysis_Status_value = map[string]int32{
+		"UNKNOWN_STATUS":      0,
+		"AFFECTED":            1,
+		"NOT_AFFECTED":        2,
+		"FIXED":               3,
+		"UNDER_INVESTIGATION": 4,
+	}
+)
+
+func (x VulnerabilityAnalysis_Status) Enum() *VulnerabilityAnalysis_Status {
+	p := new(VulnerabilityAnalysis_Status)
+	*p = x
+	return p
+}
+
+func (x VulnerabilityAnalysis_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VulnerabilityAnalysis_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_sbom_proto_enumTypes[13].Descriptor()
+}
+
+func (VulnerabilityAnalysis_Status) Type() protoreflect.EnumType {
+	return &file_api_sbom_proto_enumTypes[13]
+}
+
+func (x VulnerabilityAnalysis_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VulnerabilityAnalysis_Status.Descriptor instead.
+func (VulnerabilityAnalysis_Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{26, 0}
+}
+
+// Justification of a not affected status. The first values are defined by VEX
+// (OpenVEX, CSAF and SPDX3), the rest are CycloneDX specific.
+type VulnerabilityAnalysis_Justification int32
+
+const (
+	VulnerabilityAnalysis_UNKNOWN_JUSTIFICATION                             VulnerabilityAnalysis_Justification = 0  // No justification.
+	VulnerabilityAnalysis_COMPONENT_NOT_PRESENT                             VulnerabilityAnalysis_Justification = 1  // The vulnerable component is not present.
+	VulnerabilityAnalysis_VULNERABLE_CODE_NOT_PRESENT                       VulnerabilityAnalysis_Justification = 2  // The vulnerable code is not present. (CDX: code_not_present)
+	VulnerabilityAnalysis_VULNERABLE_CODE_NOT_IN_EXECUTE_PATH               VulnerabilityAnalysis_Justification = 3  // The vulnerable code cannot be executed. (CDX: code_not_reachable)
+	VulnerabilityAnalysis_VULNERABLE_CODE_CANNOT_BE_CONTROLLED_BY_ADVERSARY VulnerabilityAnalysis_Justification = 4  // The vulnerable code cannot be controlled by an attacker.
+	VulnerabilityAnalysis_INLINE_MITIGATIONS_ALREADY_EXIST                  VulnerabilityAnalysis_Justification = 5  // Mitigations prevent exploiting the vulnerability. (CDX: protected_by_mitigating_control)
+	VulnerabilityAnalysis_REQUIRES_CONFIGURATION                            VulnerabilityAnalysis_Justification = 6  // Exploiting requires a configuration not in use. (CDX Specific)
+	VulnerabilityAnalysis_REQUIRES_DEPENDENCY                               VulnerabilityAnalysis_Justification = 7  // Exploiting requires a dependency not present. (CDX Specific)
+	VulnerabilityAnalysis_REQUIRES_ENVIRONMENT                              VulnerabilityAnalysis_Justification = 8  // Exploiting requires an environment not in use. (CDX Specific)
+	VulnerabilityAnalysis_PROTECTED_BY_COMPILER                             VulnerabilityAnalysis_Justification = 9  // Compiler protections prevent exploiting the vulnerability. (CDX Specific)
+	VulnerabilityAnalysis_PROTECTED_AT_RUNTIME                              VulnerabilityAnalysis_Justification = 10 // Runtime protections prevent exploiting the vulnerability. (CDX Specific)
+	VulnerabilityAnalysis_PROTECTED_AT_PERIMETER                            VulnerabilityAnalysis_Justification = 11 // Network protections prevent exploiting the vulnerability. (CDX Specific)
+)
+
+// Enum value maps for VulnerabilityAnalysis_Justification.
+var (
+	VulnerabilityAnalysis_Justification_name = map[int32]string{
+		0:  "UNKNOWN_JUSTIFICATION",
+		1:  "COMPONENT_NOT_PRESENT",
+		2:  "VULNERABLE_CODE_NOT_PRESENT",
+		3:  "VULNERABLE_CODE_NOT_IN_EXECUTE_PATH",
+		4:  "VULNERABLE_CODE_CANNOT_BE_CONTROLLED_BY_ADVERSARY",
+		5:  "INLINE_MITIGATIONS_ALREADY_EXIST",
+		6:  "REQUIRES_CONFIGURATION",
+		7:  "REQUIRES_DEPENDENCY",
+		8:  "REQUIRES_ENVIRONMENT",
+		9:  "PROTECTED_BY_COMPILER",
+		10: "PROTECTED_AT_RUNTIME",
+		11: "PROTECTED_AT_PERIMETER",
+	}
+	VulnerabilityAnalysis_Justification_value = map[string]int32{
+		"UNKNOWN_JUSTIFICATION":                             0,
+		"COMPONENT_NOT_PRESENT":                             1,
+		"VULNERABLE_CODE_NOT_PRESENT":                       2,
+		"VULNERABLE_CODE_NOT_IN_EXECUTE_PATH":               3,
+		"VULNERABLE_CODE_CANNOT_BE_CONTROLLED_BY_ADVERSARY": 4,
+		"INLINE_MITIGATIONS_ALREADY_EXIST":                  5,
+		"REQUIRES_CONFIGURATION":                            6,
+		"REQUIRES_DEPENDENCY":                               7,
+		"REQUIRES_ENVIRONMENT":                              8,
+		"PROTECTED_BY_COMPILER":                             9,
+		"PROTECTED_AT_RUNTIME":                              10,
+		"PROTECTED_AT_PERIMETER":                            11,
+	}
+)
+
+func (x VulnerabilityAnalysis_Justification) Enum() *VulnerabilityAnalysis_Justification {
+	p := new(VulnerabilityAnalysis_Justification)
+	*p = x
+	return p
+}
+
+func (x VulnerabilityAnalysis_Justification) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VulnerabilityAnalysis_Justification) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_sbom_proto_enumTypes[14].Descriptor()
+}
+
+func (VulnerabilityAnalysis_Justification) Type() protoreflect.EnumType {
+	return &file_api_sbom_proto_enumTypes[14]
+}
+
+func (x VulnerabilityAnalysis_Justification) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VulnerabilityAnalysis_Justification.Descriptor instead.
+func (VulnerabilityAnalysis_Justification) EnumDescriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{26, 1}
+}
+
+// Response of the software vendor to a vulnerability.
+type VulnerabilityAnalysis_Response int32
+
+const (
+	VulnerabilityAnalysis_UNKNOWN_RESPONSE     VulnerabilityAnalysis_Response = 0 // No response.
+	VulnerabilityAnalysis_CAN_NOT_FIX          VulnerabilityAnalysis_Response = 1 // The vulnerability can not be fixed.
+	VulnerabilityAnalysis_WILL_NOT_FIX         VulnerabilityAnalysis_Response = 2 // The vulnerability will not be fixed.
+	VulnerabilityAnalysis_UPDATE               VulnerabilityAnalysis_Response = 3 // Update to a version with a fix.
+	VulnerabilityAnalysis_ROLLBACK             VulnerabilityAnalysis_Response = 4 // Roll back to a version without the vulnerability.
+	VulnerabilityAnalysis_WORKAROUND_AVAILABLE VulnerabilityAnalysis_Response = 5 // A workaround is available.
+)
+
+// Enum value maps for VulnerabilityAnalysis_Response.
+var (
+	VulnerabilityAnalysis_Response_name = map[int32]string{
+		0: "UNKNOWN_RESPONSE",
+		1: "CAN_NOT_FIX",
+		2: "WILL_NOT_FIX",
+		3: "UPDATE",
+		4: "ROLLBACK",
+		5: "WORKAROUND_AVAILABLE",
+	}
+	VulnerabilityAnalysis_Response_value = map[string]int32{
+		"UNKNOWN_RESPONSE":     0,
+		"CAN_NOT_FIX":          1,
+		"WILL_NOT_FIX":         2,
+		"UPDATE":               3,
+		"ROLLBACK":             4,
+		"WORKAROUND_AVAILABLE": 5,
+	}
+)
+
+func (x VulnerabilityAnalysis_Response) Enum() *VulnerabilityAnalysis_Response {
+	p := new(VulnerabilityAnalysis_Response)
+	*p = x
+	return p
+}
+
+func (x VulnerabilityAnalysis_Response) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VulnerabilityAnalysis_Response) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_sbom_proto_enumTypes[15].Descriptor()
+}
+
+func (VulnerabilityAnalysis_Response) Type() protoreflect.EnumType {
+	return &file_api_sbom_proto_enumTypes[15]
+}
+
+func (x VulnerabilityAnalysis_Response) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VulnerabilityAnalysis_Response.Descriptor instead.
+func (VulnerabilityAnalysis_Response) EnumDescriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{26, 2}
 }
 
 // Document is the top-level structure representing the entire Software Bill of Materials (SBOM).
@@ -825,8 +1363,9 @@ type Document struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metadata *Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`                 // Metadata associated with the SBOM document
-	NodeList *NodeList `protobuf:"bytes,2,opt,name=node_list,json=nodeList,proto3" json:"node_list,omitempty"` // List of nodes and edges forming the SBOM graph
+	Metadata        *Metadata        `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`                 // Metadata associated with the SBOM document
+	NodeList        *NodeList        `protobuf:"bytes,2,opt,name=node_list,json=nodeList,proto3" json:"node_list,omitempty"` // List of nodes and edges forming the SBOM graph
+	Vulnerabilities []*Vulnerability `protobuf:"bytes,3,rep,name=vulnerabilities,proto3" json:"vulnerabilities,omitempty"`   // Vulnerabilities affecting the nodes of the SBOM
 }
 
 func (x *Document) Reset() {
@@ -875,6 +1414,13 @@ func (x *Document) GetNodeList() *NodeList {
 	return nil
 }
 
+func (x *Document) GetVulnerabilities() []*Vulnerability {
+	if x != nil {
+		return x.Vulnerabilities
+	}
+	return nil
+}
+
 // Node represents a central element within the Software Bill of Materials (SBOM) graph,
 // serving as a vertex that captures vital information about a software component.
 // Each Node in the SBOM graph signifies a distinct software component, forming the vertices of the graph.
@@ -919,6 +1465,25 @@ type Node struct {
 	// Maps between hash algorithms types and hash values.
 	Hashes         map[int32]string `protobuf:"bytes,29,rep,name=hashes,proto3" json:"hashes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	PrimaryPurpose []Purpose        `protobuf:"varint,30,rep,packed,name=primary_purpose,json=primaryPurpose,proto3,enum=protobom.protobom.Purpose" json:"primary_purpose,omitempty"` // Primary purpose or role assigned to the software component.
+	Properties     []*Property      `protobuf:"bytes,31,rep,name=properties,proto3" json:"properties,omitempty"`                                                                      // Name/value properties with data not covered by the other fields. (CDX properties)
+	Annotations    []*Annotation    `protobuf:"bytes,32,rep,name=annotations,proto3" json:"annotations,omitempty"`                                                                    // Comments about the software component.
+	Provenance     []*Provenance    `protobuf:"bytes,33,rep,name=provenance,proto3" json:"provenance,omitempty"`                                                                      // Source documents that contributed data to the node when provenance is tracked.
+	// Location of a snippet in the file that contains it. Only used in SNIPPET nodes.
+	ByteRange *SnippetRange `protobuf:"bytes,34,opt,name=byte_range,json=byteRange,proto3" json:"byte_range,omitempty"` // Range of bytes of the file covered by the snippet.
+	LineRange *SnippetRange `protobuf:"bytes,35,opt,name=line_range,json=lineRange,proto3" json:"line_range,omitempty"` // Range of lines of the file covered by the snippet.
+	// Attributes of SERVICE nodes. (CDX services)
+	Endpoints            []string    `protobuf:"bytes,36,rep,name=endpoints,proto3" json:"endpoints,omitempty"`                                                            // URIs of the endpoints exposed by the service.
+	Authenticated        *bool       `protobuf:"varint,37,opt,name=authenticated,proto3,oneof" json:"authenticated,omitempty"`                                             // Whether the service requires authentication. Unset if unknown.
+	CrossesTrustBoundary *bool       `protobuf:"varint,38,opt,name=crosses_trust_boundary,json=crossesTrustBoundary,proto3,oneof" json:"crosses_trust_boundary,omitempty"` // Whether calling the service crosses a trust boundary. Unset if unknown.
+	DataFlows            []*DataFlow `protobuf:"bytes,39,rep,name=data_flows,json=dataFlows,proto3" json:"data_flows,omitempty"`                                           // Classification and direction of the data exchanged with the service.
+	// History and evidence of the component. (CDX pedigree and evidence)
+	// Ancestors, descendants and variants are nodes related to the component with
+	// descendant, ancestor and variant edges from it. Patches are FILE nodes with the
+	// PATCH purpose, related to the patched nodes with patch edges from the patch.
+	Commits       []*Commit `protobuf:"bytes,40,rep,name=commits,proto3" json:"commits,omitempty"`                                  // Commits in the history of the component.
+	PedigreeNotes string    `protobuf:"bytes,41,opt,name=pedigree_notes,json=pedigreeNotes,proto3" json:"pedigree_notes,omitempty"` // Notes on the pedigree of the component.
+	Evidence      *Evidence `protobuf:"bytes,42,opt,name=evidence,proto3" json:"evidence,omitempty"`                                // Evidence of the identity, location and licensing of the component.
+	Patch         *Patch    `protobuf:"bytes,43,opt,name=patch,proto3" json:"patch,omitempty"`                                      // Details of the change when the node is a patch.
 }
 
 func (x *Node) Reset() {
@@ -1135,30 +1700,124 @@ func (x *Node) GetPrimaryPurpose() []Purpose {
 	return nil
 }
 
-// Metadata encapsulates document-related details about the Software Bill of Materials (SBOM) document.
-// It includes information such as the document's identifier, version, authorship, creation date,
-// associated tools, and document types.
-type Metadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Node) GetProperties() []*Property {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
 
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // // Unique identifier for the document. Serial number in CycloneDX foramts, SPDXID in spdx formats.
-	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`             // Version of the document. In Cyclone formats the version is translated from `Int` field in to a more general `String` field.
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                   // Name associated with the document.
-	Date          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`                   // Created date of the Document. In SPDX formats mapped to the created date field.
-	Tools         []*Tool                `protobuf:"bytes,5,rep,name=tools,proto3" json:"tools,omitempty"`                 // Tools used in the creation or processing of the document.
-	Authors       []*Person              `protobuf:"bytes,6,rep,name=authors,proto3" json:"authors,omitempty"`             // Individuals or organizations involved in the creation or maintenance of the document.
-	Comment       string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`             // Comments on the document.
-	DocumentTypes []*DocumentType        `protobuf:"bytes,8,rep,name=documentTypes,proto3" json:"documentTypes,omitempty"` // Types categorizing the document based on its purpose or stage in the software development lifecycle.
+func (x *Node) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
 }
 
-func (x *Metadata) Reset() {
-	*x = Metadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sbom_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Node) GetProvenance() []*Provenance {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+func (x *Node) GetByteRange() *SnippetRange {
+	if x != nil {
+		return x.ByteRange
+	}
+	return nil
+}
+
+func (x *Node) GetLineRange() *SnippetRange {
+	if x != nil {
+		return x.LineRange
+	}
+	return nil
+}
+
+func (x *Node) GetEndpoints() []string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *Node) GetAuthenticated() bool {
+	if x != nil && x.Authenticated != nil {
+		return *x.Authenticated
+	}
+	return false
+}
+
+func (x *Node) GetCrossesTrustBoundary() bool {
+	if x != nil && x.CrossesTrustBoundary != nil {
+		return *x.CrossesTrustBoundary
+	}
+	return false
+}
+
+func (x *Node) GetDataFlows() []*DataFlow {
+	if x != nil {
+		return x.DataFlows
+	}
+	return nil
+}
+
+func (x *Node) GetCommits() []*Commit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *Node) GetPedigreeNotes() string {
+	if x != nil {
+		return x.PedigreeNotes
+	}
+	return ""
+}
+
+func (x *Node) GetEvidence() *Evidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *Node) GetPatch() *Patch {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+// Metadata encapsulates document-related details about the Software Bill of Materials (SBOM) document.
+// It includes information such as the document's identifier, version, authorship, creation date,
+// associated tools, and document types.
+type Metadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                         string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                      // // Unique identifier for the document. Serial number in CycloneDX foramts, SPDXID in spdx formats.
+	Version                    string                       `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                                                                            // Version of the document. In Cyclone formats the version is translated from `Int` field in to a more general `String` field.
+	Name                       string                       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                                                  // Name associated with the document.
+	Date                       *timestamppb.Timestamp       `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`                                                                                  // Created date of the Document. In SPDX formats mapped to the created date field.
+	Tools                      []*Tool                      `protobuf:"bytes,5,rep,name=tools,proto3" json:"tools,omitempty"`                                                                                // Tools used in the creation or processing of the document.
+	Authors                    []*Person                    `protobuf:"bytes,6,rep,name=authors,proto3" json:"authors,omitempty"`                                                                            // Individuals or organizations involved in the creation or maintenance of the document.
+	Comment                    string                       `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`                                                                            // Comments on the document.
+	DocumentTypes              []*DocumentType              `protobuf:"bytes,8,rep,name=documentTypes,proto3" json:"documentTypes,omitempty"`                                                                // Types categorizing the document based on its purpose or stage in the software development lifecycle.
+	Properties                 []*Property                  `protobuf:"bytes,9,rep,name=properties,proto3" json:"properties,omitempty"`                                                                      // Name/value properties of the document. (CDX properties)
+	Annotations                []*Annotation                `protobuf:"bytes,10,rep,name=annotations,proto3" json:"annotations,omitempty"`                                                                   // Comments about the document.
+	ExternalDocumentReferences []*ExternalDocumentReference `protobuf:"bytes,11,rep,name=external_document_references,json=externalDocumentReferences,proto3" json:"external_document_references,omitempty"` // Other documents with nodes referenced by the edges of this document.
+}
+
+func (x *Metadata) Reset() {
+	*x = Metadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
@@ -1241,6 +1900,93 @@ func (x *Metadata) GetDocumentTypes() []*DocumentType {
 	return nil
 }
 
+func (x *Metadata) GetProperties() []*Property {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *Metadata) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Metadata) GetExternalDocumentReferences() []*ExternalDocumentReference {
+	if x != nil {
+		return x.ExternalDocumentReferences
+	}
+	return nil
+}
+
+// ExternalDocumentReference declares another document whose nodes are targeted
+// by edges of this document. Edges point to nodes of the external document
+// with IDs in the form DocumentRef-ID:NodeID. (SPDX 2.3 externalDocumentRefs)
+type ExternalDocumentReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                                  // Identifier of the reference in this document, starting with "DocumentRef-".
+	Uri    string           `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`                                                                                                // URI identifying the external document. In SPDX, its document namespace.
+	Hashes map[int32]string `protobuf:"bytes,3,rep,name=hashes,proto3" json:"hashes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Hashes of the external document, keyed by HashAlgorithm.
+}
+
+func (x *ExternalDocumentReference) Reset() {
+	*x = ExternalDocumentReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalDocumentReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalDocumentReference) ProtoMessage() {}
+
+func (x *ExternalDocumentReference) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalDocumentReference.ProtoReflect.Descriptor instead.
+func (*ExternalDocumentReference) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExternalDocumentReference) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExternalDocumentReference) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ExternalDocumentReference) GetHashes() map[int32]string {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
 // Edge represents relationships between nodes in the Software Bill of Materials (SBOM) graph.
 // Each Edge captures the type of relationship and the nodes involved, providing a structured
 // way to model dependencies and connections within the SBOM.
@@ -1249,15 +1995,16 @@ type Edge struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type Edge_Type `protobuf:"varint,1,opt,name=type,proto3,enum=protobom.protobom.Edge_Type" json:"type,omitempty"` // Type enumerator representing the node relationship.
-	From string    `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                                   // Source node of the edge.
-	To   []string  `protobuf:"bytes,3,rep,name=to,proto3" json:"to,omitempty"`                                       // Target nodes of the edge.
+	Type       Edge_Type   `protobuf:"varint,1,opt,name=type,proto3,enum=protobom.protobom.Edge_Type" json:"type,omitempty"` // Type enumerator representing the node relationship.
+	From       string      `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                                   // Source node of the edge.
+	To         []string    `protobuf:"bytes,3,rep,name=to,proto3" json:"to,omitempty"`                                       // Target nodes of the edge.
+	Properties []*Property `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty"`                       // Name/value properties of the relationship.
 }
 
 func (x *Edge) Reset() {
 	*x = Edge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sbom_proto_msgTypes[3]
+		mi := &file_api_sbom_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1270,7 +2017,7 @@ func (x *Edge) String() string {
 func (*Edge) ProtoMessage() {}
 
 func (x *Edge) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sbom_proto_msgTypes[3]
+	mi := &file_api_sbom_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1283,7 +2030,7 @@ func (x *Edge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Edge.ProtoReflect.Descriptor instead.
 func (*Edge) Descriptor() ([]byte, []int) {
-	return file_api_sbom_proto_rawDescGZIP(), []int{3}
+	return file_api_sbom_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Edge) GetType() Edge_Type {
@@ -1307,6 +2054,13 @@ func (x *Edge) GetTo() []string {
 	return nil
 }
 
+func (x *Edge) GetProperties() []*Property {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
 // ExternalReference is an entry linking an element to a resource defined outside the SBOM standard.
 type ExternalReference struct {
 	state         protoimpl.MessageState
@@ -1325,7 +2079,7 @@ type ExternalReference struct {
 func (x *ExternalReference) Reset() {
 	*x = ExternalReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sbom_proto_msgTypes[4]
+		mi := &file_api_sbom_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1338,7 +2092,7 @@ func (x *ExternalReference) String() string {
 func (*ExternalReference) ProtoMessage() {}
 
 func (x *ExternalReference) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sbom_proto_msgTypes[4]
+	mi := &file_api_sbom_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,7 +2105,7 @@ func (x *ExternalReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExternalReference.ProtoReflect.Descriptor instead.
 func (*ExternalReference) Descriptor() ([]byte, []int) {
-	return file_api_sbom_proto_rawDescGZIP(), []int{4}
+	return file_api_sbom_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ExternalReference) GetUrl() string {
@@ -1407,7 +2161,7 @@ type Person struct {
 func (x *Person) Reset() {
 	*x = Person{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sbom_proto_msgTypes[5]
+		mi := &file_api_sbom_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1420,7 +2174,7 @@ func (x *Person) String() string {
 func (*Person) ProtoMessage() {}
 
 func (x *Person) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sbom_proto_msgTypes[5]
+	mi := &file_api_sbom_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1433,7 +2187,7 @@ func (x *Person) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Person.ProtoReflect.Descriptor instead.
 func (*Person) Descriptor() ([]byte, []int) {
-	return file_api_sbom_proto_rawDescGZIP(), []int{5}
+	return file_api_sbom_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Person) GetName() string {
@@ -1492,7 +2246,7 @@ type Tool struct {
 func (x *Tool) Reset() {
 	*x = Tool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sbom_proto_msgTypes[6]
+		mi := &file_api_sbom_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1505,7 +2259,7 @@ func (x *Tool) String() string {
 func (*Tool) ProtoMessage() {}
 
 func (x *Tool) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sbom_proto_msgTypes[6]
+	mi := &file_api_sbom_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1518,7 +2272,7 @@ func (x *Tool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tool.ProtoReflect.Descriptor instead.
 func (*Tool) Descriptor() ([]byte, []int) {
-	return file_api_sbom_proto_rawDescGZIP(), []int{6}
+	return file_api_sbom_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Tool) GetName() string {
@@ -1557,7 +2311,7 @@ type DocumentType struct {
 func (x *DocumentType) Reset() {
 	*x = DocumentType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sbom_proto_msgTypes[7]
+		mi := &file_api_sbom_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1570,7 +2324,7 @@ func (x *DocumentType) String() string {
 func (*DocumentType) ProtoMessage() {}
 
 func (x *DocumentType) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sbom_proto_msgTypes[7]
+	mi := &file_api_sbom_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,7 +2337,7 @@ func (x *DocumentType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DocumentType.ProtoReflect.Descriptor instead.
 func (*DocumentType) Descriptor() ([]byte, []int) {
-	return file_api_sbom_proto_rawDescGZIP(), []int{7}
+	return file_api_sbom_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DocumentType) GetType() DocumentType_SBOMType {
@@ -1622,7 +2376,7 @@ type NodeList struct {
 func (x *NodeList) Reset() {
 	*x = NodeList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sbom_proto_msgTypes[8]
+		mi := &file_api_sbom_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1635,7 +2389,7 @@ func (x *NodeList) String() string {
 func (*NodeList) ProtoMessage() {}
 
 func (x *NodeList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sbom_proto_msgTypes[8]
+	mi := &file_api_sbom_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1648,7 +2402,7 @@ func (x *NodeList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeList.ProtoReflect.Descriptor instead.
 func (*NodeList) Descriptor() ([]byte, []int) {
-	return file_api_sbom_proto_rawDescGZIP(), []int{8}
+	return file_api_sbom_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *NodeList) GetNodes() []*Node {
@@ -1672,470 +2426,2312 @@ func (x *NodeList) GetRootElements() []string {
 	return nil
 }
 
-var File_api_sbom_proto protoreflect.FileDescriptor
+// Property is a name/value pair that captures data not covered by the protobom
+// model such as CycloneDX properties or tool specific data like syft's
+// syft:package:foundBy. Properties can be nested to form trees.
+type Property struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_api_sbom_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x6f, 0x6d, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7d, 0x0a, 0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x09, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x22, 0x98, 0x0a, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x75, 0x72, 0x6c, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x75, 0x72, 0x6c, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x72, 0x6c, 0x5f,
-	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x75, 0x72, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
-	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c,
-	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x69, 0x63, 0x65, 0x6e,
-	0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69,
-	0x65, 0x72, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12,
-	0x3b, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x14,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52,
-	0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0c,
-	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
-	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f,
-	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x13,
-	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x45, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x12, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x3b,
-	0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x70,
-	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x1e,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65,
-	0x52, 0x0e, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65,
-	0x1a, 0x3e, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x39, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x21, 0x0a, 0x08, 0x4e,
-	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x43, 0x4b, 0x41,
-	0x47, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x22, 0xbd,
-	0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x74, 0x6f, 0x6f,
-	0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x54, 0x6f, 0x6f,
-	0x6c, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x6f, 0x6d, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xe1,
-	0x06, 0x0a, 0x04, 0x45, 0x64, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
-	0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x82, 0x06,
-	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x10, 0x01, 0x12,
-	0x0c, 0x0a, 0x08, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x10, 0x02, 0x12, 0x13, 0x0a,
-	0x0f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
-	0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x10,
-	0x04, 0x12, 0x0c, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x10, 0x05, 0x12,
-	0x10, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x10,
-	0x06, 0x12, 0x08, 0x0a, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x64, 0x65, 0x70,
-	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x10,
-	0x09, 0x12, 0x0d, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x10, 0x0a,
-	0x12, 0x10, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x4f, 0x66,
-	0x10, 0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74,
-	0x10, 0x0c, 0x12, 0x0d, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x10,
-	0x0d, 0x12, 0x0f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x42, 0x79,
-	0x10, 0x0e, 0x12, 0x11, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x79, 0x10, 0x0f, 0x12, 0x0b, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x54, 0x6f, 0x6f, 0x6c,
-	0x10, 0x10, 0x12, 0x18, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x10, 0x11, 0x12, 0x11, 0x0a, 0x0d,
-	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x12, 0x12,
-	0x0f, 0x0a, 0x0b, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x10, 0x13,
-	0x12, 0x0b, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x10, 0x14, 0x12, 0x17, 0x0a,
-	0x13, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x10, 0x15, 0x12, 0x0d, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x64,
-	0x64, 0x65, 0x64, 0x10, 0x16, 0x12, 0x0f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x10, 0x17, 0x12, 0x10, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x18, 0x12, 0x0d, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x73, 0x10, 0x19, 0x12, 0x11, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x10, 0x1a, 0x12, 0x0c, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x66, 0x69, 0x6c, 0x65, 0x10, 0x1b, 0x12, 0x15, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x10, 0x1c, 0x12,
-	0x16, 0x0a, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x65, 0x6e,
-	0x64, 0x65, 0x6e, 0x63, 0x79, 0x10, 0x1d, 0x12, 0x09, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72,
-	0x10, 0x1e, 0x12, 0x0c, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x10, 0x1f,
-	0x12, 0x09, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x10, 0x20, 0x12, 0x10, 0x0a, 0x0c, 0x70,
-	0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x10, 0x21, 0x12, 0x13, 0x0a,
-	0x0f, 0x70, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x46, 0x6f, 0x72,
-	0x10, 0x22, 0x12, 0x16, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x44, 0x65,
-	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x10, 0x23, 0x12, 0x12, 0x0a, 0x0e, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x10, 0x24, 0x12, 0x15,
-	0x0a, 0x11, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x79, 0x10, 0x25, 0x12, 0x14, 0x0a, 0x10, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x10, 0x26, 0x12, 0x0e, 0x0a, 0x0a, 0x73,
-	0x74, 0x61, 0x74, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x10, 0x27, 0x12, 0x08, 0x0a, 0x04, 0x74,
-	0x65, 0x73, 0x74, 0x10, 0x28, 0x12, 0x0c, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x10, 0x29, 0x12, 0x12, 0x0a, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e,
-	0x64, 0x65, 0x6e, 0x63, 0x79, 0x10, 0x2a, 0x12, 0x0c, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x54,
-	0x6f, 0x6f, 0x6c, 0x10, 0x2b, 0x12, 0x0b, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
-	0x10, 0x2c, 0x22, 0x8b, 0x0c, 0x0a, 0x11, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x48, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x39, 0x0a, 0x0b,
-	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6, 0x09, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f,
-	0x0a, 0x0b, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12,
-	0x0a, 0x0a, 0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x42,
-	0x4f, 0x4d, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x04, 0x12,
-	0x0e, 0x0a, 0x0a, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x10, 0x05, 0x12,
-	0x10, 0x0a, 0x0c, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10,
-	0x06, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x43,
-	0x48, 0x41, 0x54, 0x10, 0x08, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x5f, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x55, 0x52, 0x45,
-	0x10, 0x09, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f,
-	0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10,
-	0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x54, 0x41, 0x4b, 0x45, 0x10, 0x0c, 0x12, 0x11, 0x0a,
-	0x0d, 0x44, 0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0d,
-	0x12, 0x0c, 0x0a, 0x08, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x0e, 0x12, 0x1b,
-	0x0a, 0x17, 0x44, 0x59, 0x4e, 0x41, 0x4d, 0x49, 0x43, 0x5f, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53,
-	0x49, 0x53, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x0f, 0x12, 0x0e, 0x0a, 0x0a, 0x45,
-	0x4f, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x43, 0x45, 0x10, 0x10, 0x12, 0x0c, 0x0a, 0x08, 0x45,
-	0x56, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x11, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50,
-	0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x41, 0x53, 0x53, 0x45,
-	0x53, 0x53, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x12, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d,
-	0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x13, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x55, 0x4e,
-	0x44, 0x49, 0x4e, 0x47, 0x10, 0x14, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f,
-	0x54, 0x52, 0x41, 0x43, 0x4b, 0x45, 0x52, 0x10, 0x15, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x49, 0x43,
-	0x45, 0x4e, 0x53, 0x45, 0x10, 0x16, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x47, 0x10, 0x17, 0x12,
-	0x10, 0x0a, 0x0c, 0x4d, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10,
-	0x18, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x41, 0x54, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45,
-	0x50, 0x4f, 0x52, 0x54, 0x10, 0x19, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x56, 0x45, 0x4e, 0x5f,
-	0x43, 0x45, 0x4e, 0x54, 0x52, 0x41, 0x4c, 0x10, 0x1a, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x45, 0x54,
-	0x52, 0x49, 0x43, 0x53, 0x10, 0x1b, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f,
-	0x43, 0x41, 0x52, 0x44, 0x10, 0x1c, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x50, 0x4d, 0x10, 0x1d, 0x12,
-	0x09, 0x0a, 0x05, 0x4e, 0x55, 0x47, 0x45, 0x54, 0x10, 0x1e, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54,
-	0x48, 0x45, 0x52, 0x10, 0x1f, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x41, 0x4d, 0x10, 0x20, 0x12,
-	0x16, 0x0a, 0x12, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x53,
-	0x53, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x21, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x44, 0x55,
-	0x43, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x10, 0x22, 0x12, 0x12, 0x0a,
-	0x0e, 0x50, 0x55, 0x52, 0x43, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10,
-	0x23, 0x12, 0x1d, 0x0a, 0x19, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x53, 0x53,
-	0x45, 0x53, 0x53, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x24,
-	0x12, 0x13, 0x0a, 0x0f, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x45, 0x54, 0x52,
-	0x49, 0x43, 0x53, 0x10, 0x25, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45,
-	0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x26, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45,
-	0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x53, 0x10, 0x27, 0x12, 0x13, 0x0a,
-	0x0f, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x53, 0x53, 0x4d, 0x45, 0x4e, 0x54,
-	0x10, 0x28, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x41, 0x4e,
-	0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x29, 0x12,
-	0x1f, 0x0a, 0x1b, 0x53, 0x45, 0x43, 0x55, 0x52, 0x45, 0x5f, 0x53, 0x4f, 0x46, 0x54, 0x57, 0x41,
-	0x52, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x2a,
-	0x12, 0x1c, 0x0a, 0x18, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x44, 0x56,
-	0x45, 0x52, 0x53, 0x41, 0x52, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x2b, 0x12, 0x15,
-	0x0a, 0x11, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x44, 0x56, 0x49, 0x53,
-	0x4f, 0x52, 0x59, 0x10, 0x2c, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54,
-	0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x10, 0x2d, 0x12, 0x10, 0x0a, 0x0c, 0x53,
-	0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x46, 0x49, 0x58, 0x10, 0x2e, 0x12, 0x12, 0x0a,
-	0x0e, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10,
-	0x2f, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x45,
-	0x4e, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x30, 0x12, 0x13,
-	0x0a, 0x0f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
-	0x59, 0x10, 0x31, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f,
-	0x53, 0x57, 0x49, 0x44, 0x10, 0x32, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49,
-	0x54, 0x59, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10,
-	0x33, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x4f, 0x43, 0x49, 0x41, 0x4c, 0x10, 0x34, 0x12, 0x13, 0x0a,
-	0x0f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54,
-	0x10, 0x35, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x5f, 0x41, 0x4e, 0x41,
-	0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x36, 0x12, 0x0b,
-	0x0a, 0x07, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x37, 0x12, 0x07, 0x0a, 0x03, 0x56,
-	0x43, 0x53, 0x10, 0x38, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x55, 0x4c, 0x4e, 0x45, 0x52, 0x41, 0x42,
-	0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x52, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x39, 0x12, 0x23, 0x0a, 0x1f, 0x56, 0x55, 0x4c, 0x4e, 0x45, 0x52, 0x41, 0x42, 0x49, 0x4c, 0x49,
-	0x54, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4c, 0x4f, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45,
-	0x50, 0x4f, 0x52, 0x54, 0x10, 0x3a, 0x12, 0x2b, 0x0a, 0x27, 0x56, 0x55, 0x4c, 0x4e, 0x45, 0x52,
-	0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x4f, 0x49, 0x54, 0x41,
-	0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x53, 0x53, 0x4d, 0x45, 0x4e,
-	0x54, 0x10, 0x3b, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x45, 0x42, 0x53, 0x49, 0x54, 0x45, 0x10, 0x3c,
-	0x22, 0xa8, 0x01, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x6f, 0x72, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x69, 0x73, 0x4f, 0x72, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f,
-	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x4c, 0x0a, 0x04, 0x54,
-	0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x22, 0xb7, 0x02, 0x0a, 0x0c, 0x44, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x41, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x44, 0x6f, 0x63,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x42, 0x4f, 0x4d, 0x54, 0x79,
-	0x70, 0x65, 0x48, 0x00, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x22, 0x81, 0x01,
-	0x0a, 0x08, 0x53, 0x42, 0x4f, 0x4d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54,
-	0x48, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x10,
-	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x02, 0x12, 0x09, 0x0a,
-	0x05, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4e, 0x41, 0x4c,
-	0x59, 0x5a, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
-	0x45, 0x44, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x10,
-	0x06, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x10, 0x07,
-	0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x43, 0x4f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10,
-	0x08, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x2d, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12,
-	0x2d, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x6f, 0x6d, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x23,
-	0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x6f, 0x6f, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x2a, 0xf0, 0x01, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x44, 0x35, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53,
-	0x48, 0x41, 0x31, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34, 0x10, 0x04, 0x12, 0x0a, 0x0a,
-	0x06, 0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x41,
-	0x33, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x41, 0x33, 0x5f,
-	0x33, 0x38, 0x34, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x41, 0x33, 0x5f, 0x35, 0x31,
-	0x32, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x4c, 0x41, 0x4b, 0x45, 0x32, 0x42, 0x5f, 0x32,
-	0x35, 0x36, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x4c, 0x41, 0x4b, 0x45, 0x32, 0x42, 0x5f,
-	0x33, 0x38, 0x34, 0x10, 0x0a, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x4c, 0x41, 0x4b, 0x45, 0x32, 0x42,
-	0x5f, 0x35, 0x31, 0x32, 0x10, 0x0b, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x4c, 0x41, 0x4b, 0x45, 0x33,
-	0x10, 0x0c, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x44, 0x32, 0x10, 0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x41,
-	0x44, 0x4c, 0x45, 0x52, 0x33, 0x32, 0x10, 0x0e, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x44, 0x34, 0x10,
-	0x0f, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x44, 0x36, 0x10, 0x10, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48,
-	0x41, 0x32, 0x32, 0x34, 0x10, 0x11, 0x2a, 0x61, 0x0a, 0x16, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61,
-	0x72, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1b, 0x0a, 0x17, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x49, 0x44, 0x45, 0x4e,
-	0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x50, 0x55, 0x52, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x50, 0x45, 0x32, 0x32,
-	0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x50, 0x45, 0x32, 0x33, 0x10, 0x03, 0x12, 0x0a, 0x0a,
-	0x06, 0x47, 0x49, 0x54, 0x4f, 0x49, 0x44, 0x10, 0x04, 0x2a, 0xb7, 0x03, 0x0a, 0x07, 0x50, 0x75,
-	0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x5f, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x50,
-	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x41,
-	0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4f, 0x4d, 0x10,
-	0x03, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45,
-	0x52, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x41, 0x10, 0x06, 0x12, 0x0a, 0x0a,
-	0x06, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x56,
-	0x49, 0x43, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d,
-	0x44, 0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x09, 0x12,
-	0x0c, 0x0a, 0x08, 0x45, 0x56, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x0a, 0x12, 0x0e, 0x0a,
-	0x0a, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x0b, 0x12, 0x08, 0x0a,
-	0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x0c, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x52, 0x4d, 0x57,
-	0x41, 0x52, 0x45, 0x10, 0x0d, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x57, 0x4f,
-	0x52, 0x4b, 0x10, 0x0e, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10,
-	0x0f, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x10, 0x10, 0x12, 0x1a,
-	0x0a, 0x16, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x52, 0x4e, 0x49,
-	0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x11, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41,
-	0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x10, 0x12, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x4f, 0x44, 0x45,
-	0x4c, 0x10, 0x13, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x14, 0x12,
-	0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x59, 0x53,
-	0x54, 0x45, 0x4d, 0x10, 0x15, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x16,
-	0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x54, 0x43, 0x48, 0x10, 0x17, 0x12, 0x0c, 0x0a, 0x08, 0x50,
-	0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x18, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x51,
-	0x55, 0x49, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x19, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x4f,
-	0x55, 0x52, 0x43, 0x45, 0x10, 0x1a, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1b, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x53,
-	0x54, 0x10, 0x1c, 0x42, 0x07, 0x5a, 0x05, 0x73, 0x62, 0x6f, 0x6d, 0x2f, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	Name       string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`             // Name of the property, namespaced with colons by convention.
+	Value      string      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`           // Value of the property.
+	Properties []*Property `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty"` // Nested properties. Flattened when rendering to formats without property trees.
 }
 
-var (
-	file_api_sbom_proto_rawDescOnce sync.Once
-	file_api_sbom_proto_rawDescData = file_api_sbom_proto_rawDesc
-)
+func (x *Property) Reset() {
+	*x = Property{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_sbom_proto_rawDescGZIP() []byte {
-	file_api_sbom_proto_rawDescOnce.Do(func() {
-		file_api_sbom_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_sbom_proto_rawDescData)
-	})
-	return file_api_sbom_proto_rawDescData
+func (x *Property) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_sbom_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_api_sbom_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
-var file_api_sbom_proto_goTypes = []interface{}{
-	(HashAlgorithm)(0),          // 0: protobom.protobom.HashAlgorithm
-	(SoftwareIdentifierType)(0), // 1: protobom.protobom.SoftwareIdentifierType
-	(Purpose)(0),                // 2: protobom.protobom.Purpose
-	(Node_NodeType)(0),          // 3: protobom.protobom.Node.NodeType
-	(Edge_Type)(0),              // 4: protobom.protobom.Edge.Type
-	(ExternalReference_ExternalReferenceType)(0), // 5: protobom.protobom.ExternalReference.ExternalReferenceType
-	(DocumentType_SBOMType)(0),                   // 6: protobom.protobom.DocumentType.SBOMType
-	(*Document)(nil),                             // 7: protobom.protobom.Document
-	(*Node)(nil),                                 // 8: protobom.protobom.Node
-	(*Metadata)(nil),                             // 9: protobom.protobom.Metadata
-	(*Edge)(nil),                                 // 10: protobom.protobom.Edge
-	(*ExternalReference)(nil),                    // 11: protobom.protobom.ExternalReference
-	(*Person)(nil),                               // 12: protobom.protobom.Person
-	(*Tool)(nil),                                 // 13: protobom.protobom.Tool
-	(*DocumentType)(nil),                         // 14: protobom.protobom.DocumentType
-	(*NodeList)(nil),                             // 15: protobom.protobom.NodeList
-	nil,                                          // 16: protobom.protobom.Node.IdentifiersEntry
-	nil,                                          // 17: protobom.protobom.Node.HashesEntry
-	nil,                                          // 18: protobom.protobom.ExternalReference.HashesEntry
-	(*timestamppb.Timestamp)(nil),                // 19: google.protobuf.Timestamp
+func (*Property) ProtoMessage() {}
+
+func (x *Property) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_sbom_proto_depIdxs = []int32{
-	9,  // 0: protobom.protobom.Document.metadata:type_name -> protobom.protobom.Metadata
-	15, // 1: protobom.protobom.Document.node_list:type_name -> protobom.protobom.NodeList
-	3,  // 2: protobom.protobom.Node.type:type_name -> protobom.protobom.Node.NodeType
-	12, // 3: protobom.protobom.Node.suppliers:type_name -> protobom.protobom.Person
-	12, // 4: protobom.protobom.Node.originators:type_name -> protobom.protobom.Person
-	19, // 5: protobom.protobom.Node.release_date:type_name -> google.protobuf.Timestamp
-	19, // 6: protobom.protobom.Node.build_date:type_name -> google.protobuf.Timestamp
-	19, // 7: protobom.protobom.Node.valid_until_date:type_name -> google.protobuf.Timestamp
-	11, // 8: protobom.protobom.Node.external_references:type_name -> protobom.protobom.ExternalReference
-	16, // 9: protobom.protobom.Node.identifiers:type_name -> protobom.protobom.Node.IdentifiersEntry
-	17, // 10: protobom.protobom.Node.hashes:type_name -> protobom.protobom.Node.HashesEntry
-	2,  // 11: protobom.protobom.Node.primary_purpose:type_name -> protobom.protobom.Purpose
-	19, // 12: protobom.protobom.Metadata.date:type_name -> google.protobuf.Timestamp
-	13, // 13: protobom.protobom.Metadata.tools:type_name -> protobom.protobom.Tool
-	12, // 14: protobom.protobom.Metadata.authors:type_name -> protobom.protobom.Person
-	14, // 15: protobom.protobom.Metadata.documentTypes:type_name -> protobom.protobom.DocumentType
-	4,  // 16: protobom.protobom.Edge.type:type_name -> protobom.protobom.Edge.Type
-	18, // 17: protobom.protobom.ExternalReference.hashes:type_name -> protobom.protobom.ExternalReference.HashesEntry
-	5,  // 18: protobom.protobom.ExternalReference.type:type_name -> protobom.protobom.ExternalReference.ExternalReferenceType
-	12, // 19: protobom.protobom.Person.contacts:type_name -> protobom.protobom.Person
-	6,  // 20: protobom.protobom.DocumentType.type:type_name -> protobom.protobom.DocumentType.SBOMType
-	8,  // 21: protobom.protobom.NodeList.nodes:type_name -> protobom.protobom.Node
-	10, // 22: protobom.protobom.NodeList.edges:type_name -> protobom.protobom.Edge
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+
+// Deprecated: Use Property.ProtoReflect.Descriptor instead.
+func (*Property) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{10}
 }
 
-func init() { file_api_sbom_proto_init() }
-func file_api_sbom_proto_init() {
-	if File_api_sbom_proto != nil {
-		return
+func (x *Property) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_sbom_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Document); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_sbom_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Node); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_sbom_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Metadata); i {
+	return ""
+}
+
+func (x *Property) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Property) GetProperties() []*Property {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// Provenance records a source document that contributed data to a node. It is
+// kept by the merge operations when provenance tracking is enabled.
+type Provenance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentId string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"` // ID of the source document.
+	Tool       *Tool                  `protobuf:"bytes,2,opt,name=tool,proto3" json:"tool,omitempty"`                               // Tool that generated the source document.
+	Date       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                               // Creation date of the source document.
+	Fields     []string               `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`                           // Node fields contributed by the source. Empty when the source contributed the whole node.
+}
+
+func (x *Provenance) Reset() {
+	*x = Provenance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Provenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provenance) ProtoMessage() {}
+
+func (x *Provenance) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provenance.ProtoReflect.Descriptor instead.
+func (*Provenance) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Provenance) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *Provenance) GetTool() *Tool {
+	if x != nil {
+		return x.Tool
+	}
+	return nil
+}
+
+func (x *Provenance) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Provenance) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// SnippetRange is a range of bytes or lines in a file. Both ends are one-based
+// and inclusive as in SPDX.
+type SnippetRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // First byte or line of the range.
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`     // Last byte or line of the range.
+}
+
+func (x *SnippetRange) Reset() {
+	*x = SnippetRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnippetRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnippetRange) ProtoMessage() {}
+
+func (x *SnippetRange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnippetRange.ProtoReflect.Descriptor instead.
+func (*SnippetRange) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SnippetRange) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SnippetRange) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// DataFlow describes data of a classification exchanged with a service.
+type DataFlow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flow           DataFlow_Direction `protobuf:"varint,1,opt,name=flow,proto3,enum=protobom.protobom.DataFlow_Direction" json:"flow,omitempty"` // Direction the data flows, relative to the service.
+	Classification string             `protobuf:"bytes,2,opt,name=classification,proto3" json:"classification,omitempty"`                        // Data classification, for example PII or public.
+}
+
+func (x *DataFlow) Reset() {
+	*x = DataFlow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataFlow) ProtoMessage() {}
+
+func (x *DataFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataFlow.ProtoReflect.Descriptor instead.
+func (*DataFlow) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DataFlow) GetFlow() DataFlow_Direction {
+	if x != nil {
+		return x.Flow
+	}
+	return DataFlow_UNKNOWN
+}
+
+func (x *DataFlow) GetClassification() string {
+	if x != nil {
+		return x.Classification
+	}
+	return ""
+}
+
+// Commit is a revision in the version control history of a component. (CDX pedigree commits)
+type Commit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid       string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`             // Identifier of the revision in the version control system.
+	Url       string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`             // URL pointing to the commit.
+	Message   string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`     // Commit message.
+	Author    *Person                `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`       // Author of the change.
+	Authored  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=authored,proto3" json:"authored,omitempty"`   // Date the change was authored.
+	Committer *Person                `protobuf:"bytes,6,opt,name=committer,proto3" json:"committer,omitempty"` // Person who committed the change.
+	Committed *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=committed,proto3" json:"committed,omitempty"` // Date the change was committed.
+}
+
+func (x *Commit) Reset() {
+	*x = Commit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Commit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Commit) ProtoMessage() {}
+
+func (x *Commit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Commit.ProtoReflect.Descriptor instead.
+func (*Commit) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Commit) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Commit) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Commit) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Commit) GetAuthor() *Person {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Commit) GetAuthored() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Authored
+	}
+	return nil
+}
+
+func (x *Commit) GetCommitter() *Person {
+	if x != nil {
+		return x.Committer
+	}
+	return nil
+}
+
+func (x *Commit) GetCommitted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Committed
+	}
+	return nil
+}
+
+// Patch describes a change applied to a component. (CDX pedigree patches)
+type Patch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     Patch_Type    `protobuf:"varint,1,opt,name=type,proto3,enum=protobom.protobom.Patch_Type" json:"type,omitempty"` // Type of the patch.
+	Diff     string        `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`                                    // Contents of the diff when available inline. Its URL is the node download URL.
+	Resolves []*PatchIssue `protobuf:"bytes,3,rep,name=resolves,proto3" json:"resolves,omitempty"`                            // Issues resolved by the patch.
+}
+
+func (x *Patch) Reset() {
+	*x = Patch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Patch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Patch) ProtoMessage() {}
+
+func (x *Patch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Patch.ProtoReflect.Descriptor instead.
+func (*Patch) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Patch) GetType() Patch_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Patch_UNKNOWN
+}
+
+func (x *Patch) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *Patch) GetResolves() []*PatchIssue {
+	if x != nil {
+		return x.Resolves
+	}
+	return nil
+}
+
+// PatchIssue is a defect, enhancement or security issue resolved by a patch.
+type PatchIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        PatchIssue_Type `protobuf:"varint,1,opt,name=type,proto3,enum=protobom.protobom.PatchIssue_Type" json:"type,omitempty"` // Type of the issue.
+	Id          string          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                             // Identifier of the issue.
+	Name        string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                         // Name of the issue.
+	Description string          `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                           // Description of the issue.
+	SourceName  string          `protobuf:"bytes,5,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`           // Name of the source of the issue, for example NVD.
+	SourceUrl   string          `protobuf:"bytes,6,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`              // URL of the source of the issue.
+	References  []string        `protobuf:"bytes,7,rep,name=references,proto3" json:"references,omitempty"`                             // URLs with more information about the issue.
+}
+
+func (x *PatchIssue) Reset() {
+	*x = PatchIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchIssue) ProtoMessage() {}
+
+func (x *PatchIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchIssue.ProtoReflect.Descriptor instead.
+func (*PatchIssue) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PatchIssue) GetType() PatchIssue_Type {
+	if x != nil {
+		return x.Type
+	}
+	return PatchIssue_UNKNOWN
+}
+
+func (x *PatchIssue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchIssue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PatchIssue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PatchIssue) GetSourceName() string {
+	if x != nil {
+		return x.SourceName
+	}
+	return ""
+}
+
+func (x *PatchIssue) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *PatchIssue) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+// Evidence captures how the component was identified and where it was found. (CDX evidence)
+type Evidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identity    []*IdentityEvidence `protobuf:"bytes,1,rep,name=identity,proto3" json:"identity,omitempty"`       // Evidence of the identity of the component.
+	Occurrences []*Occurrence       `protobuf:"bytes,2,rep,name=occurrences,proto3" json:"occurrences,omitempty"` // Locations where the component was found.
+	Callstack   []*CallstackFrame   `protobuf:"bytes,3,rep,name=callstack,proto3" json:"callstack,omitempty"`     // Frames of the call stack where the component was observed.
+	Licenses    []string            `protobuf:"bytes,4,rep,name=licenses,proto3" json:"licenses,omitempty"`       // Licenses found as evidence.
+	Copyright   []string            `protobuf:"bytes,5,rep,name=copyright,proto3" json:"copyright,omitempty"`     // Copyright statements found as evidence.
+}
+
+func (x *Evidence) Reset() {
+	*x = Evidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Evidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Evidence) ProtoMessage() {}
+
+func (x *Evidence) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Evidence.ProtoReflect.Descriptor instead.
+func (*Evidence) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Evidence) GetIdentity() []*IdentityEvidence {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *Evidence) GetOccurrences() []*Occurrence {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+func (x *Evidence) GetCallstack() []*CallstackFrame {
+	if x != nil {
+		return x.Callstack
+	}
+	return nil
+}
+
+func (x *Evidence) GetLicenses() []string {
+	if x != nil {
+		return x.Licenses
+	}
+	return nil
+}
+
+func (x *Evidence) GetCopyright() []string {
+	if x != nil {
+		return x.Copyright
+	}
+	return nil
+}
+
+// IdentityEvidence records the methods used to determine a field of the component identity.
+type IdentityEvidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field      string            `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`                   // Identity field the evidence supports, for example purl, cpe or name.
+	Confidence *float32          `protobuf:"fixed32,2,opt,name=confidence,proto3,oneof" json:"confidence,omitempty"` // Overall confidence of the evidence, from 0 to 1.
+	Methods    []*IdentityMethod `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`               // Methods used to identify the component.
+	Tools      []string          `protobuf:"bytes,4,rep,name=tools,proto3" json:"tools,omitempty"`                   // References to the tools used to gather the evidence.
+}
+
+func (x *IdentityEvidence) Reset() {
+	*x = IdentityEvidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentityEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityEvidence) ProtoMessage() {}
+
+func (x *IdentityEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityEvidence.ProtoReflect.Descriptor instead.
+func (*IdentityEvidence) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *IdentityEvidence) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *IdentityEvidence) GetConfidence() float32 {
+	if x != nil && x.Confidence != nil {
+		return *x.Confidence
+	}
+	return 0
+}
+
+func (x *IdentityEvidence) GetMethods() []*IdentityMethod {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *IdentityEvidence) GetTools() []string {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+// IdentityMethod is a technique used to identify a component.
+type IdentityMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Technique  string   `protobuf:"bytes,1,opt,name=technique,proto3" json:"technique,omitempty"`           // Technique used, for example manifest-analysis or hash-comparison.
+	Confidence *float32 `protobuf:"fixed32,2,opt,name=confidence,proto3,oneof" json:"confidence,omitempty"` // Confidence of the technique, from 0 to 1.
+	Value      string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`                   // Value or contents of the evidence.
+}
+
+func (x *IdentityMethod) Reset() {
+	*x = IdentityMethod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentityMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityMethod) ProtoMessage() {}
+
+func (x *IdentityMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityMethod.ProtoReflect.Descriptor instead.
+func (*IdentityMethod) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *IdentityMethod) GetTechnique() string {
+	if x != nil {
+		return x.Technique
+	}
+	return ""
+}
+
+func (x *IdentityMethod) GetConfidence() float32 {
+	if x != nil && x.Confidence != nil {
+		return *x.Confidence
+	}
+	return 0
+}
+
+func (x *IdentityMethod) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Occurrence is a location where a component was found.
+type Occurrence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                        // Identifier of the occurrence.
+	Location          string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`                                            // Location where the component was found, for example a file path.
+	Line              *int32 `protobuf:"varint,3,opt,name=line,proto3,oneof" json:"line,omitempty"`                                             // Line where the component was found.
+	Offset            *int32 `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`                                         // Offset where the component was found.
+	Symbol            string `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`                                                // Symbol name that was found.
+	AdditionalContext string `protobuf:"bytes,6,opt,name=additional_context,json=additionalContext,proto3" json:"additional_context,omitempty"` // Additional context on the occurrence.
+}
+
+func (x *Occurrence) Reset() {
+	*x = Occurrence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Occurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Occurrence) ProtoMessage() {}
+
+func (x *Occurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Occurrence.ProtoReflect.Descriptor instead.
+func (*Occurrence) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Occurrence) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Occurrence) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Occurrence) GetLine() int32 {
+	if x != nil && x.Line != nil {
+		return *x.Line
+	}
+	return 0
+}
+
+func (x *Occurrence) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *Occurrence) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Occurrence) GetAdditionalContext() string {
+	if x != nil {
+		return x.AdditionalContext
+	}
+	return ""
+}
+
+// CallstackFrame is a frame of a call stack where a component was observed.
+type CallstackFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Package      string   `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`                               // Package containing the module.
+	Module       string   `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`                                 // Module containing the function.
+	Function     string   `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`                             // Function being called.
+	Parameters   []string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`                         // Parameters passed to the function.
+	Line         *int32   `protobuf:"varint,5,opt,name=line,proto3,oneof" json:"line,omitempty"`                              // Line of the call.
+	Column       *int32   `protobuf:"varint,6,opt,name=column,proto3,oneof" json:"column,omitempty"`                          // Column of the call.
+	FullFilename string   `protobuf:"bytes,7,opt,name=full_filename,json=fullFilename,proto3" json:"full_filename,omitempty"` // Full path of the file containing the call.
+}
+
+func (x *CallstackFrame) Reset() {
+	*x = CallstackFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallstackFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallstackFrame) ProtoMessage() {}
+
+func (x *CallstackFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallstackFrame.ProtoReflect.Descriptor instead.
+func (*CallstackFrame) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CallstackFrame) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *CallstackFrame) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *CallstackFrame) GetFunction() string {
+	if x != nil {
+		return x.Function
+	}
+	return ""
+}
+
+func (x *CallstackFrame) GetParameters() []string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *CallstackFrame) GetLine() int32 {
+	if x != nil && x.Line != nil {
+		return *x.Line
+	}
+	return 0
+}
+
+func (x *CallstackFrame) GetColumn() int32 {
+	if x != nil && x.Column != nil {
+		return *x.Column
+	}
+	return 0
+}
+
+func (x *CallstackFrame) GetFullFilename() string {
+	if x != nil {
+		return x.FullFilename
+	}
+	return ""
+}
+
+// Annotation is a comment about an element of the SBOM made by a person,
+// organization or tool at some point in time. (SPDX 2.3 annotations, CDX annotations)
+type Annotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      Annotation_Type        `protobuf:"varint,1,opt,name=type,proto3,enum=protobom.protobom.Annotation_Type" json:"type,omitempty"` // Type of the annotation.
+	Annotator *Person                `protobuf:"bytes,2,opt,name=annotator,proto3" json:"annotator,omitempty"`                               // Person or organization that made the annotation.
+	Tool      *Tool                  `protobuf:"bytes,3,opt,name=tool,proto3" json:"tool,omitempty"`                                         // Tool that made the annotation when it was not made by a person.
+	Date      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`                                         // Date the annotation was made.
+	Text      string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`                                         // Text of the annotation.
+}
+
+func (x *Annotation) Reset() {
+	*x = Annotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Annotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Annotation) ProtoMessage() {}
+
+func (x *Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Annotation) GetType() Annotation_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Annotation_UNKNOWN
+}
+
+func (x *Annotation) GetAnnotator() *Person {
+	if x != nil {
+		return x.Annotator
+	}
+	return nil
+}
+
+func (x *Annotation) GetTool() *Tool {
+	if x != nil {
+		return x.Tool
+	}
+	return nil
+}
+
+func (x *Annotation) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Annotation) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// Vulnerability represents a known security vulnerability affecting one or more nodes
+// of the SBOM graph along with its exploitability (VEX) analysis. Vulnerabilities with
+// a different analysis for some of the nodes they affect are captured as several entries
+// with the same id.
+type Vulnerability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                         // Identifier of the vulnerability, for example CVE-2021-44228.
+	Source         *VulnerabilitySource   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`                 // Database or organization that published the vulnerability.
+	Aliases        []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`               // Other identifiers of the same vulnerability. (CDX references, SPDX3 external identifiers)
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`       // Description of the vulnerability.
+	Detail         string                 `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`                 // Detailed description of the vulnerability.
+	Recommendation string                 `protobuf:"bytes,6,opt,name=recommendation,proto3" json:"recommendation,omitempty"` // Recommendations on how to remediate the vulnerability.
+	Ratings        []*VulnerabilityRating `protobuf:"bytes,7,rep,name=ratings,proto3" json:"ratings,omitempty"`               // Severity ratings of the vulnerability.
+	Cwes           []int32                `protobuf:"varint,8,rep,packed,name=cwes,proto3" json:"cwes,omitempty"`             // CWE identifiers of the weaknesses exploited by the vulnerability.
+	Advisories     []*ExternalReference   `protobuf:"bytes,9,rep,name=advisories,proto3" json:"advisories,omitempty"`         // Advisories and other references about the vulnerability.
+	Published      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=published,proto3" json:"published,omitempty"`          // Date the vulnerability was published.
+	Updated        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated,proto3" json:"updated,omitempty"`              // Date the vulnerability was last updated.
+	Affects        []string               `protobuf:"bytes,12,rep,name=affects,proto3" json:"affects,omitempty"`              // IDs of the nodes affected by the vulnerability.
+	Analysis       *VulnerabilityAnalysis `protobuf:"bytes,13,opt,name=analysis,proto3" json:"analysis,omitempty"`            // Exploitability analysis of the vulnerability in the affected nodes.
+}
+
+func (x *Vulnerability) Reset() {
+	*x = Vulnerability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vulnerability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vulnerability) ProtoMessage() {}
+
+func (x *Vulnerability) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vulnerability.ProtoReflect.Descriptor instead.
+func (*Vulnerability) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Vulnerability) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Vulnerability) GetSource() *VulnerabilitySource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Vulnerability) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Vulnerability) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Vulnerability) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *Vulnerability) GetRecommendation() string {
+	if x != nil {
+		return x.Recommendation
+	}
+	return ""
+}
+
+func (x *Vulnerability) GetRatings() []*VulnerabilityRating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+func (x *Vulnerability) GetCwes() []int32 {
+	if x != nil {
+		return x.Cwes
+	}
+	return nil
+}
+
+func (x *Vulnerability) GetAdvisories() []*ExternalReference {
+	if x != nil {
+		return x.Advisories
+	}
+	return nil
+}
+
+func (x *Vulnerability) GetPublished() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Published
+	}
+	return nil
+}
+
+func (x *Vulnerability) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *Vulnerability) GetAffects() []string {
+	if x != nil {
+		return x.Affects
+	}
+	return nil
+}
+
+func (x *Vulnerability) GetAnalysis() *VulnerabilityAnalysis {
+	if x != nil {
+		return x.Analysis
+	}
+	return nil
+}
+
+// VulnerabilitySource is the database or organization that published a vulnerability or a rating.
+type VulnerabilitySource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Name of the source, for example NVD or GitHub Advisories.
+	Url  string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`   // URL of the vulnerability in the source.
+}
+
+func (x *VulnerabilitySource) Reset() {
+	*x = VulnerabilitySource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilitySource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilitySource) ProtoMessage() {}
+
+func (x *VulnerabilitySource) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnerabilitySource.ProtoReflect.Descriptor instead.
+func (*VulnerabilitySource) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VulnerabilitySource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VulnerabilitySource) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// VulnerabilityRating is a severity score of a vulnerability computed with a scoring method.
+type VulnerabilityRating struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source        *VulnerabilitySource         `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`                                                          // Source of the rating.
+	Score         float64                      `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`                                                          // Numeric score of the rating.
+	Severity      VulnerabilityRating_Severity `protobuf:"varint,3,opt,name=severity,proto3,enum=protobom.protobom.VulnerabilityRating_Severity" json:"severity,omitempty"` // Textual severity of the rating.
+	Method        VulnerabilityRating_Method   `protobuf:"varint,4,opt,name=method,proto3,enum=protobom.protobom.VulnerabilityRating_Method" json:"method,omitempty"`       // Scoring method used to compute the rating.
+	Vector        string                       `protobuf:"bytes,5,opt,name=vector,proto3" json:"vector,omitempty"`                                                          // Scoring vector string, for example a CVSS vector.
+	Justification string                       `protobuf:"bytes,6,opt,name=justification,proto3" json:"justification,omitempty"`                                            // Reasoning behind the rating.
+}
+
+func (x *VulnerabilityRating) Reset() {
+	*x = VulnerabilityRating{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilityRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilityRating) ProtoMessage() {}
+
+func (x *VulnerabilityRating) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnerabilityRating.ProtoReflect.Descriptor instead.
+func (*VulnerabilityRating) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VulnerabilityRating) GetSource() *VulnerabilitySource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *VulnerabilityRating) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *VulnerabilityRating) GetSeverity() VulnerabilityRating_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return VulnerabilityRating_UNKNOWN_SEVERITY
+}
+
+func (x *VulnerabilityRating) GetMethod() VulnerabilityRating_Method {
+	if x != nil {
+		return x.Method
+	}
+	return VulnerabilityRating_OTHER_METHOD
+}
+
+func (x *VulnerabilityRating) GetVector() string {
+	if x != nil {
+		return x.Vector
+	}
+	return ""
+}
+
+func (x *VulnerabilityRating) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+// VulnerabilityAnalysis captures the exploitability of a vulnerability in the nodes it
+// affects as expressed in VEX (Vulnerability Exploitability eXchange) statements.
+type VulnerabilityAnalysis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        VulnerabilityAnalysis_Status        `protobuf:"varint,1,opt,name=status,proto3,enum=protobom.protobom.VulnerabilityAnalysis_Status" json:"status,omitempty"`                      // VEX status of the affected nodes.
+	Justification VulnerabilityAnalysis_Justification `protobuf:"varint,2,opt,name=justification,proto3,enum=protobom.protobom.VulnerabilityAnalysis_Justification" json:"justification,omitempty"` // Justification of a not affected status.
+	Responses     []VulnerabilityAnalysis_Response    `protobuf:"varint,3,rep,packed,name=responses,proto3,enum=protobom.protobom.VulnerabilityAnalysis_Response" json:"responses,omitempty"`       // Responses of the software vendor. (CDX Specific)
+	Detail        string                              `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`                                                                           // Impact statement or details of the analysis.
+	Action        string                              `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`                                                                           // Action statement describing how to remediate an affected status. (OpenVEX, SPDX3)
+	FirstIssued   *timestamppb.Timestamp              `protobuf:"bytes,6,opt,name=first_issued,json=firstIssued,proto3" json:"first_issued,omitempty"`                                              // Date the analysis was first issued.
+	LastUpdated   *timestamppb.Timestamp              `protobuf:"bytes,7,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`                                              // Date the analysis was last updated.
+}
+
+func (x *VulnerabilityAnalysis) Reset() {
+	*x = VulnerabilityAnalysis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sbom_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilityAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilityAnalysis) ProtoMessage() {}
+
+func (x *VulnerabilityAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sbom_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnerabilityAnalysis.ProtoReflect.Descriptor instead.
+func (*VulnerabilityAnalysis) Descriptor() ([]byte, []int) {
+	return file_api_sbom_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VulnerabilityAnalysis) GetStatus() VulnerabilityAnalysis_Status {
+	if x != nil {
+		return x.Status
+	}
+	return VulnerabilityAnalysis_UNKNOWN_STATUS
+}
+
+func (x *VulnerabilityAnalysis) GetJustification() VulnerabilityAnalysis_Justification {
+	if x != nil {
+		return x.Justification
+	}
+	return VulnerabilityAnalysis_UNKNOWN_JUSTIFICATION
+}
+
+func (x *VulnerabilityAnalysis) GetResponses() []VulnerabilityAnalysis_Response {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *VulnerabilityAnalysis) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *VulnerabilityAnalysis) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *VulnerabilityAnalysis) GetFirstIssued() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstIssued
+	}
+	return nil
+}
+
+func (x *VulnerabilityAnalysis) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+var File_api_sbom_proto protoreflect.FileDescriptor
+
+var file_api_sbom_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc9, 0x01, 0x0a, 0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x09, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f,
+	0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x0f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f,
+	0x6d, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x0f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x22, 0xa1, 0x10, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x72,
+	0x6c, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x72,
+	0x6c, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x72, 0x6c, 0x5f, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x72, 0x6c,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x69, 0x63,
+	0x65, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73,
+	0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x52, 0x09, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0b,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x0b, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x13, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x12, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x1b,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x4a, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x1c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x68,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x5f, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x1e, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x0e, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x62, 0x79,
+	0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x6f, 0x6d, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x09, 0x62, 0x79, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x6f, 0x6d, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x09, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x24, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x16, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x5f, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x18, 0x26, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x14, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3a,
+	0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x27, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6c, 0x6f, 0x77, 0x52,
+	0x09, 0x64, 0x61, 0x74, 0x61, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x65, 0x64, 0x69, 0x67, 0x72, 0x65, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x65, 0x64, 0x69, 0x67, 0x72, 0x65,
+	0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x45, 0x76, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x2e, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x6f, 0x6d, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x1a,
+	0x3e, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x39, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x08, 0x4e, 0x6f,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47,
+	0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x53, 0x4e, 0x49, 0x50, 0x50, 0x45, 0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45,
+	0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x03, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x63, 0x72,
+	0x6f, 0x73, 0x73, 0x65, 0x73, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x61, 0x72, 0x79, 0x22, 0xab, 0x04, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x2d, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x6f, 0x6d, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x33,
+	0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x45, 0x0a,
+	0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x6e, 0x0a, 0x1c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x1a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x19, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x12, 0x50, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x9e, 0x07, 0x0a, 0x04, 0x45, 0x64, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x3b,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x82, 0x06, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x10, 0x03,
+	0x12, 0x0d, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x10, 0x04, 0x12,
+	0x0c, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x10, 0x05, 0x12, 0x10, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x10, 0x06, 0x12,
+	0x08, 0x0a, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x10, 0x09, 0x12,
+	0x0d, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x10, 0x0a, 0x12, 0x10,
+	0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x4f, 0x66, 0x10, 0x0b,
+	0x12, 0x0e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x10, 0x0c,
+	0x12, 0x0d, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x10, 0x0d, 0x12,
+	0x0f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x42, 0x79, 0x10, 0x0e,
+	0x12, 0x11, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x10, 0x0f, 0x12, 0x0b, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x54, 0x6f, 0x6f, 0x6c, 0x10, 0x10,
+	0x12, 0x18, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x10, 0x11, 0x12, 0x11, 0x0a, 0x0d, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x12, 0x12, 0x0f, 0x0a,
+	0x0b, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x10, 0x13, 0x12, 0x0b,
+	0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x10, 0x14, 0x12, 0x17, 0x0a, 0x13, 0x65,
+	0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x10, 0x15, 0x12, 0x0d, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x10, 0x16, 0x12, 0x0f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x10, 0x17, 0x12, 0x10, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x10, 0x18, 0x12, 0x0d, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x73, 0x10, 0x19, 0x12, 0x11, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x10, 0x1a, 0x12, 0x0c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x66, 0x69, 0x6c, 0x65, 0x10, 0x1b, 0x12, 0x15, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x10, 0x1c, 0x12, 0x16, 0x0a,
+	0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x10, 0x1d, 0x12, 0x09, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x10, 0x1e,
+	0x12, 0x0c, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x10, 0x1f, 0x12, 0x09,
+	0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x10, 0x20, 0x12, 0x10, 0x0a, 0x0c, 0x70, 0x72, 0x65,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x10, 0x21, 0x12, 0x13, 0x0a, 0x0f, 0x70,
+	0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x10, 0x22,
+	0x12, 0x16, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x10, 0x23, 0x12, 0x12, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x10, 0x24, 0x12, 0x15, 0x0a, 0x11,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x10, 0x25, 0x12, 0x14, 0x0a, 0x10, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x10, 0x26, 0x12, 0x0e, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x10, 0x27, 0x12, 0x08, 0x0a, 0x04, 0x74, 0x65, 0x73,
+	0x74, 0x10, 0x28, 0x12, 0x0c, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x10,
+	0x29, 0x12, 0x12, 0x0a, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x10, 0x2a, 0x12, 0x0c, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x6f,
+	0x6c, 0x10, 0x2b, 0x12, 0x0b, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x10, 0x2c,
+	0x22, 0x8b, 0x0c, 0x0a, 0x11, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x48, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x48, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6, 0x09, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4f, 0x4d,
+	0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x04, 0x12, 0x0e, 0x0a,
+	0x0a, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x10, 0x05, 0x12, 0x10, 0x0a,
+	0x0c, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x06, 0x12,
+	0x18, 0x0a, 0x14, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x48, 0x41,
+	0x54, 0x10, 0x08, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x5f,
+	0x49, 0x4e, 0x46, 0x52, 0x41, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x55, 0x52, 0x45, 0x10, 0x09,
+	0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x4e,
+	0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x0a, 0x12,
+	0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x0b, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x54, 0x41, 0x4b, 0x45, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x44,
+	0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0d, 0x12, 0x0c,
+	0x0a, 0x08, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x0e, 0x12, 0x1b, 0x0a, 0x17,
+	0x44, 0x59, 0x4e, 0x41, 0x4d, 0x49, 0x43, 0x5f, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53,
+	0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x0f, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4f, 0x4c,
+	0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x43, 0x45, 0x10, 0x10, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x56, 0x49,
+	0x44, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x11, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x53, 0x53,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x12, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x55, 0x4c,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x13, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x55, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x14, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x54, 0x52,
+	0x41, 0x43, 0x4b, 0x45, 0x52, 0x10, 0x15, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x49, 0x43, 0x45, 0x4e,
+	0x53, 0x45, 0x10, 0x16, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x47, 0x10, 0x17, 0x12, 0x10, 0x0a,
+	0x0c, 0x4d, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x18, 0x12,
+	0x13, 0x0a, 0x0f, 0x4d, 0x41, 0x54, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x50, 0x4f,
+	0x52, 0x54, 0x10, 0x19, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x56, 0x45, 0x4e, 0x5f, 0x43, 0x45,
+	0x4e, 0x54, 0x52, 0x41, 0x4c, 0x10, 0x1a, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x45, 0x54, 0x52, 0x49,
+	0x43, 0x53, 0x10, 0x1b, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x43, 0x41,
+	0x52, 0x44, 0x10, 0x1c, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x50, 0x4d, 0x10, 0x1d, 0x12, 0x09, 0x0a,
+	0x05, 0x4e, 0x55, 0x47, 0x45, 0x54, 0x10, 0x1e, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45,
+	0x52, 0x10, 0x1f, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x41, 0x4d, 0x10, 0x20, 0x12, 0x16, 0x0a,
+	0x12, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x53, 0x53, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x21, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54,
+	0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x10, 0x22, 0x12, 0x12, 0x0a, 0x0e, 0x50,
+	0x55, 0x52, 0x43, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x23, 0x12,
+	0x1d, 0x0a, 0x19, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x53,
+	0x53, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x24, 0x12, 0x13,
+	0x0a, 0x0f, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43,
+	0x53, 0x10, 0x25, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x48,
+	0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x26, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x4c, 0x45,
+	0x41, 0x53, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x53, 0x10, 0x27, 0x12, 0x13, 0x0a, 0x0f, 0x52,
+	0x49, 0x53, 0x4b, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x53, 0x53, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x28,
+	0x12, 0x1b, 0x0a, 0x17, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x41, 0x4e, 0x41, 0x4c,
+	0x59, 0x53, 0x49, 0x53, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x29, 0x12, 0x1f, 0x0a,
+	0x1b, 0x53, 0x45, 0x43, 0x55, 0x52, 0x45, 0x5f, 0x53, 0x4f, 0x46, 0x54, 0x57, 0x41, 0x52, 0x45,
+	0x5f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x2a, 0x12, 0x1c,
+	0x0a, 0x18, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x44, 0x56, 0x45, 0x52,
+	0x53, 0x41, 0x52, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x2b, 0x12, 0x15, 0x0a, 0x11,
+	0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x44, 0x56, 0x49, 0x53, 0x4f, 0x52,
+	0x59, 0x10, 0x2c, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x10, 0x2d, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x43,
+	0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x46, 0x49, 0x58, 0x10, 0x2e, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x2f, 0x12,
+	0x1b, 0x0a, 0x17, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x45, 0x4e, 0x54,
+	0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x30, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x10,
+	0x31, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x57,
+	0x49, 0x44, 0x10, 0x32, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59,
+	0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x33, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x4f, 0x43, 0x49, 0x41, 0x4c, 0x10, 0x34, 0x12, 0x13, 0x0a, 0x0f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x10, 0x35,
+	0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x5f, 0x41, 0x4e, 0x41, 0x4c, 0x59,
+	0x53, 0x49, 0x53, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x36, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x37, 0x12, 0x07, 0x0a, 0x03, 0x56, 0x43, 0x53,
+	0x10, 0x38, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x55, 0x4c, 0x4e, 0x45, 0x52, 0x41, 0x42, 0x49, 0x4c,
+	0x49, 0x54, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x52, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x39, 0x12,
+	0x23, 0x0a, 0x1f, 0x56, 0x55, 0x4c, 0x4e, 0x45, 0x52, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x44, 0x49, 0x53, 0x43, 0x4c, 0x4f, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f,
+	0x52, 0x54, 0x10, 0x3a, 0x12, 0x2b, 0x0a, 0x27, 0x56, 0x55, 0x4c, 0x4e, 0x45, 0x52, 0x41, 0x42,
+	0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x4f, 0x49, 0x54, 0x41, 0x42, 0x49,
+	0x4c, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x53, 0x53, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x3b, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x45, 0x42, 0x53, 0x49, 0x54, 0x45, 0x10, 0x3c, 0x22, 0xa8,
+	0x01, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x69, 0x73, 0x5f, 0x6f, 0x72, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69,
+	0x73, 0x4f, 0x72, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x4c, 0x0a, 0x04, 0x54, 0x6f, 0x6f,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x22, 0xb7, 0x02, 0x0a, 0x0c, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x41, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x42, 0x4f, 0x4d, 0x54, 0x79, 0x70, 0x65,
+	0x48, 0x00, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x08,
+	0x53, 0x42, 0x4f, 0x4d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45,
+	0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x42,
+	0x55, 0x49, 0x4c, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x5a,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x45, 0x44,
+	0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x06, 0x12,
+	0x0d, 0x0a, 0x09, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x10, 0x07, 0x12, 0x0f,
+	0x0a, 0x0b, 0x44, 0x45, 0x43, 0x4f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d,
+	0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f,
+	0x6d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d,
+	0x2e, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x6f, 0x6f, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x71, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x04, 0x74, 0x6f, 0x6f,
+	0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x36, 0x0a, 0x0c, 0x53, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x22, 0xb5, 0x01, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x39,
+	0x0a, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6c, 0x6f, 0x77, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x46, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b,
+	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49,
+	0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x55, 0x54, 0x42,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x49, 0x44, 0x49, 0x52, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x22, 0xa4, 0x02, 0x0a, 0x06, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x37, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x22, 0xd9, 0x01, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x4e, 0x4f, 0x46, 0x46, 0x49, 0x43, 0x49, 0x41, 0x4c, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x4f, 0x4e, 0x4b, 0x45, 0x59, 0x10, 0x02, 0x12, 0x0c, 0x0a,
+	0x08, 0x42, 0x41, 0x43, 0x4b, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x43,
+	0x48, 0x45, 0x52, 0x52, 0x59, 0x5f, 0x50, 0x49, 0x43, 0x4b, 0x10, 0x04, 0x22, 0xaa, 0x02, 0x0a,
+	0x0a, 0x50, 0x61, 0x74, 0x63, 0x68, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x73, 0x73, 0x75, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x3e, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x44, 0x45, 0x46, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4e,
+	0x48, 0x41, 0x4e, 0x43, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53,
+	0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x10, 0x03, 0x22, 0x87, 0x02, 0x0a, 0x08, 0x45, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d,
+	0x2e, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x6f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e,
+	0x43, 0x61, 0x6c, 0x6c, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x63,
+	0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x63,
+	0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x70, 0x79, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x23,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x78, 0x0a, 0x0e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x63, 0x68, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x63, 0x68,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22,
+	0xc9, 0x01, 0x0a, 0x0a, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x0e,
+	0x43, 0x61, 0x6c, 0x6c, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x46,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x9a, 0x02, 0x0a, 0x0a,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x52, 0x09, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x04, 0x74,
+	0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x54, 0x6f,
+	0x6f, 0x6c, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x2a, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x02, 0x22, 0xc7, 0x04, 0x0a, 0x0d, 0x56, 0x75, 0x6c,
+	0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x56,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x26,
+	0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x56, 0x75, 0x6c, 0x6e,
+	0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x77, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x63, 0x77, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0a,
+	0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x08,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x6f, 0x6d, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x22, 0x3b, 0x0a, 0x13, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22,
+	0x8c, 0x04, 0x0a, 0x13, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x56, 0x75, 0x6c, 0x6e,
+	0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x4b, 0x0a,
+	0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x56,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x61, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49,
+	0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x57, 0x10, 0x03, 0x12, 0x0a,
+	0x0a, 0x06, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49,
+	0x47, 0x48, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c,
+	0x10, 0x06, 0x22, 0x6a, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x0c,
+	0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x43, 0x56, 0x53, 0x53, 0x56, 0x32, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x56,
+	0x53, 0x53, 0x56, 0x33, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x56, 0x53, 0x53, 0x56, 0x33,
+	0x31, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x56, 0x53, 0x53, 0x56, 0x34, 0x10, 0x04, 0x12,
+	0x09, 0x0a, 0x05, 0x4f, 0x57, 0x41, 0x53, 0x50, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x53,
+	0x56, 0x43, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x50, 0x53, 0x53, 0x10, 0x07, 0x22, 0xa7,
+	0x08, 0x0a, 0x15, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x56, 0x75, 0x6c,
+	0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x5c, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x56, 0x75, 0x6c,
+	0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x2e, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x4f, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x6f, 0x6d, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3d, 0x0a, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x12,
+	0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x60,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x41, 0x46, 0x46, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f,
+	0x54, 0x5f, 0x41, 0x46, 0x46, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
+	0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x44, 0x45, 0x52,
+	0x5f, 0x49, 0x4e, 0x56, 0x45, 0x53, 0x54, 0x49, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04,
+	0x22, 0x8c, 0x03, 0x0a, 0x0d, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4a, 0x55,
+	0x53, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x50,
+	0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x56, 0x55, 0x4c, 0x4e,
+	0x45, 0x52, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x27, 0x0a, 0x23, 0x56, 0x55, 0x4c,
+	0x4e, 0x45, 0x52, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x49, 0x4e, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x54, 0x48,
+	0x10, 0x03, 0x12, 0x35, 0x0a, 0x31, 0x56, 0x55, 0x4c, 0x4e, 0x45, 0x52, 0x41, 0x42, 0x4c, 0x45,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f,
+	0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x41, 0x44,
+	0x56, 0x45, 0x52, 0x53, 0x41, 0x52, 0x59, 0x10, 0x04, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x4e, 0x4c,
+	0x49, 0x4e, 0x45, 0x5f, 0x4d, 0x49, 0x54, 0x49, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f,
+	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x05, 0x12,
+	0x1a, 0x0a, 0x16, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x52,
+	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x53, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e,
+	0x43, 0x59, 0x10, 0x07, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x53,
+	0x5f, 0x45, 0x4e, 0x56, 0x49, 0x52, 0x4f, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x08, 0x12, 0x19,
+	0x0a, 0x15, 0x50, 0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x43,
+	0x4f, 0x4d, 0x50, 0x49, 0x4c, 0x45, 0x52, 0x10, 0x09, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f,
+	0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d,
+	0x45, 0x10, 0x0a, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x5f, 0x41, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x10, 0x0b, 0x22,
+	0x77, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10,
+	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x49, 0x58,
+	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x49, 0x4c, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x49, 0x58, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x03,
+	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x04, 0x12, 0x18,
+	0x0a, 0x14, 0x57, 0x4f, 0x52, 0x4b, 0x41, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x41, 0x56, 0x41,
+	0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x05, 0x2a, 0xf0, 0x01, 0x0a, 0x0d, 0x48, 0x61, 0x73,
+	0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x44, 0x35, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x53, 0x48, 0x41, 0x31, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48,
+	0x41, 0x32, 0x35, 0x36, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x33, 0x38, 0x34,
+	0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10, 0x05, 0x12, 0x0c,
+	0x0a, 0x08, 0x53, 0x48, 0x41, 0x33, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08,
+	0x53, 0x48, 0x41, 0x33, 0x5f, 0x33, 0x38, 0x34, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48,
+	0x41, 0x33, 0x5f, 0x35, 0x31, 0x32, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x4c, 0x41, 0x4b,
+	0x45, 0x32, 0x42, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x4c, 0x41,
+	0x4b, 0x45, 0x32, 0x42, 0x5f, 0x33, 0x38, 0x34, 0x10, 0x0a, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x4c,
+	0x41, 0x4b, 0x45, 0x32, 0x42, 0x5f, 0x35, 0x31, 0x32, 0x10, 0x0b, 0x12, 0x0a, 0x0a, 0x06, 0x42,
+	0x4c, 0x41, 0x4b, 0x45, 0x33, 0x10, 0x0c, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x44, 0x32, 0x10, 0x0d,
+	0x12, 0x0b, 0x0a, 0x07, 0x41, 0x44, 0x4c, 0x45, 0x52, 0x33, 0x32, 0x10, 0x0e, 0x12, 0x07, 0x0a,
+	0x03, 0x4d, 0x44, 0x34, 0x10, 0x0f, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x44, 0x36, 0x10, 0x10, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x32, 0x32, 0x34, 0x10, 0x11, 0x2a, 0x61, 0x0a, 0x16, 0x53,
+	0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x55, 0x52, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x43, 0x50, 0x45, 0x32, 0x32, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x50, 0x45, 0x32, 0x33,
+	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x49, 0x54, 0x4f, 0x49, 0x44, 0x10, 0x04, 0x2a, 0xb7,
+	0x03, 0x0a, 0x07, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x07, 0x0a,
+	0x03, 0x42, 0x4f, 0x4d, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e,
+	0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x41,
+	0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x10, 0x07, 0x12, 0x11,
+	0x0a, 0x0d, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10,
+	0x08, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x09, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x56, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x45,
+	0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x0b, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x0c, 0x12, 0x0c, 0x0a, 0x08,
+	0x46, 0x49, 0x52, 0x4d, 0x57, 0x41, 0x52, 0x45, 0x10, 0x0d, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x52,
+	0x41, 0x4d, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x0e, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x53,
+	0x54, 0x41, 0x4c, 0x4c, 0x10, 0x0f, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52,
+	0x59, 0x10, 0x10, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x4c,
+	0x45, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x11, 0x12,
+	0x0c, 0x0a, 0x08, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x10, 0x12, 0x12, 0x09, 0x0a,
+	0x05, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x13, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x4f, 0x44, 0x55,
+	0x4c, 0x45, 0x10, 0x14, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x15, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54,
+	0x48, 0x45, 0x52, 0x10, 0x16, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x54, 0x43, 0x48, 0x10, 0x17,
+	0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x18, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x19, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x1a, 0x12, 0x11, 0x0a, 0x0d, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1b, 0x12, 0x08,
+	0x0a, 0x04, 0x54, 0x45, 0x53, 0x54, 0x10, 0x1c, 0x42, 0x07, 0x5a, 0x05, 0x73, 0x62, 0x6f, 0x6d,
+	0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_sbom_proto_rawDescOnce sync.Once
+	file_api_sbom_proto_rawDescData = file_api_sbom_proto_rawDesc
+)
+
+func file_api_sbom_proto_rawDescGZIP() []byte {
+	file_api_sbom_proto_rawDescOnce.Do(func() {
+		file_api_sbom_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_sbom_proto_rawDescData)
+	})
+	return file_api_sbom_proto_rawDescData
+}
+
+var file_api_sbom_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_api_sbom_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_api_sbom_proto_goTypes = []interface{}{
+	(HashAlgorithm)(0),          // 0: protobom.protobom.HashAlgorithm
+	(SoftwareIdentifierType)(0), // 1: protobom.protobom.SoftwareIdentifierType
+	(Purpose)(0),                // 2: protobom.protobom.Purpose
+	(Node_NodeType)(0),          // 3: protobom.protobom.Node.NodeType
+	(Edge_Type)(0),              // 4: protobom.protobom.Edge.Type
+	(ExternalReference_ExternalReferenceType)(0), // 5: protobom.protobom.ExternalReference.ExternalReferenceType
+	(DocumentType_SBOMType)(0),                   // 6: protobom.protobom.DocumentType.SBOMType
+	(DataFlow_Direction)(0),                      // 7: protobom.protobom.DataFlow.Direction
+	(Patch_Type)(0),                              // 8: protobom.protobom.Patch.Type
+	(PatchIssue_Type)(0),                         // 9: protobom.protobom.PatchIssue.Type
+	(Annotation_Type)(0),                         // 10: protobom.protobom.Annotation.Type
+	(VulnerabilityRating_Severity)(0),            // 11: protobom.protobom.VulnerabilityRating.Severity
+	(VulnerabilityRating_Method)(0),              // 12: protobom.protobom.VulnerabilityRating.Method
+	(VulnerabilityAnalysis_Status)(0),            // 13: protobom.protobom.VulnerabilityAnalysis.Status
+	(VulnerabilityAnalysis_Justification)(0),     // 14: protobom.protobom.VulnerabilityAnalysis.Justification
+	(VulnerabilityAnalysis_Response)(0),          // 15: protobom.protobom.VulnerabilityAnalysis.Response
+	(*Document)(nil),                             // 16: protobom.protobom.Document
+	(*Node)(nil),                                 // 17: protobom.protobom.Node
+	(*Metadata)(nil),                             // 18: protobom.protobom.Metadata
+	(*ExternalDocumentReference)(nil),            // 19: protobom.protobom.ExternalDocumentReference
+	(*Edge)(nil),                                 // 20: protobom.protobom.Edge
+	(*ExternalReference)(nil),                    // 21: protobom.protobom.ExternalReference
+	(*Person)(nil),                               // 22: protobom.protobom.Person
+	(*Tool)(nil),                                 // 23: protobom.protobom.Tool
+	(*DocumentType)(nil),                         // 24: protobom.protobom.DocumentType
+	(*NodeList)(nil),                             // 25: protobom.protobom.NodeList
+	(*Property)(nil),                             // 26: protobom.protobom.Property
+	(*Provenance)(nil),                           // 27: protobom.protobom.Provenance
+	(*SnippetRange)(nil),                         // 28: protobom.protobom.SnippetRange
+	(*DataFlow)(nil),                             // 29: protobom.protobom.DataFlow
+	(*Commit)(nil),                               // 30: protobom.protobom.Commit
+	(*Patch)(nil),                                // 31: protobom.protobom.Patch
+	(*PatchIssue)(nil),                           // 32: protobom.protobom.PatchIssue
+	(*Evidence)(nil),                             // 33: protobom.protobom.Evidence
+	(*IdentityEvidence)(nil),                     // 34: protobom.protobom.IdentityEvidence
+	(*IdentityMethod)(nil),                       // 35: protobom.protobom.IdentityMethod
+	(*Occurrence)(nil),                           // 36: protobom.protobom.Occurrence
+	(*CallstackFrame)(nil),                       // 37: protobom.protobom.CallstackFrame
+	(*Annotation)(nil),                           // 38: protobom.protobom.Annotation
+	(*Vulnerability)(nil),                        // 39: protobom.protobom.Vulnerability
+	(*VulnerabilitySource)(nil),                  // 40: protobom.protobom.VulnerabilitySource
+	(*VulnerabilityRating)(nil),                  // 41: protobom.protobom.VulnerabilityRating
+	(*VulnerabilityAnalysis)(nil),                // 42: protobom.protobom.VulnerabilityAnalysis
+	nil,                                          // 43: protobom.protobom.Node.IdentifiersEntry
+	nil,                                          // 44: protobom.protobom.Node.HashesEntry
+	nil,                                          // 45: protobom.protobom.ExternalDocumentReference.HashesEntry
+	nil,                                          // 46: protobom.protobom.ExternalReference.HashesEntry
+	(*timestamppb.Timestamp)(nil),                // 47: google.protobuf.Timestamp
+}
+var file_api_sbom_proto_depIdxs = []int32{
+	18, // 0: protobom.protobom.Document.metadata:type_name -> protobom.protobom.Metadata
+	25, // 1: protobom.protobom.Document.node_list:type_name -> protobom.protobom.NodeList
+	39, // 2: protobom.protobom.Document.vulnerabilities:type_name -> protobom.protobom.Vulnerability
+	3,  // 3: protobom.protobom.Node.type:type_name -> protobom.protobom.Node.NodeType
+	22, // 4: protobom.protobom.Node.suppliers:type_name -> protobom.protobom.Person
+	22, // 5: protobom.protobom.Node.originators:type_name -> protobom.protobom.Person
+	47, // 6: protobom.protobom.Node.release_date:type_name -> google.protobuf.Timestamp
+	47, // 7: protobom.protobom.Node.build_date:type_name -> google.protobuf.Timestamp
+	47, // 8: protobom.protobom.Node.valid_until_date:type_name -> google.protobuf.Timestamp
+	21, // 9: protobom.protobom.Node.external_references:type_name -> protobom.protobom.ExternalReference
+	43, // 10: protobom.protobom.Node.identifiers:type_name -> protobom.protobom.Node.IdentifiersEntry
+	44, // 11: protobom.protobom.Node.hashes:type_name -> protobom.protobom.Node.HashesEntry
+	2,  // 12: protobom.protobom.Node.primary_purpose:type_name -> protobom.protobom.Purpose
+	26, // 13: protobom.protobom.Node.properties:type_name -> protobom.protobom.Property
+	38, // 14: protobom.protobom.Node.annotations:type_name -> protobom.protobom.Annotation
+	27, // 15: protobom.protobom.Node.provenance:type_name -> protobom.protobom.Provenance
+	28, // 16: protobom.protobom.Node.byte_range:type_name -> protobom.protobom.SnippetRange
+	28, // 17: protobom.protobom.Node.line_range:type_name -> protobom.protobom.SnippetRange
+	29, // 18: protobom.protobom.Node.data_flows:type_name -> protobom.protobom.DataFlow
+	30, // 19: protobom.protobom.Node.commits:type_name -> protobom.protobom.Commit
+	33, // 20: protobom.protobom.Node.evidence:type_name -> protobom.protobom.Evidence
+	31, // 21: protobom.protobom.Node.patch:type_name -> protobom.protobom.Patch
+	47, // 22: protobom.protobom.Metadata.date:type_name -> google.protobuf.Timestamp
+	23, // 23: protobom.protobom.Metadata.tools:type_name -> protobom.protobom.Tool
+	22, // 24: protobom.protobom.Metadata.authors:type_name -> protobom.protobom.Person
+	24, // 25: protobom.protobom.Metadata.documentTypes:type_name -> protobom.protobom.DocumentType
+	26, // 26: protobom.protobom.Metadata.properties:type_name -> protobom.protobom.Property
+	38, // 27: protobom.protobom.Metadata.annotations:type_name -> protobom.protobom.Annotation
+	19, // 28: protobom.protobom.Metadata.external_document_references:type_name -> protobom.protobom.ExternalDocumentReference
+	45, // 29: protobom.protobom.ExternalDocumentReference.hashes:type_name -> protobom.protobom.ExternalDocumentReference.HashesEntry
+	4,  // 30: protobom.protobom.Edge.type:type_name -> protobom.protobom.Edge.Type
+	26, // 31: protobom.protobom.Edge.properties:type_name -> protobom.protobom.Property
+	46, // 32: protobom.protobom.ExternalReference.hashes:type_name -> protobom.protobom.ExternalReference.HashesEntry
+	5,  // 33: protobom.protobom.ExternalReference.type:type_name -> protobom.protobom.ExternalReference.ExternalReferenceType
+	22, // 34: protobom.protobom.Person.contacts:type_name -> protobom.protobom.Person
+	6,  // 35: protobom.protobom.DocumentType.type:type_name -> protobom.protobom.DocumentType.SBOMType
+	17, // 36: protobom.protobom.NodeList.nodes:type_name -> protobom.protobom.Node
+	20, // 37: protobom.protobom.NodeList.edges:type_name -> protobom.protobom.Edge
+	26, // 38: protobom.protobom.Property.properties:type_name -> protobom.protobom.Property
+	23, // 39: protobom.protobom.Provenance.tool:type_name -> protobom.protobom.Tool
+	47, // 40: protobom.protobom.Provenance.date:type_name -> google.protobuf.Timestamp
+	7,  // 41: protobom.protobom.DataFlow.flow:type_name -> protobom.protobom.DataFlow.Direction
+	22, // 42: protobom.protobom.Commit.author:type_name -> protobom.protobom.Person
+	47, // 43: protobom.protobom.Commit.authored:type_name -> google.protobuf.Timestamp
+	22, // 44: protobom.protobom.Commit.committer:type_name -> protobom.protobom.Person
+	47, // 45: protobom.protobom.Commit.committed:type_name -> google.protobuf.Timestamp
+	8,  // 46: protobom.protobom.Patch.type:type_name -> protobom.protobom.Patch.Type
+	32, // 47: protobom.protobom.Patch.resolves:type_name -> protobom.protobom.PatchIssue
+	9,  // 48: protobom.protobom.PatchIssue.type:type_name -> protobom.protobom.PatchIssue.Type
+	34, // 49: protobom.protobom.Evidence.identity:type_name -> protobom.protobom.IdentityEvidence
+	36, // 50: protobom.protobom.Evidence.occurrences:type_name -> protobom.protobom.Occurrence
+	37, // 51: protobom.protobom.Evidence.callstack:type_name -> protobom.protobom.CallstackFrame
+	35, // 52: protobom.protobom.IdentityEvidence.methods:type_name -> protobom.protobom.IdentityMethod
+	10, // 53: protobom.protobom.Annotation.type:type_name -> protobom.protobom.Annotation.Type
+	22, // 54: protobom.protobom.Annotation.annotator:type_name -> protobom.protobom.Person
+	23, // 55: protobom.protobom.Annotation.tool:type_name -> protobom.protobom.Tool
+	47, // 56: protobom.protobom.Annotation.date:type_name -> google.protobuf.Timestamp
+	40, // 57: protobom.protobom.Vulnerability.source:type_name -> protobom.protobom.VulnerabilitySource
+	41, // 58: protobom.protobom.Vulnerability.ratings:type_name -> protobom.protobom.VulnerabilityRating
+	21, // 59: protobom.protobom.Vulnerability.advisories:type_name -> protobom.protobom.ExternalReference
+	47, // 60: protobom.protobom.Vulnerability.published:type_name -> google.protobuf.Timestamp
+	47, // 61: protobom.protobom.Vulnerability.updated:type_name -> google.protobuf.Timestamp
+	42, // 62: protobom.protobom.Vulnerability.analysis:type_name -> protobom.protobom.VulnerabilityAnalysis
+	40, // 63: protobom.protobom.VulnerabilityRating.source:type_name -> protobom.protobom.VulnerabilitySource
+	11, // 64: protobom.protobom.VulnerabilityRating.severity:type_name -> protobom.protobom.VulnerabilityRating.Severity
+	12, // 65: protobom.protobom.VulnerabilityRating.method:type_name -> protobom.protobom.VulnerabilityRating.Method
+	13, // 66: protobom.protobom.VulnerabilityAnalysis.status:type_name -> protobom.protobom.VulnerabilityAnalysis.Status
+	14, // 67: protobom.protobom.VulnerabilityAnalysis.justification:type_name -> protobom.protobom.VulnerabilityAnalysis.Justification
+	15, // 68: protobom.protobom.VulnerabilityAnalysis.responses:type_name -> protobom.protobom.VulnerabilityAnalysis.Response
+	47, // 69: protobom.protobom.VulnerabilityAnalysis.first_issued:type_name -> google.protobuf.Timestamp
+	47, // 70: protobom.protobom.VulnerabilityAnalysis.last_updated:type_name -> google.protobuf.Timestamp
+	71, // [71:71] is the sub-list for method output_type
+	71, // [71:71] is the sub-list for method input_type
+	71, // [71:71] is the sub-list for extension type_name
+	71, // [71:71] is the sub-list for extension extendee
+	0,  // [0:71] is the sub-list for field type_name
+}
+
+func init() { file_api_sbom_proto_init() }
+func file_api_sbom_proto_init() {
+	if File_api_sbom_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_sbom_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Document); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2147,7 +4743,7 @@ func file_api_sbom_proto_init() {
 			}
 		}
 		file_api_sbom_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Edge); i {
+			switch v := v.(*ExternalDocumentReference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2159,7 +4755,7 @@ func file_api_sbom_proto_init() {
 			}
 		}
 		file_api_sbom_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalReference); i {
+			switch v := v.(*Edge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2171,7 +4767,7 @@ func file_api_sbom_proto_init() {
 			}
 		}
 		file_api_sbom_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Person); i {
+			switch v := v.(*ExternalReference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2183,7 +4779,7 @@ func file_api_sbom_proto_init() {
 			}
 		}
 		file_api_sbom_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tool); i {
+			switch v := v.(*Person); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2195,7 +4791,7 @@ func file_api_sbom_proto_init() {
 			}
 		}
 		file_api_sbom_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentType); i {
+			switch v := v.(*Tool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2207,6 +4803,18 @@ func file_api_sbom_proto_init() {
 			}
 		}
 		file_api_sbom_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NodeList); i {
 			case 0:
 				return &v.state
@@ -2218,15 +4826,224 @@ func file_api_sbom_proto_init() {
 				return nil
 			}
 		}
+		file_api_sbom_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Property); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Provenance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnippetRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataFlow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Commit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Patch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Evidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdentityEvidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdentityMethod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Occurrence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallstackFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Annotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vulnerability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnerabilitySource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnerabilityRating); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sbom_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnerabilityAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_api_sbom_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_api_sbom_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_api_sbom_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_api_sbom_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_api_sbom_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_api_sbom_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_api_sbom_proto_msgTypes[21].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_sbom_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   12,
+			NumEnums:      16,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,39 @@
+package sbom
+
+import (
+	"fmt"
+)
+
+// flatString returns a deterministic serialized representation of the data flow
+func (df *DataFlow) flatString() string {
+	return fmt.Sprintf("(f)%s(c)%s", df.GetFlow(), df.GetClassification())
+}
+
+// Copy returns a duplicate of the data flow
+func (df *DataFlow) Copy() *DataFlow {
+	return &DataFlow{Flow: df.Flow, Classification: df.Classification}
+}
+
+// diffFlags compares two optional flags and returns v2 in added if it was
+// changed or set, v1 in removed if v2 is unset. count will be 1 if there was
+// a change.
+func diffFlags(v1, v2 *bool) (added, removed *bool, count int) {
+	switch {
+	case v1 == nil && v2 == nil:
+		return nil, nil, 0
+	case v2 == nil:
+		return nil, v1, 1
+	case v1 == nil || *v1 != *v2:
+		return v2, nil, 1
+	}
+	return nil, nil, 0
+}
+
+// copyFlag returns a copy of an optional flag
+func copyFlag(v *bool) *bool {
+	if v == nil {
+		return nil
+	}
+	ret := *v
+	return &ret
+}
@@ -0,0 +1,60 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceNodeCopyAndDiff(t *testing.T) {
+	authenticated := true
+	n := &Node{
+		Id:            "api",
+		Type:          Node_SERVICE,
+		Endpoints:     []string{"https://api.example.com"},
+		Authenticated: &authenticated,
+		DataFlows:     []*DataFlow{{Flow: DataFlow_INBOUND, Classification: "PII"}},
+	}
+
+	n2 := n.Copy()
+	require.True(t, n.Equal(n2))
+	require.NotSame(t, n.Authenticated, n2.Authenticated)
+	require.NotSame(t, n.DataFlows[0], n2.DataFlows[0])
+
+	crosses := false
+	n2.CrossesTrustBoundary = &crosses
+	n2.Endpoints = append(n2.Endpoints, "https://api2.example.com")
+	n2.DataFlows[0].Flow = DataFlow_OUTBOUND
+	require.False(t, n.Equal(n2))
+
+	nd := n.Diff(n2)
+	require.NotNil(t, nd)
+	require.Equal(t, 3, nd.DiffCount)
+	require.Equal(t, &crosses, nd.Added.CrossesTrustBoundary)
+	require.Equal(t, []string{"https://api2.example.com"}, nd.Added.Endpoints)
+	require.Len(t, nd.Added.DataFlows, 1)
+	require.Len(t, nd.Removed.DataFlows, 1)
+}
+
+func TestDiffFlags(t *testing.T) {
+	yes, no := true, false
+	for _, tc := range []struct {
+		name            string
+		v1, v2          *bool
+		added, removed  *bool
+		expectedChanges int
+	}{
+		{name: "both unset"},
+		{name: "same value", v1: &yes, v2: &yes},
+		{name: "set", v2: &no, added: &no, expectedChanges: 1},
+		{name: "changed", v1: &yes, v2: &no, added: &no, expectedChanges: 1},
+		{name: "unset", v1: &yes, removed: &yes, expectedChanges: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			added, removed, count := diffFlags(tc.v1, tc.v2)
+			require.Equal(t, tc.added, added)
+			require.Equal(t, tc.removed, removed)
+			require.Equal(t, tc.expectedChanges, count)
+		})
+	}
+}
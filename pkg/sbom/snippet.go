@@ -0,0 +1,103 @@
+package sbom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Names of the properties used to serialize the ranges of snippets in formats
+// that don't support them, like CycloneDX. Ranges are rendered as start:end.
+const (
+	SnippetByteRangePropertyName = "protobom:snippet:byte_range"
+	SnippetLineRangePropertyName = "protobom:snippet:line_range"
+)
+
+// flatString returns a deterministic serialized representation of the range
+func (r *SnippetRange) flatString() string {
+	return fmt.Sprintf("%d-%d", r.GetStart(), r.GetEnd())
+}
+
+// Copy returns a duplicate of the range. Copying a nil range returns nil.
+func (r *SnippetRange) Copy() *SnippetRange {
+	if r == nil {
+		return nil
+	}
+	return &SnippetRange{Start: r.Start, End: r.End}
+}
+
+// diffRanges compares two snippet ranges and returns r2 in added if there is
+// a change or r1 in removed if r2 is nil. count will be 1 if there was a change.
+func diffRanges(r1, r2 *SnippetRange) (added, removed *SnippetRange, count int) {
+	switch {
+	case r1 == nil && r2 == nil:
+		return nil, nil, 0
+	case r2 == nil:
+		return nil, r1, 1
+	case r1 == nil || r1.flatString() != r2.flatString():
+		return r2, nil, 1
+	}
+	return nil, nil, 0
+}
+
+// GetSnippetFile returns the file node that contains the snippet with the
+// specified ID, that is the first FILE node with a contains edge pointing to
+// it. Returns nil if the snippet is not contained in any file of the list.
+func (nl *NodeList) GetSnippetFile(id string) *Node {
+	for _, e := range nl.GetEdges() {
+		if e.Type != Edge_contains || !e.PointsTo(id) {
+			continue
+		}
+		if n := nl.GetNodeByID(e.From); n != nil && n.Type == Node_FILE {
+			return n
+		}
+	}
+	return nil
+}
+
+// ToProperty renders the range as a property with the specified name, one of
+// SnippetByteRangePropertyName or SnippetLineRangePropertyName.
+func (r *SnippetRange) ToProperty(name string) *Property {
+	return &Property{Name: name, Value: fmt.Sprintf("%d:%d", r.GetStart(), r.GetEnd())}
+}
+
+// SnippetRangeFromProperty parses a range serialized by SnippetRange.ToProperty
+func SnippetRangeFromProperty(prop *Property) (*SnippetRange, error) {
+	if prop.GetName() != SnippetByteRangePropertyName && prop.GetName() != SnippetLineRangePropertyName {
+		return nil, fmt.Errorf("property %q is not a snippet range", prop.GetName())
+	}
+	start, end, ok := strings.Cut(prop.GetValue(), ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid snippet range %q", prop.GetValue())
+	}
+	r := &SnippetRange{}
+	var err error
+	if r.Start, err = strconv.ParseInt(start, 10, 64); err != nil {
+		return nil, fmt.Errorf("parsing snippet range start: %w", err)
+	}
+	if r.End, err = strconv.ParseInt(end, 10, 64); err != nil {
+		return nil, fmt.Errorf("parsing snippet range end: %w", err)
+	}
+	return r, nil
+}
+
+// RestoreSnippet turns a node serialized with snippet range properties back
+// into a snippet. The range properties are removed from the node. Properties
+// that cannot be parsed are left in place.
+func (n *Node) RestoreSnippet() {
+	props := []*Property{}
+	for _, prop := range n.Properties {
+		r, err := SnippetRangeFromProperty(prop)
+		if err != nil {
+			props = append(props, prop)
+			continue
+		}
+		if prop.GetName() == SnippetByteRangePropertyName {
+			n.ByteRange = r
+		} else {
+			n.LineRange = r
+		}
+		n.Type = Node_SNIPPET
+	}
+	n.Properties = props
+}
@@ -0,0 +1,115 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSnippetFile(t *testing.T) {
+	nl := &NodeList{
+		Nodes: []*Node{
+			{Id: "package", Type: Node_PACKAGE},
+			{Id: "file", Type: Node_FILE},
+			{Id: "snippet", Type: Node_SNIPPET},
+			{Id: "orphan", Type: Node_SNIPPET},
+		},
+		Edges: []*Edge{
+			{Type: Edge_contains, From: "package", To: []string{"file", "snippet", "orphan"}},
+			{Type: Edge_contains, From: "file", To: []string{"snippet"}},
+		},
+	}
+	require.Equal(t, "file", nl.GetSnippetFile("snippet").GetId())
+	require.Nil(t, nl.GetSnippetFile("orphan"))
+	require.Nil(t, nl.GetSnippetFile("unknown"))
+}
+
+func TestSnippetRangeProperties(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		prop      *Property
+		expected  *SnippetRange
+		shouldErr bool
+	}{
+		{
+			name:     "byte range",
+			prop:     &Property{Name: SnippetByteRangePropertyName, Value: "310:420"},
+			expected: &SnippetRange{Start: 310, End: 420},
+		},
+		{
+			name:     "line range",
+			prop:     &Property{Name: SnippetLineRangePropertyName, Value: "5:23"},
+			expected: &SnippetRange{Start: 5, End: 23},
+		},
+		{
+			name:      "other property",
+			prop:      &Property{Name: "range", Value: "5:23"},
+			shouldErr: true,
+		},
+		{
+			name:      "no separator",
+			prop:      &Property{Name: SnippetLineRangePropertyName, Value: "5"},
+			shouldErr: true,
+		},
+		{
+			name:      "invalid number",
+			prop:      &Property{Name: SnippetLineRangePropertyName, Value: "5:x"},
+			shouldErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := SnippetRangeFromProperty(tc.prop)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.flatString(), r.flatString())
+			require.Equal(t, tc.prop.Value, r.ToProperty(tc.prop.Name).Value)
+		})
+	}
+}
+
+func TestRestoreSnippet(t *testing.T) {
+	n := &Node{
+		Id:   "snippet",
+		Type: Node_FILE,
+		Properties: []*Property{
+			{Name: "a", Value: "1"},
+			{Name: SnippetByteRangePropertyName, Value: "310:420"},
+			{Name: SnippetLineRangePropertyName, Value: "5:23"},
+		},
+	}
+	n.RestoreSnippet()
+	require.Equal(t, Node_SNIPPET, n.Type)
+	require.Equal(t, int64(310), n.ByteRange.Start)
+	require.Equal(t, int64(23), n.LineRange.End)
+	require.Len(t, n.Properties, 1)
+
+	// Files without range properties are not modified
+	f := &Node{Id: "file", Type: Node_FILE, Properties: []*Property{{Name: "a", Value: "1"}}}
+	f.RestoreSnippet()
+	require.Equal(t, Node_FILE, f.Type)
+	require.Len(t, f.Properties, 1)
+}
+
+func TestSnippetRangeNodeOperations(t *testing.T) {
+	n := &Node{Id: "snippet", Type: Node_SNIPPET, ByteRange: &SnippetRange{Start: 1, End: 10}}
+
+	n2 := n.Copy()
+	require.True(t, n.Equal(n2))
+	n2.ByteRange.End = 20
+	require.Equal(t, int64(10), n.ByteRange.End)
+	require.False(t, n.Equal(n2))
+
+	nd := n.Diff(n2)
+	require.NotNil(t, nd)
+	require.Equal(t, 1, nd.DiffCount)
+	require.Equal(t, int64(20), nd.Added.ByteRange.End)
+
+	n.Augment(&Node{Id: "snippet", LineRange: &SnippetRange{Start: 1, End: 2}})
+	require.Equal(t, int64(2), n.LineRange.End)
+	n.Update(&Node{Id: "snippet", LineRange: &SnippetRange{Start: 3, End: 4}})
+	require.Equal(t, int64(4), n.LineRange.End)
+	require.Equal(t, int64(10), n.ByteRange.End)
+}
@@ -0,0 +1,302 @@
+package sbom
+
+// TraversalDirection controls which way edges are followed when walking
+// the NodeList graph.
+type TraversalDirection int
+
+const (
+	// TraverseDescendants follows edges from their From node to their
+	// destinations.
+	TraverseDescendants TraversalDirection = iota
+
+	// TraverseAncestors follows edges backwards, from the destinations
+	// to the From node.
+	TraverseAncestors
+
+	// TraverseBoth follows edges in both directions
+	TraverseBoth
+)
+
+// Groups of edge types commonly filtered out when computing subgraphs. For
+// example, a runtime closure can be obtained by excluding development, build
+// and documentation edges.
+var (
+	// DevelopmentEdgeTypes are the relationships with components used
+	// only while developing and testing the software.
+	DevelopmentEdgeTypes = []Edge_Type{
+		Edge_devDependency, Edge_devTool, Edge_test, Edge_testCase,
+		Edge_testDependency, Edge_testTool,
+	}
+
+	// BuildEdgeTypes are the relationships with components needed only to
+	// build the software.
+	BuildEdgeTypes = []Edge_Type{
+		Edge_buildDependency, Edge_buildTool,
+	}
+
+	// DocumentationEdgeTypes are the relationships with documentation and
+	// other non-functional elements.
+	DocumentationEdgeTypes = []Edge_Type{
+		Edge_documentation, Edge_example, Edge_requirementFor, Edge_specificationFor,
+	}
+)
+
+// TraversalOptions controls how the NodeList graph is walked
+type TraversalOptions struct {
+	// Direction sets which way the edges are followed
+	Direction TraversalDirection
+
+	// IncludeEdgeTypes limits the traversal to edges of these types. If
+	// empty, all types are followed.
+	IncludeEdgeTypes []Edge_Type
+
+	// ExcludeEdgeTypes lists edge types that will never be followed. It
+	// takes precedence over IncludeEdgeTypes.
+	ExcludeEdgeTypes []Edge_Type
+
+	// MaxDepth is the maximum number of edges walked away from the start
+	// node. Zero means no limit.
+	MaxDepth int
+
+	// NodeFilter, when set, is called for every node reached. Nodes for which
+	// it returns false are not added to the result and are not traversed.
+	// The starting node is always included.
+	NodeFilter func(*Node) bool
+
+	// StopAtRootElements stops the traversal when reaching a root element
+	// of the NodeList. Root elements are still included in the result.
+	StopAtRootElements bool
+
+	// skipRootElements leaves the root elements reached out of the result,
+	// as NodeGraph does when called without options.
+	skipRootElements bool
+}
+
+// followsEdgeType returns a function that tells if an edge type is to be
+// traversed according to the options.
+func (opts *TraversalOptions) followsEdgeType() func(Edge_Type) bool {
+	include := edgeTypeSet(opts.IncludeEdgeTypes)
+	exclude := edgeTypeSet(opts.ExcludeEdgeTypes)
+	return func(t Edge_Type) bool {
+		if _, ok := exclude[t]; ok {
+			return false
+		}
+		if include == nil {
+			return true
+		}
+		_, ok := include[t]
+		return ok
+	}
+}
+
+// TraversalOption is a functional option that modifies how NodeGraph and
+// NodeDescendants walk the NodeList graph.
+type TraversalOption func(*TraversalOptions)
+
+// newTraversalOptions returns traversal options with opts applied
+func newTraversalOptions(opts ...TraversalOption) *TraversalOptions {
+	o := &TraversalOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithDirection sets which way the edges are followed
+func WithDirection(d TraversalDirection) TraversalOption {
+	return func(o *TraversalOptions) {
+		o.Direction = d
+	}
+}
+
+// WithEdgeTypes limits the traversal to edges of the specified types
+func WithEdgeTypes(types ...Edge_Type) TraversalOption {
+	return func(o *TraversalOptions) {
+		o.IncludeEdgeTypes = append(o.IncludeEdgeTypes, types...)
+	}
+}
+
+// WithoutEdgeTypes excludes edges of the specified types from the traversal
+func WithoutEdgeTypes(types ...Edge_Type) TraversalOption {
+	return func(o *TraversalOptions) {
+		o.ExcludeEdgeTypes = append(o.ExcludeEdgeTypes, types...)
+	}
+}
+
+// WithMaxDepth sets the maximum number of edges walked from the start node
+func WithMaxDepth(depth int) TraversalOption {
+	return func(o *TraversalOptions) {
+		o.MaxDepth = depth
+	}
+}
+
+// WithNodeFilter sets a function to decide which nodes are traversed
+func WithNodeFilter(filter func(*Node) bool) TraversalOption {
+	return func(o *TraversalOptions) {
+		o.NodeFilter = filter
+	}
+}
+
+// WithStopAtRootElements stops the traversal when reaching a root element
+func WithStopAtRootElements(stop bool) TraversalOption {
+	return func(o *TraversalOptions) {
+		o.StopAtRootElements = stop
+	}
+}
+
+// inListOrder sorts the nodes of the graph fragment nl2 in the order they
+// have in the NodeList.
+func (nl *NodeList) inListOrder(nl2 *NodeList) *NodeList {
+	if len(nl2.Nodes) < 2 {
+		return nl2
+	}
+	found := nl2.indexNodes()
+	nodes := make([]*Node, 0, len(nl2.Nodes))
+	for _, n := range nl.Nodes {
+		if _, ok := found[n.Id]; !ok {
+			continue
+		}
+		delete(found, n.Id)
+		nodes = append(nodes, n)
+	}
+	nl2.Nodes = nodes
+	return nl2
+}
+
+// traverse walks the indexed graph starting at the node identified by id
+// following the edges as specified in the traversal options and returns a new
+// NodeList with the nodes reached and the edges among them of the followed
+// types. The nodes are returned in the order they were reached.
+//
+// When traversing descendants (or both directions) the starting node is the
+// single root element of the returned list. When traversing ancestors, the
+// root elements are the topmost nodes found. If the specified id is not found
+// the NodeList will be empty.
+func (idx *graphIndex) traverse(id string, opts *TraversalOptions) *NodeList {
+	startNode := idx.nodes[id]
+	if startNode == nil {
+		return &NodeList{}
+	}
+
+	follow := opts.followsEdgeType()
+	found := nodeIndex{startNode.Id: startNode}
+	order := []*Node{startNode}
+	rejected := map[string]struct{}{}
+	current := []string{startNode.Id}
+
+	// visit checks a neighbor and returns true if it has to be traversed
+	visit := func(nodeID string) bool {
+		if _, ok := found[nodeID]; ok {
+			return false
+		}
+		if _, ok := rejected[nodeID]; ok {
+			return false
+		}
+		n, ok := idx.nodes[nodeID]
+		if !ok {
+			return false
+		}
+		_, isRoot := idx.roots[nodeID]
+		if (isRoot && opts.skipRootElements) || (opts.NodeFilter != nil && !opts.NodeFilter(n)) {
+			rejected[nodeID] = struct{}{}
+			return false
+		}
+		found[nodeID] = n
+		order = append(order, n)
+		return !isRoot || !opts.StopAtRootElements
+	}
+
+	for depth := 0; len(current) > 0 && (opts.MaxDepth < 1 || depth < opts.MaxDepth); depth++ {
+		next := []string{}
+		for _, nodeID := range current {
+			if opts.Direction != TraverseAncestors {
+				for _, e := range idx.from[nodeID] {
+					if !follow(e.Type) {
+						continue
+					}
+					for _, to := range e.To {
+						if visit(to) {
+							next = append(next, to)
+						}
+					}
+				}
+			}
+			if opts.Direction != TraverseDescendants {
+				for _, e := range idx.to[nodeID] {
+					if follow(e.Type) && visit(e.From) {
+						next = append(next, e.From)
+					}
+				}
+			}
+		}
+		current = next
+	}
+
+	nl2 := &NodeList{
+		Nodes:        order,
+		Edges:        []*Edge{},
+		RootElements: []string{},
+	}
+	for _, n := range order {
+		for _, e := range idx.from[n.Id] {
+			if follow(e.Type) {
+				nl2.Edges = append(nl2.Edges, e)
+			}
+		}
+	}
+	nl2.cleanEdges()
+
+	if opts.Direction != TraverseAncestors {
+		nl2.RootElements = append(nl2.RootElements, startNode.Id)
+		return nl2
+	}
+
+	// When looking at the ancestors, the root elements of the fragment are
+	// the nodes that have no parents in it.
+	hasParent := map[string]struct{}{}
+	for _, e := range nl2.Edges {
+		for _, to := range e.To {
+			hasParent[to] = struct{}{}
+		}
+	}
+	for _, n := range nl2.Nodes {
+		if _, ok := hasParent[n.Id]; !ok {
+			nl2.RootElements = append(nl2.RootElements, n.Id)
+		}
+	}
+
+	return nl2
+}
+
+// nodeGraph implements NodeGraph on the indexed graph
+func (idx *graphIndex) nodeGraph(id string, opts []TraversalOption) *NodeList {
+	if _, ok := idx.nodes[id]; !ok {
+		return nil
+	}
+	if len(opts) == 0 {
+		// Without options, other root elements are boundaries of the graph
+		return idx.traverse(id, &TraversalOptions{skipRootElements: true})
+	}
+	return idx.traverse(id, newTraversalOptions(opts...))
+}
+
+// descendants implements NodeDescendants on the indexed graph
+func (idx *graphIndex) descendants(id string, maxDepth int, opts []TraversalOption) *NodeList {
+	o := newTraversalOptions(append([]TraversalOption{WithStopAtRootElements(true)}, opts...)...)
+	o.Direction = TraverseDescendants
+	// The starting node counts as the first level
+	o.MaxDepth = maxDepth - 1
+	if maxDepth <= 1 {
+		// Nothing to traverse, only the starting node is returned
+		o.NodeFilter = func(*Node) bool { return false }
+	}
+	return idx.traverse(id, o)
+}
+
+// ancestors implements NodeAncestors on the indexed graph
+func (idx *graphIndex) ancestors(id string, maxDepth int, opts []TraversalOption) *NodeList {
+	o := newTraversalOptions(opts...)
+	o.MaxDepth = maxDepth
+	o.Direction = TraverseAncestors
+	return idx.traverse(id, o)
+}
@@ -0,0 +1,146 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeGraphTraversalOptions(t *testing.T) {
+	for _, tc := range []struct {
+		testName      string
+		id            string
+		opts          []TraversalOption
+		expectedNodes []string
+		expectedRoots []string
+	}{
+		{
+			testName: "runtime closure",
+			id:       "product-a",
+			opts: []TraversalOption{
+				WithoutEdgeTypes(DevelopmentEdgeTypes...),
+				WithoutEdgeTypes(BuildEdgeTypes...),
+				WithoutEdgeTypes(DocumentationEdgeTypes...),
+			},
+			expectedNodes: []string{"product-a", "framework", "logging", "log4j-core"},
+			expectedRoots: []string{"product-a"},
+		},
+		{
+			testName:      "build only",
+			id:            "product-a",
+			opts:          []TraversalOption{WithEdgeTypes(BuildEdgeTypes...)},
+			expectedNodes: []string{"product-a", "compiler"},
+			expectedRoots: []string{"product-a"},
+		},
+		{
+			testName: "exclude overrides include",
+			id:       "product-a",
+			opts: []TraversalOption{
+				WithEdgeTypes(Edge_dependsOn, Edge_buildTool),
+				WithoutEdgeTypes(Edge_buildTool),
+			},
+			expectedNodes: []string{"product-a", "framework", "logging", "log4j-core"},
+			expectedRoots: []string{"product-a"},
+		},
+		{
+			testName:      "max depth",
+			id:            "product-a",
+			opts:          []TraversalOption{WithMaxDepth(2)},
+			expectedNodes: []string{"product-a", "framework", "logging", "log4j-core", "junit", "compiler", "README"},
+			expectedRoots: []string{"product-a"},
+		},
+		{
+			testName:      "stop at root elements",
+			id:            "logging",
+			opts:          []TraversalOption{WithDirection(TraverseBoth), WithStopAtRootElements(true)},
+			expectedNodes: []string{"product-a", "product-b", "framework", "plugin", "logging", "log4j-core", "junit", "unrelated"},
+			expectedRoots: []string{"logging"},
+		},
+		{
+			testName: "node filter",
+			id:       "product-a",
+			opts: []TraversalOption{
+				WithNodeFilter(func(n *Node) bool { return n.Type == Node_PACKAGE && n.Id != "framework" }),
+			},
+			expectedNodes: []string{"product-a", "log4j-core", "junit", "compiler"},
+			expectedRoots: []string{"product-a"},
+		},
+		{
+			testName:      "ancestors",
+			id:            "logging",
+			opts:          []TraversalOption{WithDirection(TraverseAncestors)},
+			expectedNodes: []string{"product-a", "product-b", "framework", "plugin", "logging"},
+			expectedRoots: []string{"product-a", "product-b"},
+		},
+		{
+			testName:      "ancestors without test edges",
+			id:            "junit",
+			opts:          []TraversalOption{WithDirection(TraverseAncestors), WithoutEdgeTypes(DevelopmentEdgeTypes...)},
+			expectedNodes: []string{"junit", "unrelated"},
+			expectedRoots: []string{"unrelated"},
+		},
+		{
+			testName:      "both directions",
+			id:            "framework",
+			opts:          []TraversalOption{WithDirection(TraverseBoth), WithMaxDepth(1)},
+			expectedNodes: []string{"product-a", "framework", "logging"},
+			expectedRoots: []string{"framework"},
+		},
+	} {
+		t.Run(tc.testName, func(t *testing.T) {
+			res := sampleGraphNodeList().NodeGraph(tc.id, tc.opts...)
+			require.NotNil(t, res)
+			require.Equal(t, tc.expectedNodes, nodeIDs(res.Nodes))
+			require.ElementsMatch(t, tc.expectedRoots, res.RootElements)
+			require.Empty(t, res.Validate().WithSeverity(SeverityError))
+		})
+	}
+
+	// Unknown nodes return nil, like NodeGraph without options
+	require.Nil(t, sampleGraphNodeList().NodeGraph("nope", WithMaxDepth(1)))
+}
+
+func TestNodeDescendantsTraversalOptions(t *testing.T) {
+	for _, tc := range []struct {
+		testName      string
+		id            string
+		depth         int
+		opts          []TraversalOption
+		expectedNodes []string
+	}{
+		{
+			testName:      "depth counts the start node",
+			id:            "product-a",
+			depth:         3,
+			opts:          []TraversalOption{WithEdgeTypes(Edge_dependsOn)},
+			expectedNodes: []string{"product-a", "framework", "logging"},
+		},
+		{
+			testName:      "without development edges",
+			id:            "product-a",
+			depth:         10,
+			opts:          []TraversalOption{WithoutEdgeTypes(DevelopmentEdgeTypes...)},
+			expectedNodes: []string{"product-a", "framework", "logging", "log4j-core", "compiler", "README"},
+		},
+		{
+			testName:      "direction is ignored",
+			id:            "logging",
+			depth:         10,
+			opts:          []TraversalOption{WithDirection(TraverseAncestors)},
+			expectedNodes: []string{"logging", "log4j-core"},
+		},
+		{
+			testName:      "single level",
+			id:            "product-a",
+			depth:         1,
+			opts:          []TraversalOption{WithEdgeTypes(Edge_dependsOn)},
+			expectedNodes: []string{"product-a"},
+		},
+	} {
+		t.Run(tc.testName, func(t *testing.T) {
+			res := sampleGraphNodeList().NodeDescendants(tc.id, tc.depth, tc.opts...)
+			require.ElementsMatch(t, tc.expectedNodes, nodeIDs(res.Nodes))
+			require.Equal(t, []string{tc.id}, res.RootElements)
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package sbom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This file implements integrity checks for the protobom graph. Validate
+// does not modify the NodeList, it only reports the problems it finds so
+// callers can decide what to do with them.
+
+// Severity indicates how serious an integrity issue is
+type Severity int
+
+const (
+	// SeverityInfo issues are observations that don't affect the document
+	SeverityInfo Severity = iota
+
+	// SeverityWarning issues are suspicious but the graph is still usable
+	SeverityWarning
+
+	// SeverityError issues mean the graph is broken, data will be lost or
+	// misinterpreted when working with it.
+	SeverityError
+)
+
+// String returns the severity label
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return fmt.Sprintf("severity(%d)", int(s))
+	}
+}
+
+// IssueCode identifies the kind of integrity problem found
+type IssueCode string
+
+const (
+	IssueEmptyNodeID          IssueCode = "empty-node-id"
+	IssueDuplicateNodeID      IssueCode = "duplicate-node-id"
+	IssueNoRootElements       IssueCode = "no-root-elements"
+	IssueMissingRootElement   IssueCode = "missing-root-element"
+	IssueDuplicateRootElement IssueCode = "duplicate-root-element"
+	IssueDanglingEdgeFrom     IssueCode = "dangling-edge-from"
+	IssueDanglingEdgeTo       IssueCode = "dangling-edge-to"
+	IssueEmptyEdge            IssueCode = "empty-edge"
+	IssueUnknownEdgeType      IssueCode = "unknown-edge-type"
+	IssueSelfReference        IssueCode = "self-reference"
+	IssueUnreachableNode      IssueCode = "unreachable-node"
+	IssueMissingMetadata      IssueCode = "missing-metadata"
+	IssueMissingDocumentID    IssueCode = "missing-document-id"
+	IssueMissingNodeList      IssueCode = "missing-nodelist"
+	IssueSnippetWithoutFile   IssueCode = "snippet-without-file"
+	IssueInvalidSnippetRange  IssueCode = "invalid-snippet-range"
+
+	IssueDanglingVulnerabilityRef IssueCode = "dangling-vulnerability-ref"
+	IssueUnknownExternalDocument  IssueCode = "unknown-external-document"
+)
+
+// Issue is an integrity problem found when validating a NodeList or Document
+type Issue struct {
+	Severity Severity
+	Code     IssueCode
+	Message  string
+
+	// Elements are the IDs of the nodes involved in the issue
+	Elements []string
+}
+
+// String returns a one line representation of the issue
+func (i *Issue) String() string {
+	return fmt.Sprintf("[%s] %s: %s", i.Severity, i.Code, i.Message)
+}
+
+// Issues is a list of integrity problems
+type Issues []*Issue
+
+// HasErrors returns true if any of the issues has error severity
+func (is Issues) HasErrors() bool {
+	for _, i := range is {
+		if i.Severity >= SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// WithSeverity returns the issues at severity s or above
+func (is Issues) WithSeverity(s Severity) Issues {
+	ret := Issues{}
+	for _, i := range is {
+		if i.Severity >= s {
+			ret = append(ret, i)
+		}
+	}
+	return ret
+}
+
+// Err returns an *IntegrityError wrapping the error severity issues or nil
+// if there are none.
+func (is Issues) Err() error {
+	if !is.HasErrors() {
+		return nil
+	}
+	return &IntegrityError{Issues: is.WithSeverity(SeverityError)}
+}
+
+// IntegrityError is returned when a graph has error severity issues
+type IntegrityError struct {
+	Issues Issues
+}
+
+// Error implements the error interface
+func (e *IntegrityError) Error() string {
+	msgs := []string{}
+	for _, i := range e.Issues {
+		msgs = append(msgs, i.String())
+	}
+	return fmt.Sprintf("graph has %d integrity errors: %s", len(e.Issues), strings.Join(msgs, "; "))
+}
+
+// Validate checks the NodeList for integrity problems: empty and duplicate
+// node identifiers, root elements and edges that point to nodes not in the
+// list, edges without type or destinations, snippets not contained in a file
+// or with invalid ranges and nodes that can't be reached from any of the root
+// elements. Edges to nodes in external documents are not
+// checked as the NodeList has no access to them.
+func (nl *NodeList) Validate() Issues {
+	issues := Issues{}
+	add := func(s Severity, code IssueCode, msg string, ids ...string) {
+		issues = append(issues, &Issue{Severity: s, Code: code, Message: msg, Elements: ids})
+	}
+
+	// Check the node identifiers
+	index := nodeIndex{}
+	for i, n := range nl.Nodes {
+		if n.Id == "" {
+			add(SeverityError, IssueEmptyNodeID, fmt.Sprintf("node #%d has no identifier", i))
+			continue
+		}
+		if _, ok := index[n.Id]; ok {
+			add(SeverityError, IssueDuplicateNodeID, fmt.Sprintf("node identifier %q is used more than once", n.Id), n.Id)
+			continue
+		}
+		index[n.Id] = n
+	}
+
+	// Check the top level elements
+	if len(nl.RootElements) == 0 && len(nl.Nodes) > 0 {
+		add(SeverityWarning, IssueNoRootElements, "nodelist has nodes but no root elements")
+	}
+	seenRoots := rootElementsIndex{}
+	for _, id := range nl.RootElements {
+		if _, ok := seenRoots[id]; ok {
+			add(SeverityWarning, IssueDuplicateRootElement, fmt.Sprintf("root element %q is listed more than once", id), id)
+			continue
+		}
+		seenRoots[id] = struct{}{}
+		if _, ok := index[id]; !ok {
+			add(SeverityError, IssueMissingRootElement, fmt.Sprintf("root element %q is not a node in the list", id), id)
+		}
+	}
+
+	// Check the edges
+	for _, e := range nl.Edges {
+		if _, ok := index[e.From]; !ok {
+			add(SeverityError, IssueDanglingEdgeFrom, fmt.Sprintf("%s edge starts at unknown node %q", e.Type, e.From), e.From)
+		}
+		if e.Type == Edge_UNKNOWN {
+			add(SeverityWarning, IssueUnknownEdgeType, fmt.Sprintf("edge from %q has no type", e.From), e.From)
+		}
+		if len(e.To) == 0 {
+			add(SeverityWarning, IssueEmptyEdge, fmt.Sprintf("%s edge from %q has no destinations", e.Type, e.From), e.From)
+		}
+		for _, to := range e.To {
+			if _, ok := index[to]; !ok && !isExternalNodeID(to) {
+				add(SeverityError, IssueDanglingEdgeTo, fmt.Sprintf("%s edge from %q points to unknown node %q", e.Type, e.From, to), e.From, to)
+			}
+			if to == e.From {
+				add(SeverityWarning, IssueSelfReference, fmt.Sprintf("%s edge from %q points to itself", e.Type, e.From), e.From)
+			}
+		}
+	}
+
+	// Snippets must be contained in a file
+	snippetFiles := map[string]struct{}{}
+	for _, e := range nl.Edges {
+		if f, ok := index[e.From]; !ok || f.Type != Node_FILE || e.Type != Edge_contains {
+			continue
+		}
+		for _, to := range e.To {
+			snippetFiles[to] = struct{}{}
+		}
+	}
+	for _, n := range nl.Nodes {
+		if n.Type != Node_SNIPPET {
+			continue
+		}
+		if _, ok := snippetFiles[n.Id]; !ok {
+			add(SeverityWarning, IssueSnippetWithoutFile, fmt.Sprintf("snippet %q is not contained in any file", n.Id), n.Id)
+		}
+		for _, r := range []*SnippetRange{n.ByteRange, n.LineRange} {
+			if r != nil && (r.Start < 1 || r.End < r.Start) {
+				add(SeverityWarning, IssueInvalidSnippetRange, fmt.Sprintf("snippet %q has an invalid range %s", n.Id, r.flatString()), n.Id)
+			}
+		}
+	}
+
+	// Finally, look for nodes that can't be reached from the roots.
+	if len(nl.RootElements) == 0 {
+		return issues
+	}
+
+	edges := nl.indexEdges()
+	reached := map[string]struct{}{}
+	queue := []string{}
+	for _, id := range nl.RootElements {
+		if _, ok := reached[id]; !ok {
+			reached[id] = struct{}{}
+			queue = append(queue, id)
+		}
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, typedEdges := range edges[id] {
+			for _, e := range typedEdges {
+				for _, to := range e.To {
+					if _, ok := reached[to]; ok {
+						continue
+					}
+					reached[to] = struct{}{}
+					queue = append(queue, to)
+				}
+			}
+		}
+	}
+
+	for _, n := range nl.Nodes {
+		if n.Id == "" {
+			continue
+		}
+		if _, ok := reached[n.Id]; !ok {
+			add(SeverityWarning, IssueUnreachableNode, fmt.Sprintf("node %q can't be reached from any root element", n.Id), n.Id)
+		}
+	}
+
+	return issues
+}
@@ -0,0 +1,199 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeListValidate(t *testing.T) {
+	for m, tc := range map[string]struct {
+		sut       *NodeList
+		expected  []IssueCode
+		hasErrors bool
+	}{
+		"well formed nodelist": {
+			sut: &NodeList{
+				Nodes: []*Node{{Id: "node1"}, {Id: "node2"}, {Id: "node3"}},
+				Edges: []*Edge{
+					{Type: Edge_contains, From: "node1", To: []string{"node2"}},
+					{Type: Edge_dependsOn, From: "node2", To: []string{"node3"}},
+				},
+				RootElements: []string{"node1"},
+			},
+			expected: []IssueCode{},
+		},
+		"empty nodelist": {
+			sut:      &NodeList{},
+			expected: []IssueCode{},
+		},
+		"duplicate and empty ids": {
+			sut: &NodeList{
+				Nodes:        []*Node{{Id: "node1"}, {Id: "node1"}, {Id: ""}},
+				RootElements: []string{"node1"},
+			},
+			expected:  []IssueCode{IssueDuplicateNodeID, IssueEmptyNodeID},
+			hasErrors: true,
+		},
+		"no root elements": {
+			sut: &NodeList{
+				Nodes: []*Node{{Id: "node1"}},
+			},
+			expected: []IssueCode{IssueNoRootElements},
+		},
+		"missing and duplicate root elements": {
+			sut: &NodeList{
+				Nodes:        []*Node{{Id: "node1"}},
+				RootElements: []string{"node1", "node1", "node2"},
+			},
+			expected:  []IssueCode{IssueDuplicateRootElement, IssueMissingRootElement},
+			hasErrors: true,
+		},
+		"dangling edges": {
+			sut: &NodeList{
+				Nodes: []*Node{{Id: "node1"}, {Id: "node2"}},
+				Edges: []*Edge{
+					{Type: Edge_contains, From: "node1", To: []string{"node2", "node3"}},
+					{Type: Edge_contains, From: "node4", To: []string{"node2"}},
+				},
+				RootElements: []string{"node1"},
+			},
+			expected:  []IssueCode{IssueDanglingEdgeTo, IssueDanglingEdgeFrom},
+			hasErrors: true,
+		},
+		"suspicious edges": {
+			sut: &NodeList{
+				Nodes: []*Node{{Id: "node1"}, {Id: "node2"}},
+				Edges: []*Edge{
+					{Type: Edge_UNKNOWN, From: "node1", To: []string{"node2"}},
+					{Type: Edge_contains, From: "node2", To: []string{}},
+					{Type: Edge_dependsOn, From: "node2", To: []string{"node2"}},
+				},
+				RootElements: []string{"node1"},
+			},
+			expected: []IssueCode{IssueUnknownEdgeType, IssueEmptyEdge, IssueSelfReference},
+		},
+		"unreachable nodes": {
+			sut: &NodeList{
+				Nodes: []*Node{{Id: "node1"}, {Id: "node2"}, {Id: "node3"}},
+				Edges: []*Edge{
+					{Type: Edge_contains, From: "node2", To: []string{"node3"}},
+				},
+				RootElements: []string{"node1"},
+			},
+			expected: []IssueCode{IssueUnreachableNode, IssueUnreachableNode},
+		},
+		"snippets": {
+			sut: &NodeList{
+				Nodes: []*Node{
+					{Id: "file1", Type: Node_FILE},
+					{Id: "snippet1", Type: Node_SNIPPET, ByteRange: &SnippetRange{Start: 1, End: 10}},
+					{Id: "snippet2", Type: Node_SNIPPET, LineRange: &SnippetRange{Start: 5, End: 2}},
+				},
+				Edges: []*Edge{
+					{Type: Edge_contains, From: "file1", To: []string{"snippet1", "snippet2"}},
+				},
+				RootElements: []string{"file1"},
+			},
+			expected: []IssueCode{IssueInvalidSnippetRange},
+		},
+		"snippet without file": {
+			sut: &NodeList{
+				Nodes: []*Node{
+					{Id: "package1"},
+					{Id: "snippet1", Type: Node_SNIPPET},
+				},
+				Edges: []*Edge{
+					{Type: Edge_contains, From: "package1", To: []string{"snippet1"}},
+				},
+				RootElements: []string{"package1"},
+			},
+			expected: []IssueCode{IssueSnippetWithoutFile},
+		},
+	} {
+		t.Run(m, func(t *testing.T) {
+			issues := tc.sut.Validate()
+			codes := []IssueCode{}
+			for _, i := range issues {
+				codes = append(codes, i.Code)
+			}
+			require.Equal(t, tc.expected, codes)
+			require.Equal(t, tc.hasErrors, issues.HasErrors())
+			if tc.hasErrors {
+				err := issues.Err()
+				require.Error(t, err)
+				ierr := &IntegrityError{}
+				require.ErrorAs(t, err, &ierr)
+				require.NotEmpty(t, ierr.Issues)
+				for _, i := range ierr.Issues {
+					require.Equal(t, SeverityError, i.Severity)
+				}
+			} else {
+				require.NoError(t, issues.Err())
+			}
+		})
+	}
+}
+
+func TestDocumentValidate(t *testing.T) {
+	for m, tc := range map[string]struct {
+		sut      *Document
+		expected []IssueCode
+	}{
+		"new document": {
+			sut:      NewDocument(),
+			expected: []IssueCode{IssueMissingDocumentID},
+		},
+		"no metadata or nodelist": {
+			sut:      &Document{},
+			expected: []IssueCode{IssueMissingMetadata, IssueMissingNodeList},
+		},
+		"nodelist issues": {
+			sut: &Document{
+				Metadata: &Metadata{Id: "doc"},
+				NodeList: &NodeList{
+					Nodes:        []*Node{{Id: "node1"}},
+					RootElements: []string{"node2"},
+				},
+			},
+			expected: []IssueCode{IssueMissingRootElement, IssueUnreachableNode},
+		},
+		"dangling vulnerability": {
+			sut: &Document{
+				Metadata: &Metadata{Id: "doc"},
+				NodeList: &NodeList{
+					Nodes:        []*Node{{Id: "node1"}},
+					RootElements: []string{"node1"},
+				},
+				Vulnerabilities: []*Vulnerability{
+					{Id: "CVE-2021-44228", Affects: []string{"node1", "node2"}},
+				},
+			},
+			expected: []IssueCode{IssueDanglingVulnerabilityRef},
+		},
+		"external edge targets": {
+			sut: &Document{
+				Metadata: &Metadata{
+					Id:                         "doc",
+					ExternalDocumentReferences: []*ExternalDocumentReference{{Id: "DocumentRef-lib", Uri: "https://example.com/lib"}},
+				},
+				NodeList: &NodeList{
+					Nodes: []*Node{{Id: "node1"}},
+					Edges: []*Edge{
+						{Type: Edge_dependsOn, From: "node1", To: []string{"DocumentRef-lib:Package-a", "DocumentRef-other:Package-b"}},
+					},
+					RootElements: []string{"node1"},
+				},
+			},
+			expected: []IssueCode{IssueUnknownExternalDocument},
+		},
+	} {
+		t.Run(m, func(t *testing.T) {
+			codes := []IssueCode{}
+			for _, i := range tc.sut.Validate() {
+				codes = append(codes, i.Code)
+			}
+			require.Equal(t, tc.expected, codes)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package sbom
+
+import (
+	"slices"
+	"strings"
+)
+
+// AffectsNode returns true if the vulnerability lists the node ID among the
+// nodes it affects.
+func (v *Vulnerability) AffectsNode(id string) bool {
+	return slices.Contains(v.GetAffects(), id)
+}
+
+// HasID returns true if the vulnerability is known by the identifier, either
+// as its ID or one of its aliases. The comparison is case insensitive.
+func (v *Vulnerability) HasID(id string) bool {
+	if strings.EqualFold(v.GetId(), id) {
+		return true
+	}
+	for _, a := range v.GetAliases() {
+		if strings.EqualFold(a, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// HighestRating returns the rating of the vulnerability with the highest
+// severity. When several ratings have the same severity, the one with the
+// highest score is returned. Returns nil if the vulnerability has no ratings.
+func (v *Vulnerability) HighestRating() *VulnerabilityRating {
+	var ret *VulnerabilityRating
+	for _, r := range v.GetRatings() {
+		switch {
+		case ret == nil,
+			r.GetSeverity() > ret.GetSeverity(),
+			r.GetSeverity() == ret.GetSeverity() && r.GetScore() > ret.GetScore():
+			ret = r
+		}
+	}
+	return ret
+}
+
+// GetVulnerabilitiesByID returns the vulnerabilities of the document known
+// by the identifier, either as their ID or as an alias.
+func (d *Document) GetVulnerabilitiesByID(id string) []*Vulnerability {
+	ret := []*Vulnerability{}
+	for _, v := range d.GetVulnerabilities() {
+		if v.HasID(id) {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+// GetNodeVulnerabilities returns the vulnerabilities of the document that
+// affect the node with the specified ID.
+func (d *Document) GetNodeVulnerabilities(id string) []*Vulnerability {
+	ret := []*Vulnerability{}
+	for _, v := range d.GetVulnerabilities() {
+		if v.AffectsNode(id) {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+// AddVulnerability adds a vulnerability to the document
+func (d *Document) AddVulnerability(v *Vulnerability) {
+	d.Vulnerabilities = append(d.Vulnerabilities, v)
+}
@@ -0,0 +1,56 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVulnerabilityHighestRating(t *testing.T) {
+	for m, tc := range map[string]struct {
+		sut      *Vulnerability
+		expected int
+	}{
+		"no ratings": {sut: &Vulnerability{}, expected: -1},
+		"by severity": {
+			sut: &Vulnerability{Ratings: []*VulnerabilityRating{
+				{Severity: VulnerabilityRating_MEDIUM, Score: 6.5},
+				{Severity: VulnerabilityRating_CRITICAL, Score: 9.8},
+				{Severity: VulnerabilityRating_HIGH, Score: 7.5},
+			}},
+			expected: 1,
+		},
+		"same severity": {
+			sut: &Vulnerability{Ratings: []*VulnerabilityRating{
+				{Severity: VulnerabilityRating_HIGH, Score: 7.1},
+				{Severity: VulnerabilityRating_HIGH, Score: 8.2},
+			}},
+			expected: 1,
+		},
+	} {
+		t.Run(m, func(t *testing.T) {
+			r := tc.sut.HighestRating()
+			if tc.expected == -1 {
+				require.Nil(t, r)
+				return
+			}
+			require.Same(t, tc.sut.Ratings[tc.expected], r)
+		})
+	}
+}
+
+func TestDocumentVulnerabilities(t *testing.T) {
+	doc := NewDocument()
+	doc.AddVulnerability(&Vulnerability{
+		Id: "GHSA-jfh8-c2jp-5v3q", Aliases: []string{"CVE-2021-44228"}, Affects: []string{"log4j"},
+	})
+	doc.AddVulnerability(&Vulnerability{Id: "CVE-2022-22965", Affects: []string{"spring", "app"}})
+
+	require.Len(t, doc.GetVulnerabilitiesByID("cve-2021-44228"), 1)
+	require.Len(t, doc.GetVulnerabilitiesByID("GHSA-jfh8-c2jp-5v3q"), 1)
+	require.Empty(t, doc.GetVulnerabilitiesByID("CVE-2000-0001"))
+
+	require.Len(t, doc.GetNodeVulnerabilities("app"), 1)
+	require.Equal(t, "CVE-2022-22965", doc.GetNodeVulnerabilities("spring")[0].Id)
+	require.Empty(t, doc.GetNodeVulnerabilities("other"))
+}
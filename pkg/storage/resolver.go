@@ -0,0 +1,230 @@
+// --------------------------------------------------------------
+// SPDX-FileCopyrightText: Copyright © 2024 The Protobom Authors
+// SPDX-FileType: SOURCE
+// SPDX-License-Identifier: Apache-2.0
+// --------------------------------------------------------------
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// spdxDocumentID is the SPDX identifier appended to the namespace of SPDX
+// documents to build their protobom document ID.
+const spdxDocumentID = "DOCUMENT"
+
+// ResolverOptions control how the resolver retrieves external documents
+type ResolverOptions struct {
+	// IgnoreMissing keeps the references to documents that cannot be
+	// retrieved instead of returning an error.
+	IgnoreMissing bool
+
+	// RetrieveOptions are passed to the retriever when fetching the
+	// referenced documents.
+	RetrieveOptions *RetrieveOptions
+
+	// DocumentID returns the ID used to retrieve a referenced document
+	// from storage. Defaults to DefaultExternalDocumentID.
+	DocumentID func(*sbom.ExternalDocumentReference) string
+}
+
+// Resolver stitches documents that reference nodes in other documents into
+// a single graph, fetching the referenced documents from a Retriever.
+type Resolver struct {
+	Retriever Retriever
+	Options   ResolverOptions
+}
+
+// NewResolver returns a resolver that fetches documents from the retriever
+func NewResolver(r Retriever) *Resolver {
+	return &Resolver{
+		Retriever: r,
+		Options: ResolverOptions{
+			DocumentID: DefaultExternalDocumentID,
+		},
+	}
+}
+
+// DefaultExternalDocumentID returns the storage ID of an external document.
+// URIs without a fragment are considered SPDX namespaces and get the document
+// SPDX identifier appended as the SPDX unserializer does to build document IDs.
+func DefaultExternalDocumentID(ref *sbom.ExternalDocumentReference) string {
+	if strings.Contains(ref.GetUri(), "#") {
+		return ref.GetUri()
+	}
+	return ref.GetUri() + "#" + spdxDocumentID
+}
+
+// Resolve returns a copy of the document with the nodes and edges of all the
+// documents it references, directly or through other documents, merged into
+// its NodeList. The nodes of the external documents keep the IDs used to
+// reference them (DocumentRef-ID:NodeID) so the edges that pointed to them
+// now point to nodes in the same document. Documents are retrieved only once
+// even if they are referenced more than once.
+func (r *Resolver) Resolve(doc *sbom.Document) (*sbom.Document, error) {
+	if doc == nil || doc.GetNodeList() == nil {
+		return nil, errors.New("unable to resolve references, document has no nodelist")
+	}
+	if r.Retriever == nil {
+		return nil, errors.New("unable to resolve references, no retriever set")
+	}
+
+	ret := proto.Clone(doc).(*sbom.Document) //nolint:forcetypeassert
+	state := &resolverState{
+		root:       doc.GetMetadata().GetId(),
+		documentID: r.documentID,
+		names:      map[string]string{},
+		used:       map[string]struct{}{},
+	}
+	for _, ref := range ret.GetMetadata().GetExternalDocumentReferences() {
+		state.name(ref)
+	}
+
+	unresolved := []*sbom.ExternalDocumentReference{}
+	queue := ret.GetMetadata().GetExternalDocumentReferences()
+	visited := map[string]struct{}{}
+	for len(queue) > 0 {
+		ref := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[ref.GetUri()]; ok {
+			continue
+		}
+		visited[ref.GetUri()] = struct{}{}
+
+		// References back to the document being resolved point to its nodes
+		if state.isRoot(ref) {
+			continue
+		}
+
+		ext, err := r.retrieve(ref)
+		if err != nil {
+			if !r.Options.IgnoreMissing {
+				return nil, err
+			}
+			unresolved = append(unresolved, ref)
+			continue
+		}
+
+		state.merge(ret.NodeList, ext, state.names[ref.GetUri()])
+		queue = append(queue, ext.GetMetadata().GetExternalDocumentReferences()...)
+	}
+
+	// Only the references of the root document that could not be
+	// retrieved are kept in the metadata.
+	if ret.Metadata != nil {
+		kept := []*sbom.ExternalDocumentReference{}
+		for _, ref := range unresolved {
+			if ref.GetId() == state.names[ref.GetUri()] {
+				kept = append(kept, ref)
+				continue
+			}
+			kept = append(kept, &sbom.ExternalDocumentReference{
+				Id: state.names[ref.GetUri()], Uri: ref.GetUri(), Hashes: ref.GetHashes(),
+			})
+		}
+		ret.Metadata.ExternalDocumentReferences = kept
+	}
+	return ret, nil
+}
+
+// documentID returns the storage ID of the document of an external reference
+func (r *Resolver) documentID(ref *sbom.ExternalDocumentReference) string {
+	if r.Options.DocumentID == nil {
+		return DefaultExternalDocumentID(ref)
+	}
+	return r.Options.DocumentID(ref)
+}
+
+// retrieve fetches the document of an external reference
+func (r *Resolver) retrieve(ref *sbom.ExternalDocumentReference) (*sbom.Document, error) {
+	id := r.documentID(ref)
+	ext, err := r.Retriever.Retrieve(id, r.Options.RetrieveOptions)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving external document %q: %w", id, err)
+	}
+	if ext == nil || ext.GetNodeList() == nil {
+		return nil, fmt.Errorf("retrieving external document %q: document not found", id)
+	}
+	return ext, nil
+}
+
+// resolverState tracks the reference IDs assigned to the external documents
+// in the resolved document, keyed by document URI.
+type resolverState struct {
+	root       string
+	documentID func(*sbom.ExternalDocumentReference) string
+	names      map[string]string
+	used       map[string]struct{}
+}
+
+// isRoot returns true if the reference points to the document being resolved
+func (s *resolverState) isRoot(ref *sbom.ExternalDocumentReference) bool {
+	return s.root != "" && s.documentID(ref) == s.root
+}
+
+// name returns the reference ID of the external document in the resolved
+// document. Documents keep the ID of their first reference unless it is
+// already taken by another document.
+func (s *resolverState) name(ref *sbom.ExternalDocumentReference) string {
+	if name, ok := s.names[ref.GetUri()]; ok {
+		return name
+	}
+	name := ref.GetId()
+	for i := 2; ; i++ {
+		if _, ok := s.used[name]; !ok {
+			break
+		}
+		name = fmt.Sprintf("%s-%d", ref.GetId(), i)
+	}
+	s.names[ref.GetUri()] = name
+	s.used[name] = struct{}{}
+	return name
+}
+
+// merge adds the nodes and edges of the external document to the nodelist
+// with their IDs qualified with the reference ID of the document.
+func (s *resolverState) merge(nl *sbom.NodeList, ext *sbom.Document, name string) {
+	// Map the reference IDs of the external document to the resolved ones.
+	// Nodes of the root document are not qualified.
+	refs := map[string]string{}
+	for _, ref := range ext.GetMetadata().GetExternalDocumentReferences() {
+		if s.isRoot(ref) {
+			refs[ref.GetId()] = ""
+			continue
+		}
+		refs[ref.GetId()] = s.name(ref)
+	}
+	qualify := func(id string) string {
+		if docRef, nodeID, ok := sbom.ParseExternalNodeID(id); ok {
+			if resolved, ok := refs[docRef]; ok {
+				if resolved == "" {
+					return nodeID
+				}
+				return sbom.ExternalNodeID(resolved, nodeID)
+			}
+		}
+		return sbom.ExternalNodeID(name, id)
+	}
+
+	for _, n := range ext.GetNodeList().GetNodes() {
+		nn := n.Copy()
+		nn.Id = qualify(n.GetId())
+		nl.AddNode(nn)
+	}
+	for _, e := range ext.GetNodeList().GetEdges() {
+		ne := e.Copy()
+		ne.From = qualify(e.GetFrom())
+		ne.To = []string{}
+		for _, to := range e.GetTo() {
+			ne.To = append(ne.To, qualify(to))
+		}
+		nl.AddEdge(ne)
+	}
+}
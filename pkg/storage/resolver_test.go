@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/protobom/protobom/pkg/sbom"
+	"github.com/stretchr/testify/require"
+)
+
+// mapRetriever returns the documents stored in a map keyed by document ID
+type mapRetriever map[string]*sbom.Document
+
+func (m mapRetriever) Retrieve(id string, _ *RetrieveOptions) (*sbom.Document, error) {
+	doc, ok := m[id]
+	if !ok {
+		return nil, errors.New("document not found")
+	}
+	return doc, nil
+}
+
+// resolverTestDocument returns a document with a root node depending on the
+// targets and declaring references to the URIs in refs, keyed by reference ID.
+func resolverTestDocument(uri, nodeID string, refs map[string]string, targets ...string) *sbom.Document {
+	doc := sbom.NewDocument()
+	doc.Metadata.Id = uri + "#DOCUMENT"
+	for id, refURI := range refs {
+		doc.Metadata.ExternalDocumentReferences = append(
+			doc.Metadata.ExternalDocumentReferences,
+			&sbom.ExternalDocumentReference{Id: id, Uri: refURI},
+		)
+	}
+	doc.NodeList.AddRootNode(&sbom.Node{Id: nodeID, Name: nodeID})
+	if len(targets) > 0 {
+		doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: nodeID, To: targets})
+	}
+	return doc
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name          string
+		doc           *sbom.Document
+		store         mapRetriever
+		ignoreMissing bool
+		shouldErr     bool
+		expectedNodes []string
+		expectedRefs  []string
+	}{
+		{
+			name: "direct reference",
+			doc: resolverTestDocument(
+				"https://example.com/app", "app",
+				map[string]string{"DocumentRef-lib": "https://example.com/lib"},
+				"DocumentRef-lib:lib",
+			),
+			store: mapRetriever{
+				"https://example.com/lib#DOCUMENT": resolverTestDocument("https://example.com/lib", "lib", nil),
+			},
+			expectedNodes: []string{"app", "DocumentRef-lib:lib"},
+			expectedRefs:  []string{},
+		},
+		{
+			name: "transitive reference",
+			doc: resolverTestDocument(
+				"https://example.com/app", "app",
+				map[string]string{"DocumentRef-lib": "https://example.com/lib"},
+				"DocumentRef-lib:lib",
+			),
+			store: mapRetriever{
+				"https://example.com/lib#DOCUMENT": resolverTestDocument(
+					"https://example.com/lib", "lib",
+					map[string]string{"DocumentRef-base": "https://example.com/base"},
+					"DocumentRef-base:base",
+				),
+				"https://example.com/base#DOCUMENT": resolverTestDocument("https://example.com/base", "base", nil),
+			},
+			expectedNodes: []string{"app", "DocumentRef-lib:lib", "DocumentRef-base:base"},
+			expectedRefs:  []string{},
+		},
+		{
+			name: "reference back to the root document",
+			doc: resolverTestDocument(
+				"https://example.com/app", "app",
+				map[string]string{"DocumentRef-lib": "https://example.com/lib"},
+				"DocumentRef-lib:lib",
+			),
+			store: mapRetriever{
+				"https://example.com/lib#DOCUMENT": resolverTestDocument(
+					"https://example.com/lib", "lib",
+					map[string]string{"DocumentRef-app": "https://example.com/app"},
+					"DocumentRef-app:app",
+				),
+			},
+			expectedNodes: []string{"app", "DocumentRef-lib:lib"},
+			expectedRefs:  []string{},
+		},
+		{
+			name: "reference ID collision",
+			doc: resolverTestDocument(
+				"https://example.com/app", "app",
+				map[string]string{"DocumentRef-lib": "https://example.com/lib"},
+				"DocumentRef-lib:lib",
+			),
+			store: mapRetriever{
+				"https://example.com/lib#DOCUMENT": resolverTestDocument(
+					"https://example.com/lib", "lib",
+					map[string]string{"DocumentRef-lib": "https://example.com/other"},
+					"DocumentRef-lib:other",
+				),
+				"https://example.com/other#DOCUMENT": resolverTestDocument("https://example.com/other", "other", nil),
+			},
+			expectedNodes: []string{"app", "DocumentRef-lib:lib", "DocumentRef-lib-2:other"},
+			expectedRefs:  []string{},
+		},
+		{
+			name: "missing document",
+			doc: resolverTestDocument(
+				"https://example.com/app", "app",
+				map[string]string{"DocumentRef-lib": "https://example.com/lib"},
+				"DocumentRef-lib:lib",
+			),
+			store:     mapRetriever{},
+			shouldErr: true,
+		},
+		{
+			name: "missing document ignored",
+			doc: resolverTestDocument(
+				"https://example.com/app", "app",
+				map[string]string{"DocumentRef-lib": "https://example.com/lib"},
+				"DocumentRef-lib:lib",
+			),
+			store:         mapRetriever{},
+			ignoreMissing: true,
+			expectedNodes: []string{"app"},
+			expectedRefs:  []string{"DocumentRef-lib"},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			r := NewResolver(tc.store)
+			r.Options.IgnoreMissing = tc.ignoreMissing
+
+			resolved, err := r.Resolve(tc.doc)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			nodes := []string{}
+			for _, n := range resolved.NodeList.Nodes {
+				nodes = append(nodes, n.Id)
+			}
+			require.ElementsMatch(t, tc.expectedNodes, nodes)
+
+			refs := []string{}
+			for _, ref := range resolved.Metadata.ExternalDocumentReferences {
+				refs = append(refs, ref.Id)
+			}
+			require.Equal(t, tc.expectedRefs, refs)
+			require.Empty(t, resolved.Validate().WithSeverity(sbom.SeverityError))
+
+			// The original document is not modified
+			require.Len(t, tc.doc.NodeList.Nodes, 1)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vers
+
+import (
+	"strings"
+)
+
+// debianVersion is a parsed [epoch:]upstream[-revision] version
+type debianVersion struct {
+	epoch    string
+	upstream string
+	revision string
+}
+
+// parseDebian splits a Debian version into its parts
+func parseDebian(v string) (*debianVersion, error) {
+	s := strings.TrimSpace(v)
+	ret := &debianVersion{epoch: "0"}
+
+	if epoch, rest, ok := strings.Cut(s, ":"); ok {
+		if !isDigits(epoch) {
+			return nil, invalidVersion("debian", v)
+		}
+		ret.epoch, s = epoch, rest
+	}
+
+	// The revision starts after the last hyphen
+	if i := strings.LastIndex(s, "-"); i != -1 {
+		ret.revision = s[i+1:]
+		s = s[:i]
+	}
+	ret.upstream = s
+
+	if ret.upstream == "" || !isDigit(ret.upstream[0]) {
+		return nil, invalidVersion("debian", v)
+	}
+	for _, part := range []string{ret.upstream, ret.revision} {
+		for i := 0; i < len(part); i++ {
+			c := part[i]
+			if !isDigit(c) && !isAlpha(c) && !strings.ContainsRune(".+-~:", rune(c)) {
+				return nil, invalidVersion("debian", v)
+			}
+		}
+	}
+	return ret, nil
+}
+
+// debianOrder returns the sort weight of a character in the non digit parts
+// of a version: the tilde sorts before anything, even the end of the part,
+// then letters and then all other characters.
+func debianOrder(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	c := s[i]
+	switch {
+	case c == '~':
+		return -1
+	case isDigit(c):
+		return 0
+	case isAlpha(c):
+		return int(c)
+	default:
+		return int(c) + 256
+	}
+}
+
+// verrevcmp compares two upstream versions or revisions as dpkg does,
+// alternating non digit and digit parts.
+func verrevcmp(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		firstDiff := 0
+		for (i < len(a) && !isDigit(a[i])) || (j < len(b) && !isDigit(b[j])) {
+			ac, bc := debianOrder(a, i), debianOrder(b, j)
+			if ac != bc {
+				return cmpInt(ac, bc)
+			}
+			i++
+			j++
+		}
+
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+		for i < len(a) && isDigit(a[i]) && j < len(b) && isDigit(b[j]) {
+			if firstDiff == 0 {
+				firstDiff = cmpInt(int(a[i]), int(b[j]))
+			}
+			i++
+			j++
+		}
+		if i < len(a) && isDigit(a[i]) {
+			return 1
+		}
+		if j < len(b) && isDigit(b[j]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return firstDiff
+		}
+	}
+	return 0
+}
+
+// compareDebian compares two Debian package versions
+func compareDebian(a, b string) (int, error) {
+	va, err := parseDebian(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseDebian(b)
+	if err != nil {
+		return 0, err
+	}
+
+	if c := cmpNumeric(va.epoch, vb.epoch); c != 0 {
+		return c, nil
+	}
+	if c := verrevcmp(va.upstream, vb.upstream); c != 0 {
+		return c, nil
+	}
+	return verrevcmp(va.revision, vb.revision), nil
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vers
+
+import (
+	"strings"
+)
+
+// genericSegments splits a version in runs of digits and runs of letters.
+// Any other character is a separator.
+func genericSegments(v string) []string {
+	v = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(v), "v"))
+	segments := []string{}
+	start := -1
+	for i := 0; i <= len(v); i++ {
+		if start != -1 && (i == len(v) || isDigit(v[i]) != isDigit(v[start]) || !(isDigit(v[i]) || isAlpha(v[i]))) {
+			segments = append(segments, v[start:i])
+			start = -1
+		}
+		if i < len(v) && start == -1 && (isDigit(v[i]) || isAlpha(v[i])) {
+			start = i
+		}
+	}
+	return segments
+}
+
+// compareGeneric compares versions segment by segment. Numbers are compared
+// numerically, letters lexically and numbers sort after letters. When all the
+// common segments are equal, extra numbers make a version newer (1.0.1 >
+// 1.0) while extra letters mark a prerelease (1.0rc1 < 1.0).
+func compareGeneric(a, b string) (int, error) {
+	sa, sb := genericSegments(a), genericSegments(b)
+	if len(sa) == 0 {
+		return 0, invalidVersion("generic", a)
+	}
+	if len(sb) == 0 {
+		return 0, invalidVersion("generic", b)
+	}
+
+	for i := 0; i < len(sa) && i < len(sb); i++ {
+		na, nb := isDigit(sa[i][0]), isDigit(sb[i][0])
+		var c int
+		switch {
+		case na && nb:
+			c = cmpNumeric(sa[i], sb[i])
+		case na:
+			c = 1
+		case nb:
+			c = -1
+		default:
+			c = strings.Compare(sa[i], sb[i])
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+
+	// A trailing letter segment marks a prerelease: 1.0 > 1.0rc1
+	switch {
+	case len(sa) > len(sb) && !isDigit(sa[len(sb)][0]):
+		return -1, nil
+	case len(sb) > len(sa) && !isDigit(sb[len(sa)][0]):
+		return 1, nil
+	}
+	return cmpInt(len(sa), len(sb)), nil
+}
@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vers
+
+import (
+	"strconv"
+	"strings"
+)
+
+// This file implements the version ordering of Maven's ComparableVersion.
+// Versions are split in a tree of items: numbers, qualifiers and sublists
+// started by a hyphen or a transition between digits and letters.
+
+// mavenQualifiers are the well known qualifiers in ascending order. The
+// empty string is the release version.
+var mavenQualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+
+// mavenAliases normalizes qualifier aliases
+var mavenAliases = map[string]string{
+	"ga":      "",
+	"final":   "",
+	"release": "",
+	"cr":      "rc",
+}
+
+type mavenItemKind int
+
+const (
+	mavenInt mavenItemKind = iota
+	mavenString
+	mavenList
+)
+
+// mavenItem is a node of the parsed version tree
+type mavenItem struct {
+	kind  mavenItemKind
+	value string
+	items []*mavenItem
+}
+
+// isNull returns true for items equivalent to nothing (0, release or an
+// empty list) which are trimmed from the end of lists.
+func (i *mavenItem) isNull() bool {
+	switch i.kind {
+	case mavenInt:
+		return strings.TrimLeft(i.value, "0") == ""
+	case mavenString:
+		return i.value == ""
+	default:
+		return len(i.items) == 0
+	}
+}
+
+// newMavenString creates a qualifier item, expanding the single letter
+// aliases when they are followed by a number.
+func newMavenString(value string, followedByDigit bool) *mavenItem {
+	if followedByDigit && len(value) == 1 {
+		switch value {
+		case "a":
+			value = "alpha"
+		case "b":
+			value = "beta"
+		case "m":
+			value = "milestone"
+		}
+	}
+	if alias, ok := mavenAliases[value]; ok {
+		value = alias
+	}
+	return &mavenItem{kind: mavenString, value: value}
+}
+
+// comparableQualifier returns a string that sorts qualifiers in the order
+// Maven uses: known qualifiers first, then unknown ones lexically.
+func comparableQualifier(q string) string {
+	for i, known := range mavenQualifiers {
+		if q == known {
+			return strconv.Itoa(i)
+		}
+	}
+	return strconv.Itoa(len(mavenQualifiers)) + "-" + q
+}
+
+// parseMavenItem creates an item from a version token
+func parseMavenItem(isDigit bool, token string) *mavenItem {
+	if isDigit {
+		return &mavenItem{kind: mavenInt, value: token}
+	}
+	return newMavenString(token, false)
+}
+
+// normalize trims the null items at the end of the list
+func (i *mavenItem) normalize() {
+	for j := len(i.items) - 1; j >= 0; j-- {
+		last := i.items[j]
+		if last.isNull() {
+			i.items = append(i.items[:j], i.items[j+1:]...)
+		} else if last.kind != mavenList {
+			break
+		}
+	}
+}
+
+// parseMaven parses a version into its item tree
+func parseMaven(version string) *mavenItem {
+	version = strings.ToLower(strings.TrimSpace(version))
+	root := &mavenItem{kind: mavenList}
+	list := root
+	stack := []*mavenItem{root}
+
+	newList := func() {
+		l := &mavenItem{kind: mavenList}
+		list.items = append(list.items, l)
+		list = l
+		stack = append(stack, l)
+	}
+
+	isDigit := false
+	start := 0
+	for i := 0; i < len(version); i++ {
+		c := version[i]
+		switch {
+		case c == '.':
+			if i == start {
+				list.items = append(list.items, &mavenItem{kind: mavenInt, value: "0"})
+			} else {
+				list.items = append(list.items, parseMavenItem(isDigit, version[start:i]))
+			}
+			start = i + 1
+		case c == '-':
+			if i == start {
+				list.items = append(list.items, &mavenItem{kind: mavenInt, value: "0"})
+			} else {
+				list.items = append(list.items, parseMavenItem(isDigit, version[start:i]))
+			}
+			start = i + 1
+			newList()
+		case c >= '0' && c <= '9':
+			if !isDigit && i > start {
+				list.items = append(list.items, newMavenString(version[start:i], true))
+				start = i
+				newList()
+			}
+			isDigit = true
+		default:
+			if isDigit && i > start {
+				list.items = append(list.items, parseMavenItem(true, version[start:i]))
+				start = i
+				newList()
+			}
+			isDigit = false
+		}
+	}
+	if len(version) > start {
+		list.items = append(list.items, parseMavenItem(isDigit, version[start:]))
+	}
+
+	for j := len(stack) - 1; j >= 0; j-- {
+		stack[j].normalize()
+	}
+	return root
+}
+
+// compareTo compares the item with another one, other may be nil
+func (i *mavenItem) compareTo(other *mavenItem) int {
+	switch i.kind {
+	case mavenInt:
+		if other == nil {
+			if i.isNull() {
+				return 0
+			}
+			return 1
+		}
+		switch other.kind {
+		case mavenInt:
+			return cmpNumeric(i.value, other.value)
+		default:
+			// 1.1 > 1-sp and 1.1 > 1-1
+			return 1
+		}
+	case mavenString:
+		if other == nil {
+			// 1-rc < 1, 1-ga == 1, 1-sp > 1
+			return strings.Compare(comparableQualifier(i.value), comparableQualifier(""))
+		}
+		switch other.kind {
+		case mavenString:
+			return strings.Compare(comparableQualifier(i.value), comparableQualifier(other.value))
+		default:
+			// 1.any < 1.1 and 1.any < 1-1
+			return -1
+		}
+	default:
+		if other == nil {
+			if len(i.items) == 0 {
+				return 0
+			}
+			return i.items[0].compareTo(nil)
+		}
+		switch other.kind {
+		case mavenInt:
+			return -1
+		case mavenString:
+			return 1
+		}
+		for j := 0; j < len(i.items) || j < len(other.items); j++ {
+			var l, r *mavenItem
+			if j < len(i.items) {
+				l = i.items[j]
+			}
+			if j < len(other.items) {
+				r = other.items[j]
+			}
+			var c int
+			switch {
+			case l == nil && r == nil:
+				c = 0
+			case l == nil:
+				c = -r.compareTo(nil)
+			default:
+				c = l.compareTo(r)
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+}
+
+// compareMaven compares two Maven versions. Any string is a valid Maven
+// version so it never returns an error.
+func compareMaven(a, b string) (int, error) {
+	return parseMaven(a).compareTo(parseMaven(b)), nil
+}
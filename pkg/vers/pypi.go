@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// pep440Pattern is the version pattern published in PEP 440 appendix B
+var pep440Pattern = regexp.MustCompile(`(?i)^v?` +
+	`(?:(?P<epoch>[0-9]+)!)?` +
+	`(?P<release>[0-9]+(?:\.[0-9]+)*)` +
+	`(?P<pre>[-_\.]?(?P<pre_l>alpha|a|beta|b|preview|pre|c|rc)[-_\.]?(?P<pre_n>[0-9]+)?)?` +
+	`(?P<post>(?:-(?P<post_n1>[0-9]+))|(?:[-_\.]?(?P<post_l>post|rev|r)[-_\.]?(?P<post_n2>[0-9]+)?))?` +
+	`(?P<dev>[-_\.]?(?P<dev_l>dev)[-_\.]?(?P<dev_n>[0-9]+)?)?` +
+	`(?:\+(?P<local>[a-z0-9]+(?:[-_\.][a-z0-9]+)*))?$`)
+
+// pep440Bound ranks the optional parts of a version, absent parts sort
+// before or after any value depending on the part.
+type pep440Bound int
+
+const (
+	pep440Lowest pep440Bound = iota - 1
+	pep440Value
+	pep440Highest
+)
+
+// pep440Part is a comparable pre, post or dev segment
+type pep440Part struct {
+	bound pep440Bound
+	label int
+	num   string
+}
+
+func (p pep440Part) compare(o pep440Part) int {
+	if c := cmpInt(int(p.bound), int(o.bound)); c != 0 {
+		return c
+	}
+	if c := cmpInt(p.label, o.label); c != 0 {
+		return c
+	}
+	return cmpNumeric(p.num, o.num)
+}
+
+// pep440Version is the comparison key of a version
+type pep440Version struct {
+	epoch   string
+	release []string
+	pre     pep440Part
+	post    pep440Part
+	dev     pep440Part
+	local   []string
+}
+
+// parsePEP440 parses a version and computes its comparison key as the
+// packaging library does.
+func parsePEP440(v string) (*pep440Version, error) {
+	m := pep440Pattern.FindStringSubmatch(strings.TrimSpace(v))
+	if m == nil {
+		return nil, invalidVersion("pypi", v)
+	}
+	group := func(name string) string {
+		return m[pep440Pattern.SubexpIndex(name)]
+	}
+
+	ret := &pep440Version{epoch: group("epoch")}
+
+	// Trailing zeros of the release are not significant
+	ret.release = strings.Split(group("release"), ".")
+	for len(ret.release) > 1 && strings.TrimLeft(ret.release[len(ret.release)-1], "0") == "" {
+		ret.release = ret.release[:len(ret.release)-1]
+	}
+
+	// Pre-releases sort before the release. A dev release without pre or
+	// post segments sorts before any pre-release.
+	switch {
+	case group("pre") != "":
+		label := 0
+		switch strings.ToLower(group("pre_l")) {
+		case "b", "beta":
+			label = 1
+		case "c", "rc", "pre", "preview":
+			label = 2
+		}
+		ret.pre = pep440Part{bound: pep440Value, label: label, num: group("pre_n")}
+	case group("post") == "" && group("dev") != "":
+		ret.pre = pep440Part{bound: pep440Lowest}
+	default:
+		ret.pre = pep440Part{bound: pep440Highest}
+	}
+
+	ret.post = pep440Part{bound: pep440Lowest}
+	if group("post") != "" {
+		ret.post = pep440Part{bound: pep440Value, num: group("post_n1") + group("post_n2")}
+	}
+
+	ret.dev = pep440Part{bound: pep440Highest}
+	if group("dev") != "" {
+		ret.dev = pep440Part{bound: pep440Value, num: group("dev_n")}
+	}
+
+	if local := group("local"); local != "" {
+		ret.local = strings.FieldsFunc(strings.ToLower(local), func(r rune) bool {
+			return r == '.' || r == '-' || r == '_'
+		})
+	}
+	return ret, nil
+}
+
+// comparePyPI compares two versions following PEP 440
+func comparePyPI(a, b string) (int, error) {
+	va, err := parsePEP440(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parsePEP440(b)
+	if err != nil {
+		return 0, err
+	}
+
+	if c := cmpNumeric(va.epoch, vb.epoch); c != 0 {
+		return c, nil
+	}
+
+	for i := 0; i < len(va.release) || i < len(vb.release); i++ {
+		ra, rb := "0", "0"
+		if i < len(va.release) {
+			ra = va.release[i]
+		}
+		if i < len(vb.release) {
+			rb = vb.release[i]
+		}
+		if c := cmpNumeric(ra, rb); c != 0 {
+			return c, nil
+		}
+	}
+
+	for _, parts := range [][2]pep440Part{{va.pre, vb.pre}, {va.post, vb.post}, {va.dev, vb.dev}} {
+		if c := parts[0].compare(parts[1]); c != 0 {
+			return c, nil
+		}
+	}
+
+	// Local versions sort after the public version. Numeric segments are
+	// greater than alphanumeric ones.
+	for i := 0; i < len(va.local) && i < len(vb.local); i++ {
+		la, lb := va.local[i], vb.local[i]
+		na, nb := isDigits(la), isDigits(lb)
+		var c int
+		switch {
+		case na && nb:
+			c = cmpNumeric(la, lb)
+		case na:
+			c = 1
+		case nb:
+			c = -1
+		default:
+			c = strings.Compare(la, lb)
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return cmpInt(len(va.local), len(vb.local)), nil
+}
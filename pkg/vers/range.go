@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vers
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// ErrInvalidRange is returned when a vers string cannot be parsed
+var ErrInvalidRange = errors.New("invalid version range")
+
+// Operator is the comparison of a version constraint
+type Operator string
+
+const (
+	OpEqual          Operator = "="
+	OpNotEqual       Operator = "!="
+	OpLess           Operator = "<"
+	OpLessOrEqual    Operator = "<="
+	OpGreater        Operator = ">"
+	OpGreaterOrEqual Operator = ">="
+
+	// OpAny matches all versions. It can only appear alone in a range.
+	OpAny Operator = "*"
+)
+
+// Constraint is a single version constraint in a range
+type Constraint struct {
+	Operator Operator
+	Version  string
+}
+
+// String returns the constraint in vers notation
+func (c Constraint) String() string {
+	if c.Operator == OpAny {
+		return string(OpAny)
+	}
+	op := string(c.Operator)
+	if c.Operator == OpEqual {
+		op = ""
+	}
+	return op + url.PathEscape(c.Version)
+}
+
+// Range is a version range specifier: a set of constraints on the versions
+// of a versioning scheme.
+type Range struct {
+	Scheme      string
+	Constraints []Constraint
+}
+
+// Parse reads a range in the vers syntax. For example:
+//
+//	vers:npm/1.2.3|>=2.0.0|<5.0.0
+//
+// The constraints are sorted by version when parsing.
+func Parse(s string) (*Range, error) {
+	s = strings.Join(strings.Fields(s), "")
+	scheme, ok := strings.CutPrefix(s, "vers:")
+	if !ok {
+		return nil, fmt.Errorf("%w: %q does not start with vers:", ErrInvalidRange, s)
+	}
+	scheme, constraints, ok := strings.Cut(scheme, "/")
+	if !ok || scheme == "" {
+		return nil, fmt.Errorf("%w: %q has no versioning scheme", ErrInvalidRange, s)
+	}
+
+	r := &Range{Scheme: strings.ToLower(scheme), Constraints: []Constraint{}}
+	constraints = strings.Trim(constraints, "|")
+	if constraints == "" {
+		return nil, fmt.Errorf("%w: %q has no constraints", ErrInvalidRange, s)
+	}
+
+	if constraints == string(OpAny) {
+		r.Constraints = append(r.Constraints, Constraint{Operator: OpAny})
+		return r, nil
+	}
+
+	for _, c := range strings.Split(constraints, "|") {
+		constraint, err := parseConstraint(c)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidRange, err)
+		}
+		r.Constraints = append(r.Constraints, constraint)
+	}
+
+	// Sort the constraints by version. Versions that can't be parsed are
+	// reported here instead of when testing for membership.
+	cmp := ComparatorFor(r.Scheme)
+	var sortErr error
+	sort.SliceStable(r.Constraints, func(i, j int) bool {
+		c, err := cmp.Compare(r.Constraints[i].Version, r.Constraints[j].Version)
+		if err != nil && sortErr == nil {
+			sortErr = err
+		}
+		return c < 0
+	})
+	if sortErr != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRange, sortErr)
+	}
+	if len(r.Constraints) == 1 {
+		// Check the version of single constraint ranges too
+		if _, err := cmp.Compare(r.Constraints[0].Version, r.Constraints[0].Version); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidRange, err)
+		}
+	}
+	return r, nil
+}
+
+// parseConstraint parses a single constraint
+func parseConstraint(s string) (Constraint, error) {
+	c := Constraint{Operator: OpEqual}
+	for _, op := range []Operator{OpGreaterOrEqual, OpLessOrEqual, OpNotEqual, OpLess, OpGreater, OpEqual} {
+		if rest, ok := strings.CutPrefix(s, string(op)); ok {
+			c.Operator, s = op, rest
+			break
+		}
+	}
+	if s == "" {
+		return c, fmt.Errorf("constraint has no version")
+	}
+	if s == string(OpAny) {
+		return c, fmt.Errorf("* must be the only constraint")
+	}
+	v, err := url.PathUnescape(s)
+	if err != nil {
+		return c, fmt.Errorf("decoding version %q: %w", s, err)
+	}
+	c.Version = v
+	return c, nil
+}
+
+// String returns the range in vers notation
+func (r *Range) String() string {
+	constraints := []string{}
+	for _, c := range r.Constraints {
+		constraints = append(constraints, c.String())
+	}
+	return "vers:" + r.Scheme + "/" + strings.Join(constraints, "|")
+}
+
+// Contains returns true if the version is within the range. It implements
+// the algorithm of the vers specification: equality constraints are checked
+// first, then the version is tested against the intervals formed by the
+// ordered range constraints.
+func (r *Range) Contains(version string) (bool, error) {
+	cmp := ComparatorFor(r.Scheme)
+	ranges := []Constraint{}
+	hasNotEqual := false
+	for _, c := range r.Constraints {
+		switch c.Operator {
+		case OpAny:
+			return true, nil
+		case OpEqual, OpNotEqual:
+			res, err := cmp.Compare(version, c.Version)
+			if err != nil {
+				return false, err
+			}
+			if res == 0 {
+				return c.Operator == OpEqual, nil
+			}
+			if c.Operator == OpNotEqual {
+				hasNotEqual = true
+			}
+		default:
+			ranges = append(ranges, c)
+		}
+	}
+
+	// If there are only exclusions, every other version is in the range
+	if len(ranges) == 0 {
+		return hasNotEqual, nil
+	}
+
+	results := make([]int, len(ranges))
+	for i, c := range ranges {
+		res, err := cmp.Compare(version, c.Version)
+		if err != nil {
+			return false, err
+		}
+		results[i] = res
+	}
+
+	// A range that starts with < or <= is open below
+	if first := ranges[0]; isUpperBound(first.Operator) && satisfies(first.Operator, results[0]) {
+		return true, nil
+	}
+
+	// A range that ends with > or >= is open above
+	last := len(ranges) - 1
+	if isLowerBound(ranges[last].Operator) && satisfies(ranges[last].Operator, results[last]) {
+		return true, nil
+	}
+
+	// Check the closed intervals
+	for i := 0; i < last; i++ {
+		if isLowerBound(ranges[i].Operator) && isUpperBound(ranges[i+1].Operator) &&
+			satisfies(ranges[i].Operator, results[i]) && satisfies(ranges[i+1].Operator, results[i+1]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isLowerBound returns true for the > and >= operators
+func isLowerBound(op Operator) bool {
+	return op == OpGreater || op == OpGreaterOrEqual
+}
+
+// isUpperBound returns true for the < and <= operators
+func isUpperBound(op Operator) bool {
+	return op == OpLess || op == OpLessOrEqual
+}
+
+// satisfies checks the result of comparing a version with the version of a
+// constraint against its operator.
+func satisfies(op Operator, cmp int) bool {
+	switch op {
+	case OpEqual:
+		return cmp == 0
+	case OpNotEqual:
+		return cmp != 0
+	case OpLess:
+		return cmp < 0
+	case OpLessOrEqual:
+		return cmp <= 0
+	case OpGreater:
+		return cmp > 0
+	case OpGreaterOrEqual:
+		return cmp >= 0
+	case OpAny:
+		return true
+	default:
+		return false
+	}
+}
+
+// Contains parses the vers range and checks if it contains the version
+func Contains(vers, version string) (bool, error) {
+	r, err := Parse(vers)
+	if err != nil {
+		return false, err
+	}
+	return r.Contains(version)
+}
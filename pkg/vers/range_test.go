@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		vers     string
+		expected *Range
+		str      string
+		mustErr  bool
+	}{
+		"simple": {
+			vers: "vers:npm/>=1.0.0|<2.0.0",
+			expected: &Range{Scheme: "npm", Constraints: []Constraint{
+				{Operator: OpGreaterOrEqual, Version: "1.0.0"}, {Operator: OpLess, Version: "2.0.0"},
+			}},
+			str: "vers:npm/>=1.0.0|<2.0.0",
+		},
+		"sorted": {
+			vers: "vers:maven/<2.17.1|>=2.0|2.12.4|!=2.3",
+			expected: &Range{Scheme: "maven", Constraints: []Constraint{
+				{Operator: OpGreaterOrEqual, Version: "2.0"}, {Operator: OpNotEqual, Version: "2.3"},
+				{Operator: OpEqual, Version: "2.12.4"}, {Operator: OpLess, Version: "2.17.1"},
+			}},
+			str: "vers:maven/>=2.0|!=2.3|2.12.4|<2.17.1",
+		},
+		"explicit equal and spaces": {
+			vers: "vers:PyPI / =1.0 | >2.0 ",
+			expected: &Range{Scheme: "pypi", Constraints: []Constraint{
+				{Operator: OpEqual, Version: "1.0"}, {Operator: OpGreater, Version: "2.0"},
+			}},
+			str: "vers:pypi/1.0|>2.0",
+		},
+		"escaped version": {
+			vers: "vers:deb/<=1:1.0%7Erc1",
+			expected: &Range{Scheme: "deb", Constraints: []Constraint{
+				{Operator: OpLessOrEqual, Version: "1:1.0~rc1"},
+			}},
+			str: "vers:deb/<=1:1.0~rc1",
+		},
+		"any": {
+			vers:     "vers:rpm/*",
+			expected: &Range{Scheme: "rpm", Constraints: []Constraint{{Operator: OpAny}}},
+			str:      "vers:rpm/*",
+		},
+		"no prefix":        {vers: "npm/>=1.0.0", mustErr: true},
+		"no scheme":        {vers: "vers:/>=1.0.0", mustErr: true},
+		"no slash":         {vers: "vers:npm", mustErr: true},
+		"no constraints":   {vers: "vers:npm/", mustErr: true},
+		"no version":       {vers: "vers:npm/>=", mustErr: true},
+		"any with others":  {vers: "vers:npm/*|>1.0.0", mustErr: true},
+		"invalid version":  {vers: "vers:npm/>=1.0.0|<banana", mustErr: true},
+		"invalid single":   {vers: "vers:pypi/<french toast", mustErr: true},
+		"invalid escaping": {vers: "vers:npm/>=1.0%zz", mustErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r, err := Parse(tc.vers)
+			if tc.mustErr {
+				require.Error(t, err)
+				require.ErrorIs(t, err, ErrInvalidRange)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, r)
+			require.Equal(t, tc.str, r.String())
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	for name, tc := range map[string]struct {
+		vers string
+		in   []string
+		out  []string
+	}{
+		"log4shell": {
+			vers: "vers:maven/>=2.0-beta9|<2.3.1|>=2.4|<2.12.2|>=2.13.0|<2.15.0",
+			in:   []string{"2.0-beta9", "2.0", "2.3", "2.4", "2.12.1", "2.13.0", "2.14.1"},
+			out:  []string{"1.2.17", "2.0-beta8", "2.3.1", "2.3.2", "2.12.2", "2.12.4", "2.15.0", "2.17.1"},
+		},
+		"open below": {
+			vers: "vers:npm/<1.2.3",
+			in:   []string{"0.0.1", "1.2.2", "1.2.3-rc.1"},
+			out:  []string{"1.2.3", "2.0.0"},
+		},
+		"open above": {
+			vers: "vers:npm/>=4.0.0",
+			in:   []string{"4.0.0", "10.1.0"},
+			out:  []string{"3.9.9", "4.0.0-beta.1"},
+		},
+		"open both ends": {
+			vers: "vers:pypi/<1.0|>=2.0",
+			in:   []string{"0.9", "2.0", "3.1"},
+			out:  []string{"1.0", "1.5", "2.0rc1"},
+		},
+		"equals": {
+			vers: "vers:deb/1.0-1|1:2.0",
+			in:   []string{"1.0-1", "1:2.0-0"},
+			out:  []string{"1.0-2", "2.0"},
+		},
+		"not equal in range": {
+			vers: "vers:rpm/>=1.0|!=1.5|<2.0",
+			in:   []string{"1.0", "1.4", "1.6"},
+			out:  []string{"1.5", "0.9", "2.0"},
+		},
+		"only exclusions": {
+			vers: "vers:generic/!=1.0|!=2.0",
+			in:   []string{"0.5", "1.5", "3.0"},
+			out:  []string{"1.0", "2.0"},
+		},
+		"any": {
+			vers: "vers:npm/*",
+			in:   []string{"1.0.0", "not even a version"},
+		},
+		"exclusive bounds": {
+			vers: "vers:generic/>1.0|<2.0",
+			in:   []string{"1.0.1", "1.9"},
+			out:  []string{"1.0", "2.0"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r, err := Parse(tc.vers)
+			require.NoError(t, err)
+			for _, v := range tc.in {
+				res, err := r.Contains(v)
+				require.NoError(t, err, v)
+				require.True(t, res, "%s must be in %s", v, tc.vers)
+			}
+			for _, v := range tc.out {
+				res, err := r.Contains(v)
+				require.NoError(t, err, v)
+				require.False(t, res, "%s must not be in %s", v, tc.vers)
+			}
+		})
+	}
+}
+
+func TestContainsErrors(t *testing.T) {
+	_, err := Contains("vers:npm/>=1.0.0", "banana")
+	require.ErrorIs(t, err, ErrInvalidVersion)
+
+	_, err = Contains("npm/>=1.0.0", "1.0.0")
+	require.ErrorIs(t, err, ErrInvalidRange)
+
+	res, err := Contains("vers:npm/>=1.0.0|<2.0.0", "1.5.0")
+	require.NoError(t, err)
+	require.True(t, res)
+}
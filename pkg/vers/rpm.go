@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vers
+
+import (
+	"strings"
+)
+
+// rpmVersion is a parsed [epoch:]version[-release] string
+type rpmVersion struct {
+	epoch   string
+	version string
+	release string
+}
+
+// parseRPM splits an RPM EVR string into its parts
+func parseRPM(v string) (*rpmVersion, error) {
+	s := strings.TrimSpace(v)
+	ret := &rpmVersion{epoch: "0"}
+	if epoch, rest, ok := strings.Cut(s, ":"); ok {
+		if !isDigits(epoch) {
+			return nil, invalidVersion("rpm", v)
+		}
+		ret.epoch, s = epoch, rest
+	}
+	if i := strings.LastIndex(s, "-"); i != -1 {
+		ret.release = s[i+1:]
+		s = s[:i]
+	}
+	ret.version = s
+	if ret.version == "" {
+		return nil, invalidVersion("rpm", v)
+	}
+	return ret, nil
+}
+
+// rpmvercmp compares two version or release strings using the algorithm of
+// rpm's rpmvercmp function.
+func rpmvercmp(a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	isAlnum := func(c byte) bool { return isDigit(c) || isAlpha(c) }
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for i < len(a) && !isAlnum(a[i]) && a[i] != '~' && a[i] != '^' {
+			i++
+		}
+		for j < len(b) && !isAlnum(b[j]) && b[j] != '~' && b[j] != '^' {
+			j++
+		}
+
+		// A tilde sorts before everything else
+		if (i < len(a) && a[i] == '~') || (j < len(b) && b[j] == '~') {
+			if i >= len(a) || a[i] != '~' {
+				return 1
+			}
+			if j >= len(b) || b[j] != '~' {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+
+		// A caret sorts after the end of the string but before anything else
+		if (i < len(a) && a[i] == '^') || (j < len(b) && b[j] == '^') {
+			if i >= len(a) {
+				return -1
+			}
+			if j >= len(b) {
+				return 1
+			}
+			if a[i] != '^' {
+				return 1
+			}
+			if b[j] != '^' {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+
+		if i >= len(a) || j >= len(b) {
+			break
+		}
+
+		// Grab the next segment of the same kind in both strings
+		si, sj := i, j
+		isNum := isDigit(a[i])
+		if isNum {
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+		} else {
+			for i < len(a) && isAlpha(a[i]) {
+				i++
+			}
+			for j < len(b) && isAlpha(b[j]) {
+				j++
+			}
+		}
+
+		// Segments of different types: numbers are newer
+		if sj == j {
+			if isNum {
+				return 1
+			}
+			return -1
+		}
+
+		var c int
+		if isNum {
+			c = cmpNumeric(a[si:i], b[sj:j])
+		} else {
+			c = strings.Compare(a[si:i], b[sj:j])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case i >= len(a) && j >= len(b):
+		return 0
+	case i < len(a):
+		return 1
+	default:
+		return -1
+	}
+}
+
+// compareRPM compares two RPM versions. The release is only compared when
+// both versions have one.
+func compareRPM(a, b string) (int, error) {
+	va, err := parseRPM(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseRPM(b)
+	if err != nil {
+		return 0, err
+	}
+
+	if c := cmpNumeric(va.epoch, vb.epoch); c != 0 {
+		return c, nil
+	}
+	if c := rpmvercmp(va.version, vb.version); c != 0 {
+		return c, nil
+	}
+	if va.release == "" || vb.release == "" {
+		return 0, nil
+	}
+	return rpmvercmp(va.release, vb.release), nil
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vers
+
+import (
+	"strings"
+)
+
+// semver is a parsed semantic version
+type semver struct {
+	core       [3]string
+	prerelease []string
+}
+
+// parseSemVer parses a semantic version. Build metadata is discarded as it
+// does not affect precedence.
+func parseSemVer(v string) (*semver, error) {
+	s := strings.TrimPrefix(strings.TrimSpace(v), "v")
+	s, _, _ = strings.Cut(s, "+")
+
+	ret := &semver{core: [3]string{"0", "0", "0"}}
+	core, pre, hasPre := strings.Cut(s, "-")
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 {
+		return nil, invalidVersion("semver", v)
+	}
+	for i, p := range parts {
+		if !isDigits(p) {
+			return nil, invalidVersion("semver", v)
+		}
+		ret.core[i] = p
+	}
+
+	if hasPre {
+		ret.prerelease = strings.Split(pre, ".")
+		for _, id := range ret.prerelease {
+			if id == "" {
+				return nil, invalidVersion("semver", v)
+			}
+			for i := 0; i < len(id); i++ {
+				if !isDigit(id[i]) && !isAlpha(id[i]) && id[i] != '-' {
+					return nil, invalidVersion("semver", v)
+				}
+			}
+		}
+	}
+	return ret, nil
+}
+
+// compareSemVer compares two versions using the semver precedence rules
+func compareSemVer(a, b string) (int, error) {
+	va, err := parseSemVer(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseSemVer(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range va.core {
+		if c := cmpNumeric(va.core[i], vb.core[i]); c != 0 {
+			return c, nil
+		}
+	}
+
+	// A version without prerelease has higher precedence
+	switch {
+	case len(va.prerelease) == 0 && len(vb.prerelease) == 0:
+		return 0, nil
+	case len(va.prerelease) == 0:
+		return 1, nil
+	case len(vb.prerelease) == 0:
+		return -1, nil
+	}
+
+	for i := 0; i < len(va.prerelease) && i < len(vb.prerelease); i++ {
+		ida, idb := va.prerelease[i], vb.prerelease[i]
+		na, nb := isDigits(ida), isDigits(idb)
+		var c int
+		switch {
+		case na && nb:
+			c = cmpNumeric(ida, idb)
+		case na:
+			// Numeric identifiers have lower precedence
+			c = -1
+		case nb:
+			c = 1
+		default:
+			c = strings.Compare(ida, idb)
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return cmpInt(len(va.prerelease), len(vb.prerelease)), nil
+}
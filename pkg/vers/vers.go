@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package vers compares software versions using the rules of the different
+// packaging ecosystems and evaluates version range specifiers written in the
+// vers syntax (https://github.com/package-url/purl-spec/blob/master/VERSION-RANGE-SPEC.rst),
+// for example vers:maven/>=2.0.0|<2.17.1
+package vers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidVersion is returned when a version string cannot be parsed
+// according to the rules of its versioning scheme.
+var ErrInvalidVersion = errors.New("invalid version")
+
+// Comparator compares versions of a versioning scheme
+type Comparator interface {
+	// Compare returns -1 if version a is lower than b, 0 if they are
+	// equivalent and 1 if a is greater than b.
+	Compare(a, b string) (int, error)
+}
+
+// ComparatorFunc adapts a function to the Comparator interface
+type ComparatorFunc func(a, b string) (int, error)
+
+// Compare calls the function
+func (f ComparatorFunc) Compare(a, b string) (int, error) {
+	return f(a, b)
+}
+
+// The comparators of the supported versioning schemes
+var (
+	// SemVer compares semantic versions (https://semver.org). A leading "v"
+	// and missing minor or patch numbers are accepted.
+	SemVer Comparator = ComparatorFunc(compareSemVer)
+
+	// Maven compares versions following the rules of Maven's
+	// ComparableVersion.
+	Maven Comparator = ComparatorFunc(compareMaven)
+
+	// PyPI compares Python package versions as defined in PEP 440
+	PyPI Comparator = ComparatorFunc(comparePyPI)
+
+	// Debian compares Debian package versions as dpkg does
+	Debian Comparator = ComparatorFunc(compareDebian)
+
+	// RPM compares RPM package versions as rpm does
+	RPM Comparator = ComparatorFunc(compareRPM)
+
+	// Generic compares versions splitting them in numeric and alphabetic
+	// segments. It is used when the scheme has no specific rules.
+	Generic Comparator = ComparatorFunc(compareGeneric)
+)
+
+// schemes maps the versioning schemes (mostly purl types) to their comparator
+var schemes = map[string]Comparator{
+	"semver":   SemVer,
+	"npm":      SemVer,
+	"cargo":    SemVer,
+	"golang":   SemVer,
+	"hex":      SemVer,
+	"swift":    SemVer,
+	"maven":    Maven,
+	"pypi":     PyPI,
+	"pep440":   PyPI,
+	"deb":      Debian,
+	"debian":   Debian,
+	"rpm":      RPM,
+	"generic":  Generic,
+	"alpine":   Generic,
+	"apk":      Generic,
+	"gem":      Generic,
+	"nuget":    Generic,
+	"composer": Generic,
+}
+
+// ComparatorFor returns the comparator for a versioning scheme. Scheme names
+// are the same used as purl types (npm, maven, pypi, deb, rpm, etc). Unknown
+// schemes use the Generic comparator.
+func ComparatorFor(scheme string) Comparator {
+	if c, ok := schemes[strings.ToLower(scheme)]; ok {
+		return c
+	}
+	return Generic
+}
+
+// Compare compares two versions using the rules of the versioning scheme
+func Compare(scheme, a, b string) (int, error) {
+	return ComparatorFor(scheme).Compare(a, b)
+}
+
+// invalidVersion returns an ErrInvalidVersion error for version v
+func invalidVersion(scheme, v string) error {
+	return fmt.Errorf("%w: %q is not a valid %s version", ErrInvalidVersion, v, scheme)
+}
+
+// cmpInt compares two ints
+func cmpInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// cmpNumeric compares two strings of digits of any length numerically
+func cmpNumeric(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if c := cmpInt(len(a), len(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// isDigit returns true if c is an ASCII digit
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isDigits returns true if s is a non empty string of ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isAlpha returns true if c is an ASCII letter
+func isAlpha(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
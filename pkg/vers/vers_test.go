@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// compareTestCase is a pair of versions and their expected ordering
+type compareTestCase struct {
+	a, b     string
+	expected int
+}
+
+// runCompareTests checks the comparator against the cases in both directions
+func runCompareTests(t *testing.T, cmp Comparator, cases []compareTestCase) {
+	t.Helper()
+	for _, tc := range cases {
+		res, err := cmp.Compare(tc.a, tc.b)
+		require.NoError(t, err, "%s vs %s", tc.a, tc.b)
+		require.Equal(t, tc.expected, res, "%s vs %s", tc.a, tc.b)
+
+		res, err = cmp.Compare(tc.b, tc.a)
+		require.NoError(t, err, "%s vs %s", tc.b, tc.a)
+		require.Equal(t, -tc.expected, res, "%s vs %s", tc.b, tc.a)
+	}
+}
+
+func TestSemVer(t *testing.T) {
+	runCompareTests(t, SemVer, []compareTestCase{
+		{"1.0.0", "1.0.0", 0},
+		{"1.0.0", "2.0.0", -1},
+		{"2.1.0", "2.0.9", 1},
+		{"1.10.0", "1.9.0", 1},
+		{"v1.2.3", "1.2.3", 0},
+		{"1.2", "1.2.0", 0},
+		{"1", "1.0.1", -1},
+		{"1.0.0+build.5", "1.0.0+build.1", 0},
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-alpha.beta", "1.0.0-beta", -1},
+		{"1.0.0-beta", "1.0.0-beta.2", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-beta.11", "1.0.0-rc.1", -1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		{"v0.0.0-20231010000000-abcdef123456", "v0.0.0-20240101000000-abcdef123456", -1},
+		{"18446744073709551616.0.0", "18446744073709551615.0.0", 1},
+	})
+
+	for _, v := range []string{"", "a.b.c", "1.2.3.4", "1.0.0-", "1.0.0-alpha..1", "1.0.0-al_pha"} {
+		_, err := SemVer.Compare(v, "1.0.0")
+		require.ErrorIs(t, err, ErrInvalidVersion, v)
+	}
+}
+
+func TestMaven(t *testing.T) {
+	runCompareTests(t, Maven, []compareTestCase{
+		{"1", "1.0", 0},
+		{"1.0", "1.0.0", 0},
+		{"1-ga", "1", 0},
+		{"1.0-final", "1", 0},
+		{"1-release", "1", 0},
+		{"1-cr1", "1-rc1", 0},
+		{"1-a1", "1-alpha-1", 0},
+		{"1-b2", "1-beta-2", 0},
+		{"1-m3", "1-milestone-3", 0},
+		{"1-alpha", "1-beta", -1},
+		{"1-beta", "1-milestone", -1},
+		{"1-milestone", "1-rc", -1},
+		{"1-rc", "1-snapshot", -1},
+		{"1-snapshot", "1", -1},
+		{"1", "1-sp", -1},
+		{"1-sp", "1.1", -1},
+		{"1-foo", "1-sp", 1},
+		{"1-abc", "1-def", -1},
+		{"1.1", "1-1", 1},
+		{"1-1", "1.0.1", -1},
+		{"2.0.0-alpha1", "2.0.0", -1},
+		{"2.14.1", "2.17.1", -1},
+		{"2.17.0", "2.17.1", -1},
+		{"2.0-beta9", "2.0-rc1", -1},
+		{"2.0-rc1", "2.0", -1},
+		{"1.0.0.RELEASE", "1.0.0", 0},
+		{"1.2.3-SNAPSHOT", "1.2.3", -1},
+		{"1.10", "1.9", 1},
+	})
+}
+
+func TestPyPI(t *testing.T) {
+	// Ordering example from PEP 440
+	ordered := []string{
+		"1.0.dev456", "1.0a1", "1.0a2.dev456", "1.0a12.dev456", "1.0a12",
+		"1.0b1.dev456", "1.0b2", "1.0b2.post345.dev456", "1.0b2.post345",
+		"1.0rc1.dev456", "1.0rc1", "1.0", "1.0+abc.5", "1.0+abc.7", "1.0+5",
+		"1.0.post456.dev34", "1.0.post456", "1.0.15", "1.1.dev1",
+	}
+	cases := []compareTestCase{}
+	for i := 0; i < len(ordered)-1; i++ {
+		cases = append(cases, compareTestCase{ordered[i], ordered[i+1], -1})
+	}
+	cases = append(cases,
+		compareTestCase{"1.0", "1.0.0", 0},
+		compareTestCase{"1.0", "v1.0", 0},
+		compareTestCase{"1!1.0", "2.0", 1},
+		compareTestCase{"1.0alpha1", "1.0a1", 0},
+		compareTestCase{"1.0-preview2", "1.0rc2", 0},
+		compareTestCase{"1.0c1", "1.0rc1", 0},
+		compareTestCase{"1.0-1", "1.0.post1", 0},
+		compareTestCase{"1.0.post", "1.0.post0", 0},
+		compareTestCase{"1.0.rev1", "1.0.post1", 0},
+		compareTestCase{"1.0.DEV1", "1.0.dev1", 0},
+		compareTestCase{"2.17.1", "2.4", 1},
+	)
+	runCompareTests(t, PyPI, cases)
+
+	for _, v := range []string{"", "1.0-foo", "french toast", "1.0+"} {
+		_, err := PyPI.Compare(v, "1.0")
+		require.ErrorIs(t, err, ErrInvalidVersion, v)
+	}
+}
+
+func TestDebian(t *testing.T) {
+	runCompareTests(t, Debian, []compareTestCase{
+		{"1.0", "1.0", 0},
+		{"1.0", "1.0-0", 0},
+		{"1.0", "1.1", -1},
+		{"1.0-1", "1.0-2", -1},
+		{"1:1.0", "2.0", 1},
+		{"0:1.0", "1.0", 0},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0~rc1", "1.0~rc2", -1},
+		{"1.0~~", "1.0~", -1},
+		{"1.0~", "1.0", -1},
+		{"1.0", "1.0a", -1},
+		{"1.0a", "1.0+", -1},
+		{"1.0.1", "1.0a", 1},
+		{"1.2.10", "1.2.9", 1},
+		{"1.4.0-9+deb11u1", "1.4.0-9", 1},
+		{"2.36-9+deb12u4", "2.36-9+deb12u10", -1},
+		{"1.3.8+dfsg-3+deb10u2", "1.3.8+dfsg-3", 1},
+		{"7.88.1-10+deb12u5", "7.88.1-10+deb12u12", -1},
+	})
+
+	for _, v := range []string{"", "a1.0", "x:1.0", "1.0_1"} {
+		_, err := Debian.Compare(v, "1.0")
+		require.ErrorIs(t, err, ErrInvalidVersion, v)
+	}
+}
+
+func TestRPM(t *testing.T) {
+	runCompareTests(t, RPM, []compareTestCase{
+		// Test vectors from rpm's rpmvercmp tests
+		{"1.0", "1.0", 0},
+		{"1.0", "2.0", -1},
+		{"2.0.1", "2.0.1", 0},
+		{"2.0", "2.0.1", -1},
+		{"2.0.1a", "2.0.1", 1},
+		{"5.5p1", "5.5p2", -1},
+		{"5.5p10", "5.5p1", 1},
+		{"10xyz", "10.1xyz", -1},
+		{"xyz10", "xyz10.1", -1},
+		{"xyz.4", "8", -1},
+		{"5.5p1", "5.5p10", -1},
+		{"6.0.rc1", "6.0", 1},
+		{"10b2", "10a1", 1},
+		{"1.0aa", "1.0a", 1},
+		{"10.0001", "10.1", 0},
+		{"10.0001", "10.0039", -1},
+		{"4.999.9", "5.0", -1},
+		{"20101121", "20101122", -1},
+		{"2_0", "2_0", 0},
+		{"2.0", "2_0", 0},
+		{"a", "a", 0},
+		{"a+", "a+", 0},
+		{"a+", "a_", 0},
+		{"+a", "_a", 0},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0~rc1", "1.0~rc2", -1},
+		{"1.0~rc1~git123", "1.0~rc1", -1},
+		{"1.0^", "1.0", 1},
+		{"1.0^git1", "1.0", 1},
+		{"1.0^git1", "1.01", -1},
+		{"1.0^20160101", "1.0.1", -1},
+		{"1.0~rc1^git1", "1.0~rc1", 1},
+		// Epochs and releases
+		{"1:1.0-1", "2.0-1", 1},
+		{"1.0-1.el8", "1.0-2.el8", -1},
+		{"1.0", "1.0-5", 0},
+		{"2.28-151.el9", "2.28-225.el9", -1},
+	})
+
+	for _, v := range []string{"", "x:1.0", "-1"} {
+		_, err := RPM.Compare(v, "1.0")
+		require.ErrorIs(t, err, ErrInvalidVersion, v)
+	}
+}
+
+func TestGeneric(t *testing.T) {
+	runCompareTests(t, Generic, []compareTestCase{
+		{"1.0", "1.0", 0},
+		{"1.0", "1.0.1", -1},
+		{"1.10", "1.9", 1},
+		{"1.0rc1", "1.0", -1},
+		{"1.0-beta", "1.0-alpha", 1},
+		{"1.0_1", "1.0.1", 0},
+		{"v2", "2", 0},
+		{"2.38-r1", "2.38-r2", -1},
+		{"2.38-r10", "2.38-r9", 1},
+	})
+
+	_, err := Generic.Compare("...", "1.0")
+	require.ErrorIs(t, err, ErrInvalidVersion)
+}
+
+func TestComparatorFor(t *testing.T) {
+	for scheme, expected := range map[string]Comparator{
+		"npm":     SemVer,
+		"MAVEN":   Maven,
+		"pypi":    PyPI,
+		"deb":     Debian,
+		"rpm":     RPM,
+		"generic": Generic,
+		"unknown": Generic,
+	} {
+		// Comparators are funcs, compare their behavior on a known pair
+		a, b := "1.0~rc1", "1.0"
+		exp, errExp := expected.Compare(a, b)
+		res, errRes := ComparatorFor(scheme).Compare(a, b)
+		require.Equal(t, exp, res, scheme)
+		require.Equal(t, errExp, errRes, scheme)
+	}
+
+	res, err := Compare("maven", "2.14.1", "2.17.1")
+	require.NoError(t, err)
+	require.Equal(t, -1, res)
+}
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vex
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// hashAlgorithms maps the protobom hash algorithms to the OpenVEX labels
+var hashAlgorithms = map[sbom.HashAlgorithm]string{
+	sbom.HashAlgorithm_MD5:         "md5",
+	sbom.HashAlgorithm_SHA1:        "sha1",
+	sbom.HashAlgorithm_SHA256:      "sha-256",
+	sbom.HashAlgorithm_SHA384:      "sha-384",
+	sbom.HashAlgorithm_SHA512:      "sha-512",
+	sbom.HashAlgorithm_SHA3_256:    "sha3-256",
+	sbom.HashAlgorithm_SHA3_384:    "sha3-384",
+	sbom.HashAlgorithm_SHA3_512:    "sha3-512",
+	sbom.HashAlgorithm_BLAKE2B_256: "blake2b-256",
+	sbom.HashAlgorithm_BLAKE2B_512: "blake2b-512",
+}
+
+// ExportOptions control the metadata of exported OpenVEX documents
+type ExportOptions struct {
+	// ID of the OpenVEX document
+	ID string
+
+	// Author of the document. Defaults to the name of the first author of
+	// the SBOM.
+	Author string
+
+	// Role of the author
+	Role string
+
+	// Timestamp of the document. Defaults to the current time.
+	Timestamp time.Time
+}
+
+// ExportOption modifies the export options
+type ExportOption func(*ExportOptions)
+
+// WithID sets the ID of the exported document
+func WithID(id string) ExportOption {
+	return func(o *ExportOptions) {
+		o.ID = id
+	}
+}
+
+// WithAuthor sets the author and role of the exported document
+func WithAuthor(author, role string) ExportOption {
+	return func(o *ExportOptions) {
+		o.Author = author
+		o.Role = role
+	}
+}
+
+// WithTimestamp sets the timestamp of the exported document
+func WithTimestamp(t time.Time) ExportOption {
+	return func(o *ExportOptions) {
+		o.Timestamp = t
+	}
+}
+
+// Export renders the vulnerabilities of the document that have a VEX status
+// as OpenVEX statements. The affected nodes are exported as products
+// identified by their purl or, if they don't have one, by their node ID.
+func Export(doc *sbom.Document, opts ...ExportOption) (*Document, error) {
+	if doc == nil {
+		return nil, errors.New("document is nil")
+	}
+
+	options := &ExportOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+	if options.Author == "" {
+		for _, a := range doc.GetMetadata().GetAuthors() {
+			if a.GetName() != "" {
+				options.Author = a.GetName()
+				break
+			}
+		}
+	}
+	if options.Timestamp.IsZero() {
+		options.Timestamp = time.Now().UTC()
+	}
+
+	vexDoc := &Document{
+		Context:    Context,
+		ID:         options.ID,
+		Author:     options.Author,
+		Role:       options.Role,
+		Timestamp:  &options.Timestamp,
+		Version:    1,
+		Statements: []*Statement{},
+	}
+
+	for _, v := range doc.GetVulnerabilities() {
+		status := statusFromProtobom(v.GetAnalysis().GetStatus())
+		if status == "" || len(v.GetAffects()) == 0 {
+			continue
+		}
+		vexDoc.Statements = append(vexDoc.Statements, vulnerabilityToStatement(doc, v, status))
+	}
+	return vexDoc, nil
+}
+
+// vulnerabilityToStatement converts a vulnerability to an OpenVEX statement
+func vulnerabilityToStatement(doc *sbom.Document, v *sbom.Vulnerability, status Status) *Statement {
+	a := v.GetAnalysis()
+	s := &Statement{
+		Vulnerability: Vulnerability{
+			Name:        v.GetId(),
+			Description: v.GetDescription(),
+			Aliases:     v.GetAliases(),
+		},
+		Timestamp:   timePointer(a.GetFirstIssued()),
+		LastUpdated: timePointer(a.GetLastUpdated()),
+		Products:    []*Product{},
+		Status:      status,
+	}
+
+	switch status {
+	case StatusNotAffected:
+		s.Justification = justificationFromProtobom(a.GetJustification())
+		s.ImpactStatement = a.GetDetail()
+	case StatusAffected:
+		s.ActionStatement = a.GetAction()
+		s.StatusNotes = a.GetDetail()
+	default:
+		s.StatusNotes = a.GetDetail()
+	}
+	// TODO(degradation): OpenVEX statements have no ratings, CWEs or vendor responses
+
+	for _, id := range v.GetAffects() {
+		s.Products = append(s.Products, &Product{Component: nodeToComponent(doc.GetNodeList().GetNodeByID(id), id)})
+	}
+	return s
+}
+
+// nodeToComponent returns the OpenVEX component of a node. If the node is not
+// in the document, the component is identified by the node ID.
+func nodeToComponent(n *sbom.Node, id string) Component {
+	c := Component{ID: id}
+	if n == nil {
+		return c
+	}
+
+	identifiers := map[string]string{}
+	if purl := string(n.Purl()); purl != "" {
+		c.ID = purl
+		identifiers[IdentifierPurl] = purl
+	}
+	if cpe := n.GetIdentifiers()[int32(sbom.SoftwareIdentifierType_CPE23)]; cpe != "" {
+		identifiers[IdentifierCPE23] = cpe
+	}
+	if cpe := n.GetIdentifiers()[int32(sbom.SoftwareIdentifierType_CPE22)]; cpe != "" {
+		identifiers[IdentifierCPE22] = cpe
+	}
+	if len(identifiers) > 0 {
+		c.Identifiers = identifiers
+	}
+
+	hashes := map[string]string{}
+	for algo, value := range n.GetHashes() {
+		if label, ok := hashAlgorithms[sbom.HashAlgorithm(algo)]; ok && value != "" {
+			hashes[label] = value
+		}
+	}
+	if len(hashes) > 0 {
+		c.Hashes = hashes
+	}
+	return c
+}
+
+// statusFromProtobom returns the OpenVEX status of a protobom VEX status
+func statusFromProtobom(s sbom.VulnerabilityAnalysis_Status) Status {
+	switch s {
+	case sbom.VulnerabilityAnalysis_AFFECTED:
+		return StatusAffected
+	case sbom.VulnerabilityAnalysis_NOT_AFFECTED:
+		return StatusNotAffected
+	case sbom.VulnerabilityAnalysis_FIXED:
+		return StatusFixed
+	case sbom.VulnerabilityAnalysis_UNDER_INVESTIGATION:
+		return StatusUnderInvestigation
+	default:
+		return ""
+	}
+}
+
+// justificationFromProtobom returns the OpenVEX justification of a protobom
+// justification.
+func justificationFromProtobom(j sbom.VulnerabilityAnalysis_Justification) Justification {
+	switch j {
+	case sbom.VulnerabilityAnalysis_COMPONENT_NOT_PRESENT:
+		return JustificationComponentNotPresent
+	case sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_PRESENT:
+		return JustificationVulnerableCodeNotPresent
+	case sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_IN_EXECUTE_PATH:
+		return JustificationVulnerableCodeNotInExecutePath
+	case sbom.VulnerabilityAnalysis_VULNERABLE_CODE_CANNOT_BE_CONTROLLED_BY_ADVERSARY:
+		return JustificationVulnerableCodeCannotBeControlledByAdversary
+	case sbom.VulnerabilityAnalysis_INLINE_MITIGATIONS_ALREADY_EXIST,
+		sbom.VulnerabilityAnalysis_PROTECTED_BY_COMPILER,
+		sbom.VulnerabilityAnalysis_PROTECTED_AT_RUNTIME,
+		sbom.VulnerabilityAnalysis_PROTECTED_AT_PERIMETER:
+		// TODO(degradation): CDX protections are exported as inline mitigations
+		return JustificationInlineMitigationsAlreadyExist
+	default:
+		// TODO(degradation): CDX requirements have no OpenVEX equivalent
+		return ""
+	}
+}
+
+// timePointer converts a protobom timestamp, returns nil if it is not set
+func timePointer(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	ret := t.AsTime()
+	return &ret
+}
@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vex
+
+import (
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+// ImportResult summarizes the statements applied to a document
+type ImportResult struct {
+	// Applied is the number of statements that matched nodes in the document
+	Applied int
+
+	// Unmatched lists the products and subcomponents of the statements that
+	// were not found in the document.
+	Unmatched []string
+}
+
+// Import applies the statements of an OpenVEX document to the vulnerabilities
+// of the SBOM. Statements are applied from the oldest to the newest so the
+// last statement about a vulnerability in a node determines its status.
+//
+// Products are matched to the nodes by node ID, purl, CPE or hashes. When a
+// product lists subcomponents, the statement applies to the subcomponents. A
+// purl without a version matches all the versions of the package.
+//
+// The vulnerabilities already in the document keep their data but the nodes
+// covered by a statement are moved to a new vulnerability entry with the
+// analysis from the statement.
+func Import(doc *sbom.Document, vexDoc *Document) (*ImportResult, error) {
+	if doc == nil || vexDoc == nil {
+		return nil, errors.New("document is nil")
+	}
+	if err := vexDoc.Validate(); err != nil {
+		return nil, err
+	}
+
+	statements := slices.Clone(vexDoc.Statements)
+	sort.SliceStable(statements, func(i, j int) bool {
+		return statements[i].effectiveTime(vexDoc).Before(statements[j].effectiveTime(vexDoc))
+	})
+
+	result := &ImportResult{Unmatched: []string{}}
+	for _, s := range statements {
+		ids := []string{}
+		for _, p := range s.Products {
+			targets := p.Subcomponents
+			if len(targets) == 0 {
+				targets = []*Component{&p.Component}
+			}
+			for _, c := range targets {
+				nodes := matchNodes(doc.GetNodeList(), c)
+				if len(nodes) == 0 {
+					result.Unmatched = append(result.Unmatched, c.name())
+				}
+				for _, n := range nodes {
+					if !slices.Contains(ids, n.GetId()) {
+						ids = append(ids, n.GetId())
+					}
+				}
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		applyStatement(doc, s, ids)
+		result.Applied++
+	}
+	return result, nil
+}
+
+// applyStatement moves the nodes out of the vulnerabilities of the document
+// with the same ID and adds a vulnerability entry for them with the analysis
+// of the statement.
+func applyStatement(doc *sbom.Document, s *Statement, ids []string) {
+	name := s.vulnerabilityName()
+	var base *sbom.Vulnerability
+	vulns := []*sbom.Vulnerability{}
+	for _, v := range doc.Vulnerabilities {
+		if !v.HasID(name) {
+			vulns = append(vulns, v)
+			continue
+		}
+		if base == nil {
+			base = v
+		}
+		affects := slices.DeleteFunc(slices.Clone(v.Affects), func(id string) bool {
+			return slices.Contains(ids, id)
+		})
+		// Drop the entries of the vulnerability left without nodes
+		if len(affects) == 0 && len(v.Affects) > 0 {
+			continue
+		}
+		v.Affects = affects
+		vulns = append(vulns, v)
+	}
+	doc.Vulnerabilities = vulns
+
+	vuln := &sbom.Vulnerability{Id: name, Description: s.Vulnerability.Description}
+	if base != nil {
+		vuln = proto.Clone(base).(*sbom.Vulnerability) //nolint:forcetypeassert
+	}
+	for _, a := range s.Vulnerability.Aliases {
+		if !vuln.HasID(a) {
+			vuln.Aliases = append(vuln.Aliases, a)
+		}
+	}
+	vuln.Affects = ids
+	vuln.Analysis = statementToAnalysis(s)
+	doc.AddVulnerability(vuln)
+}
+
+// statementToAnalysis returns the protobom analysis of a statement
+func statementToAnalysis(s *Statement) *sbom.VulnerabilityAnalysis {
+	a := &sbom.VulnerabilityAnalysis{
+		Status:        statusToProtobom(s.Status),
+		Justification: justificationToProtobom(s.Justification),
+		Responses:     []sbom.VulnerabilityAnalysis_Response{},
+		Detail:        s.StatusNotes,
+		Action:        s.ActionStatement,
+	}
+	if s.ImpactStatement != "" {
+		// TODO(degradation): Status notes are lost if there is an impact statement
+		a.Detail = s.ImpactStatement
+	}
+	if s.Timestamp != nil {
+		a.FirstIssued = timestamppb.New(*s.Timestamp)
+	}
+	if s.LastUpdated != nil {
+		a.LastUpdated = timestamppb.New(*s.LastUpdated)
+	}
+	return a
+}
+
+// matchNodes returns the nodes of the nodelist that match the component
+func matchNodes(nl *sbom.NodeList, c *Component) []*sbom.Node {
+	ret := []*sbom.Node{}
+	for _, n := range nl.GetNodes() {
+		if c.matches(n) {
+			ret = append(ret, n)
+		}
+	}
+	return ret
+}
+
+// name returns a label to identify the component in messages
+func (c *Component) name() string {
+	if c.ID != "" {
+		return c.ID
+	}
+	for _, t := range []string{IdentifierPurl, IdentifierCPE23, IdentifierCPE22} {
+		if v := c.Identifiers[t]; v != "" {
+			return v
+		}
+	}
+	for _, v := range c.Hashes {
+		return v
+	}
+	return ""
+}
+
+// matches returns true if the node is the component. The component @id is
+// compared to the node ID and, when it is a purl or a CPE, to the node
+// identifiers.
+func (c *Component) matches(n *sbom.Node) bool {
+	if c.ID != "" && c.ID == n.GetId() {
+		return true
+	}
+
+	purls := []string{c.Identifiers[IdentifierPurl]}
+	cpes := []string{c.Identifiers[IdentifierCPE23], c.Identifiers[IdentifierCPE22]}
+	switch {
+	case strings.HasPrefix(c.ID, "pkg:"):
+		purls = append(purls, c.ID)
+	case strings.HasPrefix(c.ID, "cpe:"):
+		cpes = append(cpes, c.ID)
+	}
+
+	for _, p := range purls {
+		if p == "" || n.Purl() == "" {
+			continue
+		}
+		purl := sbom.PackageURL(p)
+		mode := sbom.PurlMatchQualifierSubset
+		if purl.Version() == "" {
+			mode |= sbom.PurlMatchIgnoreVersion
+		}
+		if purl.Matches(n.Purl(), mode) {
+			return true
+		}
+	}
+
+	for _, s := range cpes {
+		if s == "" {
+			continue
+		}
+		pattern, err := sbom.ParseCPE(s)
+		if err != nil {
+			continue
+		}
+		for _, t := range []sbom.SoftwareIdentifierType{sbom.SoftwareIdentifierType_CPE23, sbom.SoftwareIdentifierType_CPE22} {
+			target, err := sbom.ParseCPE(n.GetIdentifiers()[int32(t)])
+			if err == nil && pattern.Matches(target) {
+				return true
+			}
+		}
+	}
+
+	if len(c.Hashes) > 0 {
+		hashes := map[int32]string{}
+		for algo, label := range hashAlgorithms {
+			if v, ok := c.Hashes[label]; ok {
+				hashes[int32(algo)] = v
+			}
+		}
+		if n.HashesMatch(hashes) {
+			return true
+		}
+	}
+	return false
+}
+
+// statusToProtobom returns the protobom VEX status of an OpenVEX status
+func statusToProtobom(s Status) sbom.VulnerabilityAnalysis_Status {
+	switch s {
+	case StatusAffected:
+		return sbom.VulnerabilityAnalysis_AFFECTED
+	case StatusNotAffected:
+		return sbom.VulnerabilityAnalysis_NOT_AFFECTED
+	case StatusFixed:
+		return sbom.VulnerabilityAnalysis_FIXED
+	case StatusUnderInvestigation:
+		return sbom.VulnerabilityAnalysis_UNDER_INVESTIGATION
+	default:
+		return sbom.VulnerabilityAnalysis_UNKNOWN_STATUS
+	}
+}
+
+// justificationToProtobom returns the protobom justification of an OpenVEX
+// justification.
+func justificationToProtobom(j Justification) sbom.VulnerabilityAnalysis_Justification {
+	switch j {
+	case JustificationComponentNotPresent:
+		return sbom.VulnerabilityAnalysis_COMPONENT_NOT_PRESENT
+	case JustificationVulnerableCodeNotPresent:
+		return sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_PRESENT
+	case JustificationVulnerableCodeNotInExecutePath:
+		return sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_IN_EXECUTE_PATH
+	case JustificationVulnerableCodeCannotBeControlledByAdversary:
+		return sbom.VulnerabilityAnalysis_VULNERABLE_CODE_CANNOT_BE_CONTROLLED_BY_ADVERSARY
+	case JustificationInlineMitigationsAlreadyExist:
+		return sbom.VulnerabilityAnalysis_INLINE_MITIGATIONS_ALREADY_EXIST
+	default:
+		return sbom.VulnerabilityAnalysis_UNKNOWN_JUSTIFICATION
+	}
+}
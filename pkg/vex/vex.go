@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package vex reads and writes OpenVEX documents (https://openvex.dev) and
+// exchanges their statements with the vulnerabilities of a protobom
+// Document. Export renders the VEX analysis of the document vulnerabilities
+// as OpenVEX statements and Import applies the statements of an OpenVEX
+// document to the nodes of an SBOM.
+package vex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Context is the JSON-LD context of the OpenVEX specification version
+// written by this package.
+const Context = "https://openvex.dev/ns/v0.2.0"
+
+// ErrInvalidDocument is returned when parsing a malformed OpenVEX document
+var ErrInvalidDocument = errors.New("invalid OpenVEX document")
+
+// Status is the exploitability status of a product in a VEX statement
+type Status string
+
+const (
+	StatusNotAffected        Status = "not_affected"
+	StatusAffected           Status = "affected"
+	StatusFixed              Status = "fixed"
+	StatusUnderInvestigation Status = "under_investigation"
+)
+
+// Justification explains why a product is not affected by a vulnerability
+type Justification string
+
+const (
+	JustificationComponentNotPresent                         Justification = "component_not_present"
+	JustificationVulnerableCodeNotPresent                    Justification = "vulnerable_code_not_present"
+	JustificationVulnerableCodeNotInExecutePath              Justification = "vulnerable_code_not_in_execute_path"
+	JustificationVulnerableCodeCannotBeControlledByAdversary Justification = "vulnerable_code_cannot_be_controlled_by_adversary"
+	JustificationInlineMitigationsAlreadyExist               Justification = "inline_mitigations_already_exist"
+)
+
+// Identifier types of the OpenVEX components
+const (
+	IdentifierPurl  = "purl"
+	IdentifierCPE22 = "cpe22"
+	IdentifierCPE23 = "cpe23"
+)
+
+// Document is an OpenVEX document
+type Document struct {
+	Context     string       `json:"@context"`
+	ID          string       `json:"@id"`
+	Author      string       `json:"author"`
+	Role        string       `json:"role,omitempty"`
+	Timestamp   *time.Time   `json:"timestamp"`
+	LastUpdated *time.Time   `json:"last_updated,omitempty"`
+	Version     int          `json:"version"`
+	Tooling     string       `json:"tooling,omitempty"`
+	Statements  []*Statement `json:"statements"`
+}
+
+// Statement asserts the status of a vulnerability in a list of products
+type Statement struct {
+	ID                       string        `json:"@id,omitempty"`
+	Vulnerability            Vulnerability `json:"vulnerability"`
+	Timestamp                *time.Time    `json:"timestamp,omitempty"`
+	LastUpdated              *time.Time    `json:"last_updated,omitempty"`
+	Products                 []*Product    `json:"products,omitempty"`
+	Status                   Status        `json:"status"`
+	StatusNotes              string        `json:"status_notes,omitempty"`
+	Justification            Justification `json:"justification,omitempty"`
+	ImpactStatement          string        `json:"impact_statement,omitempty"`
+	ActionStatement          string        `json:"action_statement,omitempty"`
+	ActionStatementTimestamp *time.Time    `json:"action_statement_timestamp,omitempty"`
+}
+
+// Vulnerability identifies the vulnerability of a statement
+type Vulnerability struct {
+	ID          string   `json:"@id,omitempty"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Aliases     []string `json:"aliases,omitempty"`
+}
+
+// UnmarshalJSON reads the vulnerability from its object form or from the
+// plain string used by OpenVEX versions before v0.2.0.
+func (v *Vulnerability) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*v = Vulnerability{Name: name}
+		return nil
+	}
+
+	type vulnerability Vulnerability
+	vuln := vulnerability{}
+	if err := json.Unmarshal(data, &vuln); err != nil {
+		return err
+	}
+	*v = Vulnerability(vuln)
+	return nil
+}
+
+// Component is a piece of software referenced by a VEX statement
+type Component struct {
+	ID          string            `json:"@id,omitempty"`
+	Identifiers map[string]string `json:"identifiers,omitempty"`
+	Hashes      map[string]string `json:"hashes,omitempty"`
+}
+
+// Product is a component covered by a statement and, optionally, the
+// subcomponents in it that carry the vulnerability.
+type Product struct {
+	Component
+	Subcomponents []*Component `json:"subcomponents,omitempty"`
+}
+
+// Parse reads an OpenVEX document from its JSON encoding
+func Parse(data []byte) (*Document, error) {
+	doc := &Document{}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, fmt.Errorf("%w: decoding json: %w", ErrInvalidDocument, err)
+	}
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Read parses an OpenVEX document from a reader
+func Read(r io.Reader) (*Document, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading OpenVEX document: %w", err)
+	}
+	return Parse(data)
+}
+
+// ReadFile parses an OpenVEX document file
+func ReadFile(path string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading OpenVEX file: %w", err)
+	}
+	return Parse(data)
+}
+
+// Validate checks that the statements of the document have a vulnerability
+// and a valid status. All the errors found are returned.
+func (d *Document) Validate() error {
+	errs := []error{}
+	invalid := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%w: %s", ErrInvalidDocument, fmt.Sprintf(format, args...)))
+	}
+	for i, s := range d.Statements {
+		if s == nil {
+			invalid("statement #%d is empty", i)
+			continue
+		}
+		if s.Vulnerability.Name == "" && s.Vulnerability.ID == "" {
+			invalid("statement #%d has no vulnerability", i)
+		}
+		switch s.Status {
+		case StatusNotAffected, StatusAffected, StatusFixed, StatusUnderInvestigation:
+		default:
+			invalid("statement #%d has an invalid status %q", i, s.Status)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Write renders the document as JSON
+func (d *Document) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(d); err != nil {
+		return fmt.Errorf("encoding OpenVEX document: %w", err)
+	}
+	return nil
+}
+
+// vulnerabilityName returns the name used to match the vulnerability
+func (s *Statement) vulnerabilityName() string {
+	if s.Vulnerability.Name != "" {
+		return s.Vulnerability.Name
+	}
+	return s.Vulnerability.ID
+}
+
+// effectiveTime returns the last time the statement was modified, falling
+// back to the document timestamps.
+func (s *Statement) effectiveTime(d *Document) time.Time {
+	for _, t := range []*time.Time{s.LastUpdated, s.Timestamp, d.LastUpdated, d.Timestamp} {
+		if t != nil {
+			return *t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: Copyright 2024 The Protobom Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vex
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/protobom/protobom/pkg/sbom"
+)
+
+func testDocument() *sbom.Document {
+	doc := sbom.NewDocument()
+	doc.Metadata.Authors = append(doc.Metadata.Authors, &sbom.Person{Name: "ACME Corp"})
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app", Type: sbom.Node_PACKAGE, Name: "app",
+		Identifiers: map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "pkg:oci/app@sha256%3A1234"},
+	})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "log4j", Type: sbom.Node_PACKAGE, Name: "log4j-core", Version: "2.14.1",
+		Identifiers: map[int32]string{
+			int32(sbom.SoftwareIdentifierType_PURL):  "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+			int32(sbom.SoftwareIdentifierType_CPE23): "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+		},
+		Hashes: map[int32]string{int32(sbom.HashAlgorithm_SHA256): "abcdef"},
+	})
+	doc.NodeList.AddNode(&sbom.Node{Id: "spring", Type: sbom.Node_PACKAGE, Name: "spring-beans"})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "app", To: []string{"log4j", "spring"}})
+	return doc
+}
+
+func TestParse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		data    string
+		mustErr bool
+	}{
+		"v0.2.0": {
+			data: `{
+  "@context": "https://openvex.dev/ns/v0.2.0",
+  "@id": "https://example.com/vex-1",
+  "author": "ACME Corp",
+  "timestamp": "2024-01-01T00:00:00Z",
+  "version": 1,
+  "statements": [{
+    "vulnerability": {"name": "CVE-2021-44228", "aliases": ["GHSA-jfh8-c2jp-5v3q"]},
+    "products": [{"@id": "pkg:oci/app", "subcomponents": [{"@id": "pkg:maven/org.apache.logging.log4j/log4j-core"}]}],
+    "status": "not_affected",
+    "justification": "vulnerable_code_not_in_execute_path"
+  }]
+}`,
+		},
+		"v0.0.1 string vulnerability": {
+			data: `{"@context": "https://openvex.dev/ns", "author": "ACME", "version": 1,
+  "statements": [{"vulnerability": "CVE-2021-44228", "products": [{"@id": "log4j"}], "status": "fixed"}]}`,
+		},
+		"invalid status": {
+			data:    `{"statements": [{"vulnerability": {"name": "CVE-2021-44228"}, "status": "exploitable"}]}`,
+			mustErr: true,
+		},
+		"no vulnerability": {
+			data:    `{"statements": [{"status": "fixed"}]}`,
+			mustErr: true,
+		},
+		"invalid json": {data: `{"statements": [`, mustErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			doc, err := Parse([]byte(tc.data))
+			if tc.mustErr {
+				require.ErrorIs(t, err, ErrInvalidDocument)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, doc.Statements, 1)
+			require.Equal(t, "CVE-2021-44228", doc.Statements[0].Vulnerability.Name)
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	doc := testDocument()
+	doc.AddVulnerability(&sbom.Vulnerability{
+		Id: "CVE-2021-44228", Aliases: []string{"GHSA-jfh8-c2jp-5v3q"}, Affects: []string{"log4j"},
+		Analysis: &sbom.VulnerabilityAnalysis{
+			Status:        sbom.VulnerabilityAnalysis_NOT_AFFECTED,
+			Justification: sbom.VulnerabilityAnalysis_PROTECTED_AT_RUNTIME,
+			Detail:        "JNDI lookups are disabled",
+			FirstIssued:   timestamppb.New(time.Date(2021, 12, 11, 0, 0, 0, 0, time.UTC)),
+		},
+	})
+	doc.AddVulnerability(&sbom.Vulnerability{
+		Id: "CVE-2022-22965", Affects: []string{"spring"},
+		Analysis: &sbom.VulnerabilityAnalysis{Status: sbom.VulnerabilityAnalysis_AFFECTED, Action: "Upgrade to 5.3.18"},
+	})
+	// Vulnerabilities without a VEX status are not exported
+	doc.AddVulnerability(&sbom.Vulnerability{Id: "CVE-2000-0001", Affects: []string{"app"}})
+
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	vexDoc, err := Export(doc, WithID("https://example.com/vex-1"), WithTimestamp(ts))
+	require.NoError(t, err)
+	require.Equal(t, Context, vexDoc.Context)
+	require.Equal(t, "ACME Corp", vexDoc.Author)
+	require.Equal(t, ts, *vexDoc.Timestamp)
+	require.Len(t, vexDoc.Statements, 2)
+
+	s := vexDoc.Statements[0]
+	require.Equal(t, StatusNotAffected, s.Status)
+	require.Equal(t, JustificationInlineMitigationsAlreadyExist, s.Justification)
+	require.Equal(t, "JNDI lookups are disabled", s.ImpactStatement)
+	require.Equal(t, []string{"GHSA-jfh8-c2jp-5v3q"}, s.Vulnerability.Aliases)
+	require.NotNil(t, s.Timestamp)
+	require.Len(t, s.Products, 1)
+	require.Equal(t, "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", s.Products[0].ID)
+	require.Equal(t, "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", s.Products[0].Identifiers[IdentifierCPE23])
+	require.Equal(t, map[string]string{"sha-256": "abcdef"}, s.Products[0].Hashes)
+
+	s = vexDoc.Statements[1]
+	require.Equal(t, StatusAffected, s.Status)
+	require.Equal(t, "Upgrade to 5.3.18", s.ActionStatement)
+	require.Equal(t, "spring", s.Products[0].ID)
+
+	var b bytes.Buffer
+	require.NoError(t, vexDoc.Write(&b))
+	parsed, err := Parse(b.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, vexDoc, parsed)
+}
+
+func TestImport(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		statements []*Statement
+		applied    int
+		unmatched  []string
+		expected   map[string]sbom.VulnerabilityAnalysis_Status
+	}{
+		"purl without version": {
+			statements: []*Statement{{
+				Vulnerability: Vulnerability{Name: "CVE-2021-44228"},
+				Products:      []*Product{{Component: Component{ID: "pkg:maven/org.apache.logging.log4j/log4j-core"}}},
+				Status:        StatusFixed,
+			}},
+			applied:  1,
+			expected: map[string]sbom.VulnerabilityAnalysis_Status{"log4j": sbom.VulnerabilityAnalysis_FIXED},
+		},
+		"subcomponents": {
+			statements: []*Statement{{
+				Vulnerability: Vulnerability{Name: "CVE-2021-44228"},
+				Products: []*Product{{
+					Component:     Component{ID: "pkg:oci/app"},
+					Subcomponents: []*Component{{Identifiers: map[string]string{IdentifierCPE23: "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*"}}},
+				}},
+				Status:        StatusNotAffected,
+				Justification: JustificationVulnerableCodeNotInExecutePath,
+			}},
+			applied:  1,
+			expected: map[string]sbom.VulnerabilityAnalysis_Status{"log4j": sbom.VulnerabilityAnalysis_NOT_AFFECTED},
+		},
+		"newest statement wins": {
+			statements: []*Statement{
+				{
+					Vulnerability: Vulnerability{Name: "CVE-2021-44228"},
+					Timestamp:     &newer,
+					Products:      []*Product{{Component: Component{Hashes: map[string]string{"sha-256": "abcdef"}}}},
+					Status:        StatusFixed,
+				},
+				{
+					Vulnerability: Vulnerability{Name: "CVE-2021-44228"},
+					Timestamp:     &older,
+					Products:      []*Product{{Component: Component{ID: "log4j"}}},
+					Status:        StatusUnderInvestigation,
+				},
+			},
+			applied:  2,
+			expected: map[string]sbom.VulnerabilityAnalysis_Status{"log4j": sbom.VulnerabilityAnalysis_FIXED},
+		},
+		"unmatched product": {
+			statements: []*Statement{{
+				Vulnerability: Vulnerability{Name: "CVE-2021-44228"},
+				Products:      []*Product{{Component: Component{ID: "pkg:npm/left-pad@1.0.0"}}},
+				Status:        StatusAffected,
+			}},
+			unmatched: []string{"pkg:npm/left-pad@1.0.0"},
+			expected:  map[string]sbom.VulnerabilityAnalysis_Status{"log4j": sbom.VulnerabilityAnalysis_UNKNOWN_STATUS},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			doc := testDocument()
+			doc.AddVulnerability(&sbom.Vulnerability{
+				Id: "CVE-2021-44228", Description: "Log4Shell", Affects: []string{"log4j", "spring"},
+			})
+
+			res, err := Import(doc, &Document{Context: Context, Statements: tc.statements})
+			require.NoError(t, err)
+			require.Equal(t, tc.applied, res.Applied)
+			if tc.unmatched == nil {
+				tc.unmatched = []string{}
+			}
+			require.Equal(t, tc.unmatched, res.Unmatched)
+
+			for id, status := range tc.expected {
+				vulns := doc.GetNodeVulnerabilities(id)
+				require.Len(t, vulns, 1)
+				require.Equal(t, "Log4Shell", vulns[0].Description)
+				require.Equal(t, status, vulns[0].GetAnalysis().GetStatus())
+			}
+
+			// The other nodes keep the original vulnerability entry
+			spring := doc.GetNodeVulnerabilities("spring")
+			require.Len(t, spring, 1)
+			require.Nil(t, spring[0].Analysis)
+			require.Empty(t, doc.Validate().WithSeverity(sbom.SeverityError))
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	doc := testDocument()
+	doc.AddVulnerability(&sbom.Vulnerability{
+		Id: "CVE-2021-44228", Affects: []string{"log4j"},
+		Analysis: &sbom.VulnerabilityAnalysis{
+			Status:        sbom.VulnerabilityAnalysis_NOT_AFFECTED,
+			Justification: sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_PRESENT,
+			Detail:        "The JndiLookup class was removed",
+		},
+	})
+	vexDoc, err := Export(doc)
+	require.NoError(t, err)
+
+	target := testDocument()
+	res, err := Import(target, vexDoc)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Applied)
+	require.Len(t, target.Vulnerabilities, 1)
+
+	v := target.Vulnerabilities[0]
+	require.Equal(t, []string{"log4j"}, v.Affects)
+	require.Equal(t, sbom.VulnerabilityAnalysis_NOT_AFFECTED, v.Analysis.Status)
+	require.Equal(t, sbom.VulnerabilityAnalysis_VULNERABLE_CODE_NOT_PRESENT, v.Analysis.Justification)
+	require.Equal(t, "The JndiLookup class was removed", v.Analysis.Detail)
+}
@@ -38,6 +38,22 @@ func WithFormat(f formats.Format) WriterOption {
 	}
 }
 
+// WithSchemaValidation turns on validation of the rendered document against
+// the official JSON schema of the output format.
+func WithSchemaValidation(validate bool) WriterOption {
+	return func(w *Writer) {
+		w.Options.ValidateSchema = validate
+	}
+}
+
+// WithGraphValidation turns on the integrity checks of the document graph
+// before it is serialized.
+func WithGraphValidation(validate bool) WriterOption {
+	return func(w *Writer) {
+		w.Options.ValidateGraph = validate
+	}
+}
+
 func WithStoreRetriever(sb storage.StoreRetriever) WriterOption {
 	return func(w *Writer) {
 		if sb != nil {
@@ -59,7 +75,15 @@ type Options struct {
 	RenderOptions    *native.RenderOptions
 	SerializeOptions *native.SerializeOptions
 	StoreOptions     *storage.StoreOptions
-	formatOptions    map[string]interface{}
+
+	// ValidateSchema makes the writer check the rendered document against
+	// the bundled JSON schema of its format before writing it out.
+	ValidateSchema bool
+
+	// ValidateGraph makes the writer check the integrity of the document
+	// graph before serializing it. Writing fails if errors are found.
+	ValidateGraph bool
+	formatOptions map[string]interface{}
 }
 
 // argToOptsKeyVal returns a key value to access the options dictionary by using
@@ -1,6 +1,7 @@
 package writer
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -8,6 +9,7 @@ import (
 	"sync"
 
 	"github.com/protobom/protobom/pkg/formats"
+	"github.com/protobom/protobom/pkg/formats/schema"
 	"github.com/protobom/protobom/pkg/native"
 	drivers "github.com/protobom/protobom/pkg/native/serializers"
 	"github.com/protobom/protobom/pkg/sbom"
@@ -93,6 +95,12 @@ func (w *Writer) WriteStreamWithOptions(bom *sbom.Document, wr io.WriteCloser, o
 		return fmt.Errorf("unable to write sbom to stream, SBOM is nil")
 	}
 
+	if o.ValidateGraph {
+		if err := bom.Validate().Err(); err != nil {
+			return fmt.Errorf("validating document graph: %w", err)
+		}
+	}
+
 	format := o.Format
 	if o.Format == "" {
 		format = w.Options.Format
@@ -118,10 +126,28 @@ func (w *Writer) WriteStreamWithOptions(bom *sbom.Document, wr io.WriteCloser, o
 		ro = defaultOptions.RenderOptions
 	}
 
-	if err := serializer.Render(nativeDoc, wr, ro, o.GetFormatOptions(serializer)); err != nil {
+	if !o.ValidateSchema {
+		if err := serializer.Render(nativeDoc, wr, ro, o.GetFormatOptions(serializer)); err != nil {
+			return fmt.Errorf("writing rendered document to string: %w", err)
+		}
+		return nil
+	}
+
+	// When validating, render to a buffer first so that nothing reaches
+	// the output stream if the document does not conform to its schema.
+	var buf bytes.Buffer
+	if err := serializer.Render(nativeDoc, &buf, ro, o.GetFormatOptions(serializer)); err != nil {
 		return fmt.Errorf("writing rendered document to string: %w", err)
 	}
 
+	if err := schema.Validate(format, bytes.NewReader(buf.Bytes())); err != nil {
+		return fmt.Errorf("validating rendered document: %w", err)
+	}
+
+	if _, err := buf.WriteTo(wr); err != nil {
+		return fmt.Errorf("writing validated document: %w", err)
+	}
+
 	return nil
 }
 
@@ -2,11 +2,15 @@ package writer_test
 
 import (
 	"bufio"
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"path"
 	"testing"
 
 	"github.com/protobom/protobom/pkg/formats"
+	"github.com/protobom/protobom/pkg/formats/schema"
 	"github.com/protobom/protobom/pkg/native"
 	"github.com/protobom/protobom/pkg/native/nativefakes"
 	"github.com/protobom/protobom/pkg/sbom"
@@ -484,3 +488,116 @@ func TestStore(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteStreamSchemaValidation(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		output    string
+		validate  bool
+		mustError bool
+	}{
+		{
+			name:     "valid output",
+			output:   `{"bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1}`,
+			validate: true,
+		},
+		{
+			name:      "invalid output",
+			output:    `{"bomFormat": "SomethingElse", "specVersion": "1.5", "version": 1}`,
+			validate:  true,
+			mustError: true,
+		},
+		{
+			name:   "invalid output not validated",
+			output: `{"bomFormat": "SomethingElse", "specVersion": "1.5", "version": 1}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &nativefakes.FakeSerializer{}
+			s.RenderCalls(func(_ interface{}, w io.Writer, _ *native.RenderOptions, _ interface{}) error {
+				_, err := w.Write([]byte(tc.output))
+				return err
+			})
+			writer.RegisterSerializer(formats.CDX15JSON, s)
+
+			var buf bytes.Buffer
+			out := &fakeWriteCloser{bufio.NewWriter(&buf)}
+			w := writer.New()
+			err := w.WriteStreamWithOptions(&sbom.Document{}, out, &writer.Options{
+				Format:         formats.CDX15JSON,
+				ValidateSchema: tc.validate,
+			})
+			require.NoError(t, out.Flush())
+			if tc.mustError {
+				require.Error(t, err)
+				verr := &schema.ValidationError{}
+				require.True(t, errors.As(err, &verr))
+				require.Empty(t, buf.String(), "invalid documents must not be written")
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.output, buf.String())
+		})
+	}
+}
+
+func TestWriteStreamGraphValidation(t *testing.T) {
+	writer.RegisterSerializer(formats.CDX15JSON, &nativefakes.FakeSerializer{})
+	for _, tc := range []struct {
+		name      string
+		bom       *sbom.Document
+		validate  bool
+		mustError bool
+	}{
+		{
+			name: "valid graph",
+			bom: &sbom.Document{
+				Metadata: &sbom.Metadata{Id: "doc"},
+				NodeList: &sbom.NodeList{
+					Nodes:        []*sbom.Node{{Id: "node1"}, {Id: "node2"}},
+					Edges:        []*sbom.Edge{{Type: sbom.Edge_contains, From: "node1", To: []string{"node2"}}},
+					RootElements: []string{"node1"},
+				},
+			},
+			validate: true,
+		},
+		{
+			name: "dangling edge",
+			bom: &sbom.Document{
+				Metadata: &sbom.Metadata{Id: "doc"},
+				NodeList: &sbom.NodeList{
+					Nodes:        []*sbom.Node{{Id: "node1"}},
+					Edges:        []*sbom.Edge{{Type: sbom.Edge_contains, From: "node1", To: []string{"node2"}}},
+					RootElements: []string{"node1"},
+				},
+			},
+			validate:  true,
+			mustError: true,
+		},
+		{
+			name: "dangling edge not validated",
+			bom: &sbom.Document{
+				Metadata: &sbom.Metadata{Id: "doc"},
+				NodeList: &sbom.NodeList{
+					Nodes:        []*sbom.Node{{Id: "node1"}},
+					Edges:        []*sbom.Edge{{Type: sbom.Edge_contains, From: "node1", To: []string{"node2"}}},
+					RootElements: []string{"node1"},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := writer.New()
+			err := w.WriteStreamWithOptions(tc.bom, &fakeWriteCloser{}, &writer.Options{
+				Format:        formats.CDX15JSON,
+				ValidateGraph: tc.validate,
+			})
+			if tc.mustError {
+				ierr := &sbom.IntegrityError{}
+				require.ErrorAs(t, err, &ierr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}